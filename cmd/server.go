@@ -5,14 +5,20 @@ import (
 
 	"github.com/GeovaneCavalcante/tree-genealogical/config"
 	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/event"
+	eventInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/event/inmem"
+	eventSQLiteRepo "github.com/GeovaneCavalcante/tree-genealogical/event/sqlite"
 	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/importer"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/gin"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/webserver"
 	"github.com/GeovaneCavalcante/tree-genealogical/person"
 	personInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/person/inmem"
+	personSQLiteRepo "github.com/GeovaneCavalcante/tree-genealogical/person/sqlite"
 	"github.com/GeovaneCavalcante/tree-genealogical/pkg/genealogy"
 	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
 	relationshipInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/relationship/inmem"
+	relationshipSQLiteRepo "github.com/GeovaneCavalcante/tree-genealogical/relationship/sqlite"
 )
 
 // @title Tree Genealogical API
@@ -22,22 +28,38 @@ import (
 // @BasePath /api/v1
 func main() {
 
-	inmenDB := database.New()
-
 	envs := config.LoadEnvVars()
 
-	personRepo := personInmemRepo.NewPersonRepository(inmenDB)
-	personService := person.NewService(personRepo)
+	personRepo, relationshipRepo, eventRepo := newRepositories(envs)
 
-	relationshipRepo := relationshipInmemRepo.NewRelationshipRepository(inmenDB)
+	personService := person.NewService(personRepo)
 	relationshipService := relationship.NewService(relationshipRepo)
 
 	genealogy := genealogy.NewFamilyTree()
 	familytreeService := familytree.NewService(genealogy, personRepo, relationshipRepo)
 
-	h := gin.Handlers(envs, personService, relationshipService, familytreeService)
+	importService := importer.NewService(personRepo, relationshipService)
+
+	eventService := event.NewService(eventRepo, personRepo)
+
+	h := gin.Handlers(envs, personService, relationshipService, familytreeService, importService, eventService)
 
 	if err := webserver.Start(envs.APIPort, h); err != nil {
 		log.Fatalf("Failed to start API: %v", err)
 	}
 }
+
+// Cria os repositórios de acordo com o DATABASE_DRIVER configurado (inmem ou sqlite).
+func newRepositories(envs *config.Environments) (person.Repository, relationship.Repository, event.Repository) {
+	switch envs.DatabaseDriver {
+	case "sqlite":
+		db, err := database.NewSQLite(envs.SQLitePath)
+		if err != nil {
+			log.Fatalf("Failed to open SQLite database: %v", err)
+		}
+		return personSQLiteRepo.NewPersonRepository(db), relationshipSQLiteRepo.NewRelationshipRepository(db), eventSQLiteRepo.NewEventRepository(db)
+	default:
+		inmenDB := database.New()
+		return personInmemRepo.NewPersonRepository(inmenDB), relationshipInmemRepo.NewRelationshipRepository(inmenDB), eventInmemRepo.NewEventRepository(inmenDB)
+	}
+}
@@ -11,14 +11,18 @@ var (
 )
 
 type Environments struct {
-	APIPort     string `mapstructure:"API_PORT"`
-	Environment string `mapstructure:"ENVIRONMENT"`
+	APIPort        string `mapstructure:"API_PORT"`
+	Environment    string `mapstructure:"ENVIRONMENT"`
+	DatabaseDriver string `mapstructure:"DATABASE_DRIVER"`
+	SQLitePath     string `mapstructure:"SQLITE_PATH"`
 }
 
 func LoadEnvVars() *Environments {
 	viper.SetConfigFile(".env")
 	viper.SetDefault("API_PORT", "8080")
 	viper.SetDefault("ENVIRONMENT", "local")
+	viper.SetDefault("DATABASE_DRIVER", "inmem")
+	viper.SetDefault("SQLITE_PATH", "tree-genealogical.db")
 
 	viper.AutomaticEnv()
 
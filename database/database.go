@@ -1,31 +1,46 @@
 package database
 
 import (
+	"sync"
+
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/google/uuid"
 )
 
+// Banco em memória. Os dados só são acessados pelos métodos abaixo, que
+// protegem as leituras e escritas com um RWMutex.
 type Database struct {
-	Persons       []entity.Person
-	Relationships []entity.Relationship
+	mu            sync.RWMutex
+	persons       []entity.Person
+	relationships []entity.Relationship
+	events        []entity.Event
 }
 
-var database *Database
+var (
+	database *Database
+	once     sync.Once
+)
 
 func New() *Database {
-	if database == nil {
-		database = &Database{
-			Persons:       []entity.Person{},
-			Relationships: []entity.Relationship{},
-		}
+	once.Do(func() {
+		database = NewEmpty()
 
 		loadGeovaneFamily(database)
 		loadDefaultFamily(database)
-	}
+	})
 
 	return database
 }
 
+// Cria um banco em memória vazio, sem as famílias de exemplo.
+func NewEmpty() *Database {
+	return &Database{
+		persons:       []entity.Person{},
+		relationships: []entity.Relationship{},
+		events:        []entity.Event{},
+	}
+}
+
 func NewPerson(db *Database, name, gender, fatherID, motherID string) entity.Person {
 	person := entity.Person{
 		ID:     uuid.New().String(),
@@ -41,7 +56,7 @@ func NewPerson(db *Database, name, gender, fatherID, motherID string) entity.Per
 		NewRelationshipAndLoadDb(db, person.ID, motherID)
 	}
 
-	db.Persons = append(db.Persons, person)
+	db.AddPerson(person)
 
 	return person
 }
@@ -54,11 +69,277 @@ func NewRelationshipAndLoadDb(db *Database, mainPersonID, secundePersonID string
 		SecundePersonID: secundePersonID,
 	}
 
-	db.Relationships = append(db.Relationships, relationship)
+	db.AddRelationship(relationship)
 	return relationship
 
 }
 
+// Adiciona uma pessoa ao banco.
+func (db *Database) AddPerson(person entity.Person) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.persons = append(db.persons, person)
+}
+
+// Retorna uma cópia das pessoas cadastradas.
+func (db *Database) Persons() []entity.Person {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]entity.Person(nil), db.persons...)
+}
+
+// Busca uma pessoa pelo ID.
+func (db *Database) FindPerson(ID string) (entity.Person, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	for _, p := range db.persons {
+		if p.ID == ID {
+			return p, true
+		}
+	}
+	return entity.Person{}, false
+}
+
+// Substitui a pessoa com o ID informado. Retorna false se ela não existir.
+func (db *Database) UpdatePerson(ID string, person entity.Person) bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, p := range db.persons {
+		if p.ID == ID {
+			db.persons[i] = person
+			return true
+		}
+	}
+	return false
+}
+
+// Remove a pessoa com o ID informado junto com seus eventos. Sem cascade, a pessoa que
+// participa de algum relacionamento é mantida; com cascade, esses relacionamentos também
+// são removidos. Retorna se a pessoa existia e se ela possuía relacionamentos.
+func (db *Database) DeletePersonWithRelationships(ID string, cascade bool) (found, linked bool) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	index := -1
+	for i, p := range db.persons {
+		if p.ID == ID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return false, false
+	}
+
+	relationships := db.relationships[:0:0]
+	for _, r := range db.relationships {
+		if r.MainPersonID == ID || r.SecundePersonID == ID {
+			linked = true
+			continue
+		}
+		relationships = append(relationships, r)
+	}
+	if linked && !cascade {
+		return true, true
+	}
+
+	db.persons = append(db.persons[:index:index], db.persons[index+1:]...)
+	db.relationships = relationships
+	events := db.events[:0:0]
+	for _, e := range db.events {
+		if e.PersonID != ID {
+			events = append(events, e)
+		}
+	}
+	db.events = events
+	return true, linked
+}
+
+// Adiciona um relacionamento ao banco.
+func (db *Database) AddRelationship(relationship entity.Relationship) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.relationships = append(db.relationships, relationship)
+}
+
+// Dados do banco entregues às verificações feitas sob o lock de escrita.
+type Snapshot struct {
+	Persons       []entity.Person
+	Events        []entity.Event
+	Relationships []entity.Relationship
+}
+
+// Cópia dos dados. Deve ser chamado com o lock obtido.
+func (db *Database) lockedSnapshot() Snapshot {
+	return Snapshot{
+		Persons:       append([]entity.Person(nil), db.persons...),
+		Events:        append([]entity.Event(nil), db.events...),
+		Relationships: append([]entity.Relationship(nil), db.relationships...),
+	}
+}
+
+// Adiciona o relacionamento apenas quando check, se informado, não retorna erro e as duas pessoas
+// estão cadastradas, verificando sob o mesmo lock da escrita. Retorna false quando alguma delas não existe.
+func (db *Database) AddLinkedRelationship(relationship entity.Relationship, check func(Snapshot) error) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if check != nil {
+		if err := check(db.lockedSnapshot()); err != nil {
+			return true, err
+		}
+	}
+	if !db.hasPersons(relationship.MainPersonID, relationship.SecundePersonID) {
+		return false, nil
+	}
+	db.relationships = append(db.relationships, relationship)
+	return true, nil
+}
+
+// Retorna uma cópia dos relacionamentos cadastrados.
+func (db *Database) Relationships() []entity.Relationship {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]entity.Relationship(nil), db.relationships...)
+}
+
+// Busca um relacionamento pelo ID.
+func (db *Database) FindRelationship(ID string) (entity.Relationship, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	for _, r := range db.relationships {
+		if r.ID == ID {
+			return r, true
+		}
+	}
+	return entity.Relationship{}, false
+}
+
+// Substitui o relacionamento apenas quando check, se informado, não retorna erro e as duas pessoas
+// estão cadastradas, verificando sob o mesmo lock da escrita. Retorna se o relacionamento existia e
+// se as pessoas existiam.
+func (db *Database) UpdateLinkedRelationship(ID string, relationship entity.Relationship, check func(Snapshot) error) (found, linked bool, err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, r := range db.relationships {
+		if r.ID != ID {
+			continue
+		}
+		if check != nil {
+			if err := check(db.lockedSnapshot()); err != nil {
+				return true, true, err
+			}
+		}
+		if !db.hasPersons(relationship.MainPersonID, relationship.SecundePersonID) {
+			return true, false, nil
+		}
+		db.relationships[i] = relationship
+		return true, true, nil
+	}
+	return false, false, nil
+}
+
+// Verifica se todas as pessoas estão cadastradas. Deve ser chamado com o lock obtido.
+func (db *Database) hasPersons(IDs ...string) bool {
+	for _, ID := range IDs {
+		found := false
+		for _, p := range db.persons {
+			if p.ID == ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Remove o relacionamento com o ID informado. Retorna false se ele não existir.
+func (db *Database) DeleteRelationship(ID string) bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, r := range db.relationships {
+		if r.ID == ID {
+			db.relationships = append(db.relationships[:i:i], db.relationships[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Adiciona um evento ao banco.
+func (db *Database) AddEvent(event entity.Event) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.events = append(db.events, event)
+}
+
+// Retorna uma cópia dos eventos cadastrados.
+func (db *Database) Events() []entity.Event {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]entity.Event(nil), db.events...)
+}
+
+// Busca um evento pelo ID.
+func (db *Database) FindEvent(ID string) (entity.Event, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	for _, e := range db.events {
+		if e.ID == ID {
+			return e, true
+		}
+	}
+	return entity.Event{}, false
+}
+
+// Substitui o evento com o ID informado. Retorna false se ele não existir.
+func (db *Database) UpdateEvent(ID string, event entity.Event) bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, e := range db.events {
+		if e.ID == ID {
+			db.events[i] = event
+			return true
+		}
+	}
+	return false
+}
+
+// Remove o evento com o ID informado. Retorna false se ele não existir.
+func (db *Database) DeleteEvent(ID string) bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for i, e := range db.events {
+		if e.ID == ID {
+			db.events = append(db.events[:i:i], db.events[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Remove todos os eventos da pessoa informada.
+func (db *Database) DeletePersonEvents(personID string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	events := db.events[:0:0]
+	for _, e := range db.events {
+		if e.PersonID != personID {
+			events = append(events, e)
+		}
+	}
+	db.events = events
+}
+
+// Retorna uma cópia consistente de pessoas e relacionamentos, lidas sob o mesmo lock.
+func (db *Database) PersonsAndRelationships() ([]entity.Person, []entity.Relationship) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]entity.Person(nil), db.persons...), append([]entity.Relationship(nil), db.relationships...)
+}
+
 func loadDefaultFamily(db *Database) []entity.Person {
 	martin := NewPerson(db, "Martin", "M", "", "")
 	anastasia := NewPerson(db, "Anastasia", "F", "", "")
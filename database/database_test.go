@@ -0,0 +1,203 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type DatabaseTestSuite struct {
+	suite.Suite
+	DB *Database
+}
+
+func (suite *DatabaseTestSuite) SetupTest() {
+	suite.DB = NewEmpty()
+}
+
+func (suite *DatabaseTestSuite) TestPersons() {
+	suite.Run("should add, find, update and delete a person", func() {
+		suite.DB.AddPerson(entity.Person{ID: "1", Name: "Martin", Gender: "M"})
+
+		p, ok := suite.DB.FindPerson("1")
+		suite.True(ok)
+		suite.Equal("Martin", p.Name)
+
+		suite.True(suite.DB.UpdatePerson("1", entity.Person{ID: "1", Name: "Martina", Gender: "F"}))
+		p, _ = suite.DB.FindPerson("1")
+		suite.Equal("Martina", p.Name)
+
+		found, linked := suite.DB.DeletePersonWithRelationships("1", false)
+		suite.True(found)
+		suite.False(linked)
+		_, ok = suite.DB.FindPerson("1")
+		suite.False(ok)
+	})
+
+	suite.Run("should return false when the person does not exist", func() {
+		suite.False(suite.DB.UpdatePerson("unknown", entity.Person{}))
+		found, _ := suite.DB.DeletePersonWithRelationships("unknown", false)
+		suite.False(found)
+	})
+
+	suite.Run("should return a copy that is not affected by later writes", func() {
+		suite.DB.AddPerson(entity.Person{ID: "2", Name: "Anastasia"})
+		persons := suite.DB.Persons()
+		suite.DB.DeletePersonWithRelationships("2", false)
+		suite.Len(persons, 1)
+		suite.Equal("Anastasia", persons[0].Name)
+	})
+}
+
+func (suite *DatabaseTestSuite) TestRelationships() {
+	suite.Run("should add, find, update and delete a relationship", func() {
+		for _, ID := range []string{"a", "b", "c"} {
+			suite.DB.AddPerson(entity.Person{ID: ID})
+		}
+		suite.DB.AddRelationship(entity.Relationship{ID: "1", MainPersonID: "a", SecundePersonID: "b"})
+
+		r, ok := suite.DB.FindRelationship("1")
+		suite.True(ok)
+		suite.Equal("b", r.SecundePersonID)
+
+		found, linked, err := suite.DB.UpdateLinkedRelationship("1", entity.Relationship{ID: "1", MainPersonID: "a", SecundePersonID: "c"}, nil)
+		suite.NoError(err)
+		suite.True(found)
+		suite.True(linked)
+		r, _ = suite.DB.FindRelationship("1")
+		suite.Equal("c", r.SecundePersonID)
+
+		suite.True(suite.DB.DeleteRelationship("1"))
+		suite.Empty(suite.DB.Relationships())
+	})
+}
+
+func (suite *DatabaseTestSuite) TestReferentialIntegrity() {
+	suite.DB.AddPerson(entity.Person{ID: "a"})
+	suite.DB.AddPerson(entity.Person{ID: "b"})
+	suite.DB.AddEvent(entity.Event{ID: "e", PersonID: "a"})
+
+	suite.Run("should only link persons that exist", func() {
+		linked, err := suite.DB.AddLinkedRelationship(entity.Relationship{ID: "1", MainPersonID: "a", SecundePersonID: "unknown"}, nil)
+		suite.NoError(err)
+		suite.False(linked)
+		linked, err = suite.DB.AddLinkedRelationship(entity.Relationship{ID: "1", MainPersonID: "a", SecundePersonID: "b"}, nil)
+		suite.NoError(err)
+		suite.True(linked)
+
+		found, linked, err := suite.DB.UpdateLinkedRelationship("1", entity.Relationship{ID: "1", MainPersonID: "a", SecundePersonID: "unknown"}, nil)
+		suite.NoError(err)
+		suite.True(found)
+		suite.False(linked)
+		r, _ := suite.DB.FindRelationship("1")
+		suite.Equal("b", r.SecundePersonID)
+
+		found, _, _ = suite.DB.UpdateLinkedRelationship("unknown", entity.Relationship{}, nil)
+		suite.False(found)
+	})
+
+	suite.Run("should check the data under the write lock", func() {
+		checkErr := errors.New("check failed")
+		check := func(s Snapshot) error {
+			suite.Len(s.Persons, 2)
+			suite.Equal([]entity.Event{{ID: "e", PersonID: "a"}}, s.Events)
+			suite.Len(s.Relationships, 1)
+			return checkErr
+		}
+
+		_, err := suite.DB.AddLinkedRelationship(entity.Relationship{ID: "2", MainPersonID: "b", SecundePersonID: "a"}, check)
+		suite.ErrorIs(err, checkErr)
+		_, _, err = suite.DB.UpdateLinkedRelationship("1", entity.Relationship{ID: "1", MainPersonID: "b", SecundePersonID: "a"}, check)
+		suite.ErrorIs(err, checkErr)
+
+		r, _ := suite.DB.FindRelationship("1")
+		suite.Equal("b", r.SecundePersonID)
+		suite.Len(suite.DB.Relationships(), 1)
+	})
+
+	suite.Run("should keep a linked person without cascade", func() {
+		found, linked := suite.DB.DeletePersonWithRelationships("a", false)
+		suite.True(found)
+		suite.True(linked)
+		_, ok := suite.DB.FindPerson("a")
+		suite.True(ok)
+		suite.Len(suite.DB.Relationships(), 1)
+	})
+
+	suite.Run("should delete the person with relationships and events on cascade", func() {
+		found, linked := suite.DB.DeletePersonWithRelationships("a", true)
+		suite.True(found)
+		suite.True(linked)
+		_, ok := suite.DB.FindPerson("a")
+		suite.False(ok)
+		suite.Empty(suite.DB.Relationships())
+		suite.Empty(suite.DB.Events())
+	})
+
+	suite.Run("should return not found for an unknown person", func() {
+		found, _ := suite.DB.DeletePersonWithRelationships("unknown", true)
+		suite.False(found)
+	})
+}
+
+func (suite *DatabaseTestSuite) TestEvents() {
+	suite.Run("should add, find, update and delete an event", func() {
+		suite.DB.AddEvent(entity.Event{ID: "1", PersonID: "a", Type: entity.EventBirth, Date: "1890"})
+
+		e, ok := suite.DB.FindEvent("1")
+		suite.True(ok)
+		suite.Equal("1890", e.Date)
+
+		suite.True(suite.DB.UpdateEvent("1", entity.Event{ID: "1", PersonID: "a", Type: entity.EventBirth, Date: "ABT 1891"}))
+		e, _ = suite.DB.FindEvent("1")
+		suite.Equal("ABT 1891", e.Date)
+
+		suite.True(suite.DB.DeleteEvent("1"))
+		suite.Empty(suite.DB.Events())
+	})
+
+	suite.Run("should delete only the events of the given person", func() {
+		suite.DB.AddEvent(entity.Event{ID: "2", PersonID: "a"})
+		suite.DB.AddEvent(entity.Event{ID: "3", PersonID: "b"})
+		suite.DB.AddEvent(entity.Event{ID: "4", PersonID: "a"})
+
+		suite.DB.DeletePersonEvents("a")
+
+		events := suite.DB.Events()
+		suite.Len(events, 1)
+		suite.Equal("3", events[0].ID)
+	})
+}
+
+func (suite *DatabaseTestSuite) TestConcurrentAccess() {
+	suite.Run("should keep every write when many goroutines use the database", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				id := fmt.Sprint(i)
+				suite.DB.AddPerson(entity.Person{ID: id})
+				suite.DB.AddRelationship(entity.Relationship{ID: id, MainPersonID: id})
+				_, _ = suite.DB.PersonsAndRelationships()
+				if i%2 == 0 {
+					suite.DB.DeleteRelationship(id)
+					suite.DB.DeletePersonWithRelationships(id, false)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		persons, relationships := suite.DB.PersonsAndRelationships()
+		suite.Len(persons, 50)
+		suite.Len(relationships, 50)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseTestSuite))
+}
@@ -0,0 +1,186 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS persons (
+	id     TEXT PRIMARY KEY,
+	name   TEXT NOT NULL,
+	gender TEXT NOT NULL,
+	level  INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_persons_name ON persons (name COLLATE NOCASE);
+
+CREATE TABLE IF NOT EXISTS relationships (
+	id                TEXT PRIMARY KEY,
+	main_person_id    TEXT NOT NULL,
+	secunde_person_id TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_relationships_main_person_id ON relationships (main_person_id);
+CREATE INDEX IF NOT EXISTS idx_relationships_secunde_person_id ON relationships (secunde_person_id);
+
+CREATE TABLE IF NOT EXISTS events (
+	id          TEXT PRIMARY KEY,
+	person_id   TEXT NOT NULL,
+	type        TEXT NOT NULL,
+	date        TEXT NOT NULL DEFAULT '',
+	place       TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_person_id ON events (person_id);
+`
+
+// Colunas adicionadas depois da primeira versão do schema, aplicadas em bancos já existentes.
+var migrations = []struct {
+	table, column, definition string
+}{
+	{"relationships", "kind", "TEXT NOT NULL DEFAULT 'parent'"},
+	{"relationships", "start_date", "TEXT"},
+	{"relationships", "start_place", "TEXT"},
+	{"relationships", "end_date", "TEXT"},
+	{"relationships", "end_place", "TEXT"},
+	{"relationships", "parentage", "TEXT NOT NULL DEFAULT ''"},
+}
+
+// Colunas da tabela relationships na ordem esperada por ScanRelationship.
+const RelationshipColumns = "id, kind, main_person_id, secunde_person_id, start_date, start_place, end_date, end_place, parentage"
+
+// Colunas da tabela events na ordem esperada por ScanEvent.
+const PersonEventColumns = "id, person_id, type, date, place, description"
+
+// Abre a conexão com o SQLite e cria o schema caso ainda não exista.
+func NewSQLite(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite error: %w", err)
+	}
+
+	// O SQLite aceita apenas um escritor por vez, uma única conexão evita erros de SQLITE_BUSY.
+	db.SetMaxOpenConns(1)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping sqlite error: %w", err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("create sqlite schema error: %w", err)
+	}
+
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func migrate(db *sql.DB) error {
+	for _, m := range migrations {
+		exists, err := columnExists(db, m.table, m.column)
+		if err != nil {
+			return fmt.Errorf("migrate sqlite schema error: %w", err)
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", m.table, m.column, m.definition)); err != nil {
+			return fmt.Errorf("migrate sqlite schema error: %w", err)
+		}
+	}
+	return nil
+}
+
+func columnExists(db *sql.DB, table, column string) (bool, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name, kind string
+			notNull    int
+			dflt       sql.NullString
+			pk         int
+		)
+		if err := rows.Scan(&cid, &name, &kind, &notNull, &dflt, &pk); err != nil {
+			return false, err
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// Lê um relacionamento selecionado com RelationshipColumns.
+func ScanRelationship(s scanner) (*entity.Relationship, error) {
+	var (
+		r                     entity.Relationship
+		startDate, startPlace sql.NullString
+		endDate, endPlace     sql.NullString
+	)
+	if err := s.Scan(&r.ID, &r.Kind, &r.MainPersonID, &r.SecundePersonID, &startDate, &startPlace, &endDate, &endPlace, &r.Parentage); err != nil {
+		return nil, err
+	}
+	r.Start = eventFromColumns(startDate, startPlace)
+	r.End = eventFromColumns(endDate, endPlace)
+	return &r, nil
+}
+
+// Lê um evento da vida de uma pessoa selecionado com PersonEventColumns.
+func ScanEvent(s scanner) (*entity.Event, error) {
+	var e entity.Event
+	if err := s.Scan(&e.ID, &e.PersonID, &e.Type, &e.Date, &e.Place, &e.Description); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Converte um evento nas colunas de data e local. Um evento nil é gravado como NULL.
+func EventColumns(event *entity.RelationshipEvent) (date, place sql.NullString) {
+	if event == nil {
+		return date, place
+	}
+	return sql.NullString{String: event.Date, Valid: true}, sql.NullString{String: event.Place, Valid: true}
+}
+
+func eventFromColumns(date, place sql.NullString) *entity.RelationshipEvent {
+	if !date.Valid && !place.Valid {
+		return nil
+	}
+	return &entity.RelationshipEvent{Date: date.String, Place: place.String}
+}
+
+// Valor de LIMIT da página. No SQLite, -1 retorna todos os registros a partir do OFFSET.
+func Limit(page entity.Page) int {
+	if page.Limit <= 0 {
+		return -1
+	}
+	return page.Limit
+}
+
+// Escapa os curingas do LIKE, usando \ como caractere de escape.
+func EscapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
@@ -15,9 +15,35 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/familytree/kinship/distance/{firstPersonName}/{secondPersonName}": {
+        "/export/gedcom": {
+            "get": {
+                "description": "Export all persons and relationships as a GEDCOM 5.5.1 file. Parent pairs and unions are grouped into FAM records.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Export to GEDCOM",
+                "responses": {
+                    "200": {
+                        "description": "GEDCOM file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/familytree/coefficients/{firstPersonName}/{secondPersonName}": {
             "get": {
-                "description": "Determine kinship distance",
+                "description": "Wright's coefficient of relationship between two persons, their kinship coefficient and the inbreeding coefficient of each one. Only genetic parents (biological and donor) are considered and ancestors reached by more than one path (pedigree collapse) are counted once per path.",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -29,7 +55,7 @@ const docTemplate = `{
                 "tags": [
                     "familytree"
                 ],
-                "summary": "Determine kinship distance",
+                "summary": "Coefficient of relationship",
                 "parameters": [
                     {
                         "type": "string",
@@ -50,27 +76,39 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.KinshipDistanceResponse"
+                            "$ref": "#/definitions/presenter.CoefficientsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name matches more than one person",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
         },
-        "/familytree/members/{personName}": {
+        "/familytree/common-ancestors/{firstPersonName}/{secondPersonName}": {
             "get": {
-                "description": "Find family members",
+                "description": "List the ancestors shared by two persons, nearest first, with the number of generations from each person and the most recent common ancestors marked. A person who is an ancestor of the other is their common ancestor, zero generations away from themselves. Persons without shared ancestors return related false.",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -82,12 +120,19 @@ const docTemplate = `{
                 "tags": [
                     "familytree"
                 ],
-                "summary": "Find family members",
+                "summary": "Common ancestors",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Person Name",
-                        "name": "personName",
+                        "description": "First Person Name",
+                        "name": "firstPersonName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second Person Name",
+                        "name": "secondPersonName",
                         "in": "path",
                         "required": true
                     }
@@ -96,27 +141,39 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.FamilyTreeResponse"
+                            "$ref": "#/definitions/presenter.CommonAncestorsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name matches more than one person",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
         },
-        "/familytree/relationship/{firstPersonName}/{secondPersonName}": {
+        "/familytree/health-report": {
             "get": {
-                "description": "Determine relationship",
+                "description": "Scan all persons and relationships for data-quality issues: orphaned relationships, dangling person IDs, missing genders, likely duplicate people and disconnected components. Each issue has a severity (error, warning or info) and a reference to the offending entity.",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -128,7 +185,38 @@ const docTemplate = `{
                 "tags": [
                     "familytree"
                 ],
-                "summary": "Determine relationship",
+                "summary": "Tree health report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.HealthReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/familytree/kinship/distance/{firstPersonName}/{secondPersonName}": {
+            "get": {
+                "description": "Determine the kinship distance as the shortest path over parent and child links, with the ordered chain of people and the direction of each link (child_of or parent_of)",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "familytree"
+                ],
+                "summary": "Determine kinship distance",
                 "parameters": [
                     {
                         "type": "string",
@@ -149,44 +237,79 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.DetermineRelationResponse"
+                            "$ref": "#/definitions/presenter.KinshipDistanceResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name matches more than one person",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
         },
-        "/person": {
+        "/familytree/members/{personName}": {
             "get": {
-                "description": "List persons",
+                "description": "Find family members. With Accept text/vnd.graphviz the tree is returned as a DOT graph and with image/svg+xml as an SVG chart.",
                 "consumes": [
                     "application/json",
                     "text/xml"
                 ],
                 "produces": [
                     "application/json",
-                    "text/xml"
+                    "text/xml",
+                    "text/vnd.graphviz",
+                    "image/svg+xml"
                 ],
                 "tags": [
-                    "person"
+                    "familytree"
                 ],
-                "summary": "List persons",
+                "summary": "Find family members",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by person's lasted name (no implemeted)",
-                        "name": "name",
+                        "description": "Person Name",
+                        "name": "personName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pedigree",
+                            "descendants"
+                        ],
+                        "type": "string",
+                        "description": "Graph restricted to the ancestors (pedigree) or descendants of the person",
+                        "name": "chart",
                         "in": "query"
                     }
                 ],
@@ -194,82 +317,121 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presenter.PersonResponse"
-                            }
+                            "$ref": "#/definitions/presenter.FamilyTreeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "No acceptable representation",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name matches more than one person",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a person",
-                "consumes": [
-                    "application/json",
-                    "text/xml"
-                ],
+            }
+        },
+        "/familytree/members/{personName}/gedcom": {
+            "get": {
+                "description": "Export a person and the relatives found in their family tree as a GEDCOM 5.5.1 file",
                 "produces": [
-                    "application/json",
-                    "text/xml"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "person"
+                    "familytree"
                 ],
-                "summary": "Create a person",
+                "summary": "Export family members to GEDCOM",
                 "parameters": [
                     {
-                        "description": "Person",
-                        "name": "person",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presenter.PersonRequest"
-                        }
+                        "type": "string",
+                        "description": "Person Name",
+                        "name": "personName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "GEDCOM file",
                         "schema": {
-                            "$ref": "#/definitions/presenter.PersonResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name matches more than one person",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
         },
-        "/person/{id}": {
+        "/familytree/persons/{id}/ahnentafel": {
             "get": {
-                "description": "Get a person",
+                "description": "Number the ancestors of the person by the Ahnentafel (Sosa-Stradonitz) system: the person is 1, the father of n is 2n and the mother 2n+1. With Accept text/plain the list is returned as a printable report.",
                 "consumes": [
                     "application/json",
                     "text/xml"
                 ],
                 "produces": [
                     "application/json",
-                    "text/xml"
+                    "text/xml",
+                    "text/plain"
                 ],
                 "tags": [
-                    "person"
+                    "familytree"
                 ],
-                "summary": "Get a person",
+                "summary": "Ahnentafel numbering",
                 "parameters": [
                     {
                         "type": "string",
@@ -277,31 +439,61 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of generations, from 1 to 10 (default 10)",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.PersonResponse"
+                            "$ref": "#/definitions/presenter.NumberingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "404": {
                         "description": "Person not found",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "No acceptable representation",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a person",
+            }
+        },
+        "/familytree/persons/{id}/ancestors": {
+            "get": {
+                "description": "List the ancestors of the person grouped by generation, starting with the person at level 0, up to the given depth",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -311,9 +503,9 @@ const docTemplate = `{
                     "text/xml"
                 ],
                 "tags": [
-                    "person"
+                    "familytree"
                 ],
-                "summary": "Update a person",
+                "summary": "Ancestors by generation",
                 "parameters": [
                     {
                         "type": "string",
@@ -323,44 +515,53 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Person",
-                        "name": "person",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presenter.PersonRequest"
-                        }
+                        "type": "integer",
+                        "description": "Number of generations, from 1 to 10 (default 10)",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.PersonResponse"
+                            "$ref": "#/definitions/presenter.GenerationsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "404": {
                         "description": "Person not found",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a person",
+            }
+        },
+        "/familytree/persons/{id}/coefficients/{otherId}": {
+            "get": {
+                "description": "Wright's coefficient of relationship, kinship coefficient and inbreeding coefficients of the persons with the given IDs",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -370,40 +571,56 @@ const docTemplate = `{
                     "text/xml"
                 ],
                 "tags": [
-                    "person"
+                    "familytree"
                 ],
-                "summary": "Delete a person",
+                "summary": "Coefficient of relationship by person IDs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Person ID",
+                        "description": "First Person ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second Person ID",
+                        "name": "otherId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.CoefficientsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
                     },
                     "404": {
                         "description": "Person not found",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
         },
-        "/relationship": {
+        "/familytree/persons/{id}/common-ancestors/{otherId}": {
             "get": {
-                "description": "List relationships",
+                "description": "List the ancestors shared by the persons with the given IDs, nearest first, with the most recent common ancestors marked",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -413,77 +630,131 @@ const docTemplate = `{
                     "text/xml"
                 ],
                 "tags": [
-                    "relationship"
+                    "familytree"
                 ],
-                "summary": "List relationships",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
-                            }
-                        }
-                    },
+                "summary": "Common ancestors by person IDs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second Person ID",
+                        "name": "otherId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.CommonAncestorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a relationship",
+            }
+        },
+        "/familytree/persons/{id}/daboville": {
+            "get": {
+                "description": "Number the descendants of the person by the d'Aboville system: the person is 1 and the children of n are n.1, n.2... in birth order. With Accept text/plain the list is returned as a printable report.",
                 "consumes": [
                     "application/json",
                     "text/xml"
                 ],
                 "produces": [
                     "application/json",
-                    "text/xml"
+                    "text/xml",
+                    "text/plain"
                 ],
                 "tags": [
-                    "relationship"
+                    "familytree"
                 ],
-                "summary": "Create a relationship",
+                "summary": "d'Aboville numbering",
                 "parameters": [
                     {
-                        "description": "Relationship",
-                        "name": "relationship",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presenter.PaternityRelationshipRequest"
-                        }
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of generations, from 1 to 10 (default 10)",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                            "$ref": "#/definitions/presenter.NumberingResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "No acceptable representation",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
         },
-        "/relationship/{id}": {
+        "/familytree/persons/{id}/descendants": {
             "get": {
-                "description": "Get a relationship",
+                "description": "List the descendants of the person grouped by generation, starting with the person at level 0, up to the given depth",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -493,41 +764,122 @@ const docTemplate = `{
                     "text/xml"
                 ],
                 "tags": [
-                    "relationship"
+                    "familytree"
                 ],
-                "summary": "Get a relationship",
+                "summary": "Descendants by generation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Relationship ID",
+                        "description": "Person ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of generations, from 1 to 10 (default 10)",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                            "$ref": "#/definitions/presenter.GenerationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "404": {
-                        "description": "Relationship not found",
+                        "description": "Person not found",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a relationship",
+            }
+        },
+        "/familytree/persons/{id}/gedcom": {
+            "get": {
+                "description": "Export the person with the given ID and the relatives found in their family tree as a GEDCOM 5.5.1 file",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "familytree"
+                ],
+                "summary": "Export family members to GEDCOM by person ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GEDCOM file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/familytree/persons/{id}/kinship/distance/{otherId}": {
+            "get": {
+                "description": "Determine the kinship distance between the persons with the given IDs as the shortest path over parent and child links, with the ordered chain of people",
                 "consumes": [
                     "application/json",
                     "text/xml"
@@ -537,122 +889,1980 @@ const docTemplate = `{
                     "text/xml"
                 ],
                 "tags": [
-                    "relationship"
+                    "familytree"
                 ],
-                "summary": "Update a relationship",
+                "summary": "Determine kinship distance by person IDs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Relationship ID",
+                        "description": "First Person ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Relationship",
-                        "name": "relationship",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presenter.PaternityRelationshipRequest"
-                        }
+                        "type": "string",
+                        "description": "Second Person ID",
+                        "name": "otherId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                            "$ref": "#/definitions/presenter.KinshipDistanceResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "404": {
-                        "description": "Relationship not found",
+                        "description": "Person not found",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a relationship",
+            }
+        },
+        "/familytree/persons/{id}/members": {
+            "get": {
+                "description": "Find family members of the person with the given ID. With Accept text/vnd.graphviz the tree is returned as a DOT graph and with image/svg+xml as an SVG chart.",
                 "consumes": [
                     "application/json",
                     "text/xml"
                 ],
                 "produces": [
                     "application/json",
-                    "text/xml"
+                    "text/xml",
+                    "text/vnd.graphviz",
+                    "image/svg+xml"
                 ],
                 "tags": [
-                    "relationship"
+                    "familytree"
                 ],
-                "summary": "Delete a relationship",
+                "summary": "Find family members by person ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Relationship ID",
+                        "description": "Person ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "biological",
+                            "legal"
+                        ],
+                        "type": "string",
+                        "description": "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pedigree",
+                            "descendants"
+                        ],
+                        "type": "string",
+                        "description": "Graph restricted to the ancestors (pedigree) or descendants of the person",
+                        "name": "chart",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.FamilyTreeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
                     },
                     "404": {
-                        "description": "Relationship not found",
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "No acceptable representation",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/gin.errorResponse"
+                            "$ref": "#/definitions/presenter.ProblemResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "gin.errorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                }
-            }
         },
-        "presenter.DetermineRelationResponse": {
-            "type": "object",
-            "properties": {
-                "relationship": {
-                    "type": "string"
+        "/familytree/persons/{id}/relationship/{otherId}": {
+            "get": {
+                "description": "Determine the relationship between the persons with the given IDs",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "familytree"
+                ],
+                "summary": "Determine relationship by person IDs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second Person ID",
+                        "name": "otherId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.DetermineRelationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/familytree/relationship/{firstPersonName}/{secondPersonName}": {
+            "get": {
+                "description": "Determine relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "familytree"
+                ],
+                "summary": "Determine relationship",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First Person Name",
+                        "name": "firstPersonName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second Person Name",
+                        "name": "secondPersonName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.DetermineRelationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name matches more than one person",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/gedcom": {
+            "post": {
+                "description": "Import persons and relationships from a GEDCOM 5.5.1 file, sent as the request body or as the multipart field \"file\". Each individual becomes a new person, unless merge=true links it to the registered person with the same name.",
+                "consumes": [
+                    "text/plain",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "import"
+                ],
+                "summary": "Import a GEDCOM file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "GEDCOM file",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Link individuals to the registered persons with the same name",
+                        "name": "merge",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ImportSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/person": {
+            "get": {
+                "description": "List persons page by page, filtered by name, gender, parent or child",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "List persons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by part of the person's name, ignoring case",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "F",
+                            "M"
+                        ],
+                        "type": "string",
+                        "description": "Filter by gender",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List the children of this parent",
+                        "name": "parentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List the parents of this child",
+                        "name": "childId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "Sort field, registration order when omitted",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of persons to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PersonsPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Create a person",
+                "parameters": [
+                    {
+                        "description": "Person",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/person/{id}": {
+            "get": {
+                "description": "Get a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get a person",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PersonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Update a person",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Person",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a person. A person with relationships is only deleted with cascade=true, which also deletes the relationships",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Delete a person",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the person's relationships",
+                        "name": "cascade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid cascade",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Person has relationships",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/person/{id}/events": {
+            "get": {
+                "description": "List the life events of a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "List life events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presenter.EventResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a birth, baptism, marriage, death, burial, residence or custom event for a person. Dates may be partial (1890, 1890-05), approximate (ABT 1890), bounded (BEF 1900) or ranges (BET 1900 AND 1905).",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Create a life event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.EventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/person/{id}/events/{eventId}": {
+            "get": {
+                "description": "Get a life event of a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get a life event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.EventResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or event not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a life event of a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Update a life event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.EventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or event not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a life event of a person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Delete a life event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Person or event not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/relationship": {
+            "get": {
+                "description": "List parent relationships page by page, filtered by parent or child",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "List relationships",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "List the relationships of this parent",
+                        "name": "parentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List the relationships of this child",
+                        "name": "childId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List the relationships of this person, as parent or child",
+                        "name": "personId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Registration order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of relationships to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PaternityRelationshipsPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Create a relationship",
+                "parameters": [
+                    {
+                        "description": "Relationship",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PaternityRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Inconsistent tree",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/relationship/partner": {
+            "get": {
+                "description": "List spouse or partner relationships page by page, optionally of a single person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "List unions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "List the unions of this person",
+                        "name": "personId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Registration order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of unions to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipsPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a spouse or partner relationship. For spouses, start is the marriage and end the divorce.",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Create a union",
+                "parameters": [
+                    {
+                        "description": "Union",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Inconsistent tree",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/relationship/partner/{id}": {
+            "get": {
+                "description": "Get a spouse or partner relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Get a union",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a spouse or partner relationship, e.g. to register a divorce",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Update a union",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Union",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Inconsistent tree",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a spouse or partner relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Delete a union",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/relationship/spouse": {
+            "get": {
+                "description": "List spouse or partner relationships page by page, optionally of a single person",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "List unions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "List the unions of this person",
+                        "name": "personId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Registration order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of unions to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipsPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a spouse or partner relationship. For spouses, start is the marriage and end the divorce.",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Create a union",
+                "parameters": [
+                    {
+                        "description": "Union",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Inconsistent tree",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/relationship/spouse/{id}": {
+            "get": {
+                "description": "Get a spouse or partner relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Get a union",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a spouse or partner relationship, e.g. to register a divorce",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Update a union",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Union",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Inconsistent tree",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a spouse or partner relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Delete a union",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/relationship/{id}": {
+            "get": {
+                "description": "Get a relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Get a relationship",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Update a relationship",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Relationship",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PaternityRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Inconsistent tree",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a relationship",
+                "consumes": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
+                "tags": [
+                    "relationship"
+                ],
+                "summary": "Delete a relationship",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ProblemResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "presenter.CoefficientsResponse": {
+            "type": "object",
+            "properties": {
+                "firstInbreeding": {
+                    "description": "Coeficientes de endogamia (F) da primeira e da segunda pessoa.",
+                    "type": "number"
+                },
+                "kinship": {
+                    "type": "number"
+                },
+                "relationship": {
+                    "description": "Coeficiente de parentesco de Wright (r).",
+                    "type": "number"
+                },
+                "secondInbreeding": {
+                    "type": "number"
+                }
+            }
+        },
+        "presenter.CommonAncestorResponse": {
+            "type": "object",
+            "properties": {
+                "firstGeneration": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mostRecent": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secondGeneration": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.CommonAncestorsResponse": {
+            "type": "object",
+            "properties": {
+                "ancestors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CommonAncestorResponse"
+                    }
+                },
+                "related": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presenter.DetermineRelationResponse": {
+            "type": "object",
+            "properties": {
+                "relationship": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.EntityRefResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.EventRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "place": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "birth",
+                        "baptism",
+                        "marriage",
+                        "death",
+                        "burial",
+                        "residence",
+                        "custom"
+                    ]
+                }
+            }
+        },
+        "presenter.EventResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "place": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
         "presenter.FamilyTreeResponse": {
             "type": "object",
             "properties": {
-                "members": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.Member"
+                    }
+                }
+            }
+        },
+        "presenter.GenerationResponse": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "integer"
+                },
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.PersonResponse"
+                    }
+                }
+            }
+        },
+        "presenter.GenerationsResponse": {
+            "type": "object",
+            "properties": {
+                "depth": {
+                    "type": "integer"
+                },
+                "generations": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/presenter.Member"
+                        "$ref": "#/definitions/presenter.GenerationResponse"
+                    }
+                }
+            }
+        },
+        "presenter.HealthIssueResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "entity": {
+                    "$ref": "#/definitions/presenter.EntityRefResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "related": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.EntityRefResponse"
+                    }
+                },
+                "severity": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.HealthReportResponse": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "integer"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.HealthIssueResponse"
+                    }
+                },
+                "persons": {
+                    "type": "integer"
+                },
+                "relationships": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "$ref": "#/definitions/presenter.HealthSummaryResponse"
+                }
+            }
+        },
+        "presenter.HealthSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "integer"
+                },
+                "infos": {
+                    "type": "integer"
+                },
+                "warnings": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.ImportCountsResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "invalid": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.ImportIssueResponse": {
+            "type": "object",
+            "properties": {
+                "line": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "record": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.ImportSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ImportIssueResponse"
                     }
+                },
+                "persons": {
+                    "$ref": "#/definitions/presenter.ImportCountsResponse"
+                },
+                "relationships": {
+                    "$ref": "#/definitions/presenter.ImportCountsResponse"
                 }
             }
         },
@@ -661,12 +2871,42 @@ const docTemplate = `{
             "properties": {
                 "distance": {
                     "type": "integer"
+                },
+                "explanation": {
+                    "description": "Caminho legível, ex.: Bruce → (child of) Phoebe → (child of) Martin.",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.KinshipStepResponse"
+                    }
+                },
+                "related": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presenter.KinshipStepResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "relation": {
+                    "type": "string"
                 }
             }
         },
         "presenter.Member": {
             "type": "object",
             "properties": {
+                "inLaw": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -681,6 +2921,63 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.NumberedPersonResponse": {
+            "type": "object",
+            "properties": {
+                "birth": {
+                    "type": "string"
+                },
+                "death": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "generation": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.NumberingResponse": {
+            "type": "object",
+            "properties": {
+                "depth": {
+                    "type": "integer"
+                },
+                "numbering": {
+                    "type": "string"
+                },
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.NumberedPersonResponse"
+                    }
+                }
+            }
+        },
+        "presenter.PaginationResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "presenter.PaternityRelationshipRequest": {
             "type": "object",
             "required": [
@@ -693,6 +2990,17 @@ const docTemplate = `{
                 },
                 "parent": {
                     "type": "string"
+                },
+                "parentage": {
+                    "description": "Tipo de paternidade, biológica quando não informado.",
+                    "type": "string",
+                    "enum": [
+                        "biological",
+                        "adoptive",
+                        "foster",
+                        "surrogate",
+                        "donor"
+                    ]
                 }
             }
         },
@@ -707,6 +3015,23 @@ const docTemplate = `{
                 },
                 "parent": {
                     "type": "string"
+                },
+                "parentage": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.PaternityRelationshipsPageResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/presenter.PaginationResponse"
+                },
+                "relationships": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.PaternityRelationshipResponse"
+                    }
                 }
             }
         },
@@ -717,6 +3042,13 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "events": {
+                    "description": "Eventos da vida da pessoa. Na atualização, substituem os eventos atuais quando informados.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.EventRequest"
+                    }
+                },
                 "gender": {
                     "type": "string",
                     "enum": [
@@ -732,6 +3064,12 @@ const docTemplate = `{
         "presenter.PersonResponse": {
             "type": "object",
             "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.EventResponse"
+                    }
+                },
                 "gender": {
                     "type": "string"
                 },
@@ -743,6 +3081,55 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.PersonsPageResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/presenter.PaginationResponse"
+                },
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.PersonResponse"
+                    }
+                }
+            }
+        },
+        "presenter.ProblemResponse": {
+            "type": "object",
+            "properties": {
+                "candidates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.PersonResponse"
+                    }
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ViolationResponse"
+                    }
+                }
+            }
+        },
         "presenter.Relationship": {
             "type": "object",
             "properties": {
@@ -750,6 +3137,92 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "presenter.RelationshipEvent": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "place": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.UnionRelationshipRequest": {
+            "type": "object",
+            "required": [
+                "firstPerson",
+                "secondPerson"
+            ],
+            "properties": {
+                "end": {
+                    "$ref": "#/definitions/presenter.RelationshipEvent"
+                },
+                "firstPerson": {
+                    "type": "string"
+                },
+                "secondPerson": {
+                    "type": "string"
+                },
+                "start": {
+                    "$ref": "#/definitions/presenter.RelationshipEvent"
+                }
+            }
+        },
+        "presenter.UnionRelationshipResponse": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "$ref": "#/definitions/presenter.RelationshipEvent"
+                },
+                "firstPerson": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "secondPerson": {
+                    "type": "string"
+                },
+                "start": {
+                    "$ref": "#/definitions/presenter.RelationshipEvent"
+                }
+            }
+        },
+        "presenter.UnionRelationshipsPageResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/presenter.PaginationResponse"
+                },
+                "relationships": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.UnionRelationshipResponse"
+                    }
+                }
+            }
+        },
+        "presenter.ViolationResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+var (
+	ErrPersonNotFound = entity.NewNotFoundError("person not found")
+	ErrEventNotFound  = entity.NewNotFoundError("event not found")
+)
+
+type Repository interface {
+	Create(ctx context.Context, event *entity.Event) error
+	Get(ctx context.Context, personID, ID string) (*entity.Event, error)
+	List(ctx context.Context, personID string) ([]*entity.Event, error)
+	Update(ctx context.Context, ID string, event *entity.Event) error
+	Delete(ctx context.Context, ID string) error
+}
+
+type UseCase interface {
+	Create(ctx context.Context, personID string, event *entity.Event) error
+	Get(ctx context.Context, personID, ID string) (*entity.Event, error)
+	List(ctx context.Context, personID string) ([]*entity.Event, error)
+	Update(ctx context.Context, personID, ID string, event *entity.Event) error
+	Delete(ctx context.Context, personID, ID string) error
+}
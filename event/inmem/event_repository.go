@@ -0,0 +1,76 @@
+package inmem
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	eventDomain "github.com/GeovaneCavalcante/tree-genealogical/event"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type EventRepository struct {
+	InmenDB *database.Database
+}
+
+func NewEventRepository(inmenDB *database.Database) *EventRepository {
+	return &EventRepository{
+		InmenDB: inmenDB,
+	}
+}
+
+func (r *EventRepository) Create(ctx context.Context, event *entity.Event) error {
+	logger.Info("[Repository] Create event started")
+	event.ID = uuid.New().String()
+	r.InmenDB.AddEvent(*event)
+	logger.Info("[Repository] Create event finished")
+	return nil
+}
+
+// Um evento de outra pessoa não é encontrado pela pessoa informada.
+func (r *EventRepository) Get(ctx context.Context, personID, eventID string) (*entity.Event, error) {
+	logger.Info(fmt.Sprint("[Repository] Get event by eventID: ", eventID))
+	event, ok := r.InmenDB.FindEvent(eventID)
+	if !ok || event.PersonID != personID {
+		logger.Info(fmt.Sprintf("[Repository] Get event by eventID: %s not found", eventID))
+		return nil, eventDomain.ErrEventNotFound
+	}
+	return &event, nil
+}
+
+func (r *EventRepository) List(ctx context.Context, personID string) ([]*entity.Event, error) {
+	logger.Info(fmt.Sprintf("[Repository] List event started for personID: %s", personID))
+
+	events := []*entity.Event{}
+	for _, e := range r.InmenDB.Events() {
+		if e.PersonID != personID {
+			continue
+		}
+		event := e
+		events = append(events, &event)
+	}
+
+	logger.Info("[Repository] List event finished")
+	return events, nil
+}
+
+func (r *EventRepository) Update(ctx context.Context, eventID string, event *entity.Event) error {
+	logger.Info(fmt.Sprintf("[Repository] Update event started by eventID: %s", eventID))
+	event.ID = eventID
+	if !r.InmenDB.UpdateEvent(eventID, *event) {
+		logger.Info(fmt.Sprintf("[Repository] Update event by eventID: %s not found", eventID))
+		return eventDomain.ErrEventNotFound
+	}
+	return nil
+}
+
+func (r *EventRepository) Delete(ctx context.Context, eventID string) error {
+	logger.Info(fmt.Sprintf("[Repository] Delete event started by eventID: %s", eventID))
+	if !r.InmenDB.DeleteEvent(eventID) {
+		logger.Info(fmt.Sprintf("[Repository] Delete event by eventID: %s not found", eventID))
+		return eventDomain.ErrEventNotFound
+	}
+	return nil
+}
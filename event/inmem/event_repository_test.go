@@ -0,0 +1,67 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	eventDomain "github.com/GeovaneCavalcante/tree-genealogical/event"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventRepositoryTestSuite struct {
+	suite.Suite
+	Repo *EventRepository
+}
+
+func (suite *EventRepositoryTestSuite) SetupTest() {
+	suite.Repo = NewEventRepository(database.NewEmpty())
+}
+
+func (suite *EventRepositoryTestSuite) TestCRUD() {
+	ctx := context.Background()
+	event := &entity.Event{PersonID: "1", Type: entity.EventBirth, Date: "ABT 1890"}
+
+	suite.Run("should create an event with a generated ID", func() {
+		suite.Nil(suite.Repo.Create(ctx, event))
+		suite.NotEmpty(event.ID)
+	})
+
+	suite.Run("should list only the events of the person", func() {
+		suite.Nil(suite.Repo.Create(ctx, &entity.Event{PersonID: "2", Type: entity.EventBirth}))
+		events, err := suite.Repo.List(ctx, "1")
+		suite.Nil(err)
+		suite.Len(events, 1)
+		suite.Equal(event.ID, events[0].ID)
+	})
+
+	suite.Run("should update the event", func() {
+		suite.Nil(suite.Repo.Update(ctx, event.ID, &entity.Event{PersonID: "1", Type: entity.EventBirth, Date: "1891"}))
+		found, err := suite.Repo.Get(ctx, "1", event.ID)
+		suite.Nil(err)
+		suite.Equal("1891", found.Date)
+	})
+
+	suite.Run("should not find the event through another person", func() {
+		found, err := suite.Repo.Get(ctx, "2", event.ID)
+		suite.ErrorIs(err, eventDomain.ErrEventNotFound)
+		suite.Nil(found)
+	})
+
+	suite.Run("should delete the event", func() {
+		suite.Nil(suite.Repo.Delete(ctx, event.ID))
+		found, err := suite.Repo.Get(ctx, "1", event.ID)
+		suite.ErrorIs(err, eventDomain.ErrEventNotFound)
+		suite.Nil(found)
+	})
+
+	suite.Run("should return event not found when updating or deleting a missing event", func() {
+		suite.ErrorIs(suite.Repo.Update(ctx, event.ID, &entity.Event{PersonID: "1"}), eventDomain.ErrEventNotFound)
+		suite.ErrorIs(suite.Repo.Delete(ctx, event.ID), eventDomain.ErrEventNotFound)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(EventRepositoryTestSuite))
+}
@@ -0,0 +1,121 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+type Service struct {
+	repo       Repository
+	PersonRepo person.Repository
+}
+
+func NewService(repo Repository, personRepo person.Repository) *Service {
+	return &Service{
+		repo:       repo,
+		PersonRepo: personRepo,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, personID string, event *entity.Event) error {
+	logger.Info(fmt.Sprintf("[Service] Create event started for personID: %s", personID))
+
+	if err := s.checkPerson(ctx, personID); err != nil {
+		return err
+	}
+
+	event.PersonID = personID
+	if err := s.repo.Create(ctx, event); err != nil {
+		logger.Error("[Service] Create event error: ", err)
+		return fmt.Errorf("create event error: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("[Service] Create event finished for personID: %s", personID))
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, personID, eventID string) (*entity.Event, error) {
+	logger.Info(fmt.Sprintf("[Service] Get event by eventID: %s", eventID))
+
+	if err := s.checkPerson(ctx, personID); err != nil {
+		return nil, err
+	}
+
+	event, err := s.repo.Get(ctx, personID, eventID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] Get event by eventID: %s error ", eventID), err)
+		return nil, fmt.Errorf("get event error: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("[Service] Get event service finished for eventID: %s", eventID))
+	return event, nil
+}
+
+func (s *Service) List(ctx context.Context, personID string) ([]*entity.Event, error) {
+	logger.Info(fmt.Sprintf("[Service] List event started for personID: %s", personID))
+
+	if err := s.checkPerson(ctx, personID); err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.List(ctx, personID)
+	if err != nil {
+		logger.Error("[Service] List event error: ", err)
+		return nil, fmt.Errorf("list event error: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("[Service] List event finished for personID: %s", personID))
+	return events, nil
+}
+
+func (s *Service) Update(ctx context.Context, personID, eventID string, event *entity.Event) error {
+	logger.Info(fmt.Sprintf("[Service] Update event started by eventID: %s", eventID))
+
+	if _, err := s.Get(ctx, personID, eventID); err != nil {
+		return err
+	}
+
+	event.PersonID = personID
+	if err := s.repo.Update(ctx, eventID, event); err != nil {
+		logger.Error(fmt.Sprintf("[Service] Update event by eventID: %s error ", eventID), err)
+		return fmt.Errorf("update event error: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("[Service] Update event service finished for eventID: %s", eventID))
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, personID, eventID string) error {
+	logger.Info(fmt.Sprintf("[Service] Delete event started by eventID: %s", eventID))
+
+	if _, err := s.Get(ctx, personID, eventID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, eventID); err != nil {
+		logger.Error(fmt.Sprintf("[Service] Delete event by eventID: %s error ", eventID), err)
+		return fmt.Errorf("delete event error: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("[Service] Delete event service finished for eventID: %s", eventID))
+	return nil
+}
+
+// Os repositórios de pessoa retornam person.ErrNotFound quando a pessoa não existe.
+func (s *Service) checkPerson(ctx context.Context, personID string) error {
+	_, err := s.PersonRepo.Get(ctx, personID)
+	if errors.Is(err, entity.ErrNotFound) {
+		logger.Info(fmt.Sprintf("[Service] Get person by personID: %s not found", personID))
+		return ErrPersonNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] Get person by personID: %s error ", personID), err)
+		return fmt.Errorf("get person error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock_event "github.com/GeovaneCavalcante/tree-genealogical/event/mock"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	mock_person "github.com/GeovaneCavalcante/tree-genealogical/person/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type EventServiceTestSuite struct {
+	suite.Suite
+	EventRepoMock  *mock_event.MockRepository
+	PersonRepoMock *mock_person.MockRepository
+	Service        *Service
+	Person         *entity.Person
+	Event          *entity.Event
+}
+
+func (suite *EventServiceTestSuite) SetupTest() {
+	ctrl := gomock.NewController(suite.T())
+	suite.EventRepoMock = mock_event.NewMockRepository(ctrl)
+	suite.PersonRepoMock = mock_person.NewMockRepository(ctrl)
+	suite.Service = NewService(suite.EventRepoMock, suite.PersonRepoMock)
+	suite.Person = &entity.Person{ID: "1", Name: "Martin", Gender: "M"}
+	suite.Event = &entity.Event{ID: "10", PersonID: "1", Type: entity.EventBirth, Date: "ABT 1890"}
+}
+
+func (suite *EventServiceTestSuite) TestCreate() {
+	ctx := context.Background()
+	suite.Run("should create the event for the person", func() {
+		event := &entity.Event{Type: entity.EventDeath, Date: "1950"}
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Create(gomock.Any(), event).Return(nil)
+		suite.Nil(suite.Service.Create(ctx, "1", event))
+		suite.Equal("1", event.PersonID)
+	})
+
+	suite.Run("should return person not found when the person does not exist", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "2").Return(nil, person.ErrNotFound)
+		err := suite.Service.Create(ctx, "2", &entity.Event{Type: entity.EventBirth})
+		suite.ErrorIs(err, ErrPersonNotFound)
+		suite.ErrorIs(err, entity.ErrNotFound)
+	})
+
+	suite.Run("should return error when the repository fails", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+		err := suite.Service.Create(ctx, "1", &entity.Event{Type: entity.EventBirth})
+		suite.Equal("create event error: database error", err.Error())
+	})
+}
+
+func (suite *EventServiceTestSuite) TestGet() {
+	ctx := context.Background()
+	suite.Run("should return the event of the person", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Get(gomock.Any(), "1", "10").Return(suite.Event, nil)
+		event, err := suite.Service.Get(ctx, "1", "10")
+		suite.Nil(err)
+		suite.Equal(suite.Event, event)
+	})
+
+	suite.Run("should return event not found when the event belongs to another person", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "3").Return(&entity.Person{ID: "3"}, nil)
+		suite.EventRepoMock.EXPECT().Get(gomock.Any(), "3", "10").Return(nil, ErrEventNotFound)
+		event, err := suite.Service.Get(ctx, "3", "10")
+		suite.ErrorIs(err, ErrEventNotFound)
+		suite.Nil(event)
+	})
+}
+
+func (suite *EventServiceTestSuite) TestList() {
+	ctx := context.Background()
+	suite.Run("should list the events of the person", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().List(gomock.Any(), "1").Return([]*entity.Event{suite.Event}, nil)
+		events, err := suite.Service.List(ctx, "1")
+		suite.Nil(err)
+		suite.Len(events, 1)
+	})
+}
+
+func (suite *EventServiceTestSuite) TestUpdate() {
+	ctx := context.Background()
+	suite.Run("should update the event", func() {
+		event := &entity.Event{Type: entity.EventBirth, Date: "1891"}
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Get(gomock.Any(), "1", "10").Return(suite.Event, nil)
+		suite.EventRepoMock.EXPECT().Update(gomock.Any(), "10", event).Return(nil)
+		suite.Nil(suite.Service.Update(ctx, "1", "10", event))
+		suite.Equal("1", event.PersonID)
+	})
+
+	suite.Run("should return event not found when the event does not exist", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Get(gomock.Any(), "1", "11").Return(nil, ErrEventNotFound)
+		err := suite.Service.Update(ctx, "1", "11", &entity.Event{})
+		suite.ErrorIs(err, ErrEventNotFound)
+	})
+}
+
+func (suite *EventServiceTestSuite) TestDelete() {
+	ctx := context.Background()
+	suite.Run("should delete the event", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Get(gomock.Any(), "1", "10").Return(suite.Event, nil)
+		suite.EventRepoMock.EXPECT().Delete(gomock.Any(), "10").Return(nil)
+		suite.Nil(suite.Service.Delete(ctx, "1", "10"))
+	})
+
+	suite.Run("should return event not found when the event does not exist", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), "1").Return(suite.Person, nil)
+		suite.EventRepoMock.EXPECT().Get(gomock.Any(), "1", "11").Return(nil, ErrEventNotFound)
+		err := suite.Service.Delete(ctx, "1", "11")
+		suite.ErrorIs(err, ErrEventNotFound)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(EventServiceTestSuite))
+}
@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	eventDomain "github.com/GeovaneCavalcante/tree-genealogical/event"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type EventRepository struct {
+	DB *sql.DB
+}
+
+func NewEventRepository(db *sql.DB) *EventRepository {
+	return &EventRepository{
+		DB: db,
+	}
+}
+
+func (r *EventRepository) Create(ctx context.Context, event *entity.Event) error {
+	logger.Info("[Repository] Create event started")
+	event.ID = uuid.New().String()
+
+	_, err := r.DB.ExecContext(ctx,
+		"INSERT INTO events ("+database.PersonEventColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+		event.ID, event.PersonID, event.Type, event.Date, event.Place, event.Description,
+	)
+	if err != nil {
+		logger.Error("[Repository] Create event error: ", err)
+		return fmt.Errorf("insert event error: %w", err)
+	}
+
+	logger.Info("[Repository] Create event finished")
+	return nil
+}
+
+// Um evento de outra pessoa não é encontrado pela pessoa informada.
+func (r *EventRepository) Get(ctx context.Context, personID, eventID string) (*entity.Event, error) {
+	logger.Info(fmt.Sprint("[Repository] Get event by eventID: ", eventID))
+
+	row := r.DB.QueryRowContext(ctx,
+		"SELECT "+database.PersonEventColumns+" FROM events WHERE id = ? AND person_id = ?",
+		eventID, personID,
+	)
+	event, err := database.ScanEvent(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Info(fmt.Sprintf("[Repository] Get event by eventID: %s not found", eventID))
+		return nil, eventDomain.ErrEventNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Get event by eventID: %s error", eventID), err)
+		return nil, fmt.Errorf("select event error: %w", err)
+	}
+
+	return event, nil
+}
+
+func (r *EventRepository) List(ctx context.Context, personID string) ([]*entity.Event, error) {
+	logger.Info(fmt.Sprintf("[Repository] List event started for personID: %s", personID))
+
+	rows, err := r.DB.QueryContext(ctx,
+		"SELECT "+database.PersonEventColumns+" FROM events WHERE person_id = ? ORDER BY rowid",
+		personID,
+	)
+	if err != nil {
+		logger.Error("[Repository] List event error: ", err)
+		return nil, fmt.Errorf("select events error: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*entity.Event{}
+	for rows.Next() {
+		event, err := database.ScanEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan event error: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select events error: %w", err)
+	}
+
+	logger.Info("[Repository] List event finished")
+	return events, nil
+}
+
+func (r *EventRepository) Update(ctx context.Context, eventID string, event *entity.Event) error {
+	logger.Info(fmt.Sprintf("[Repository] Update event started by eventID: %s", eventID))
+
+	res, err := r.DB.ExecContext(ctx,
+		"UPDATE events SET person_id = ?, type = ?, date = ?, place = ?, description = ? WHERE id = ?",
+		event.PersonID, event.Type, event.Date, event.Place, event.Description, eventID,
+	)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update event by eventID: %s error", eventID), err)
+		return fmt.Errorf("update event error: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.Info(fmt.Sprintf("[Repository] Update event by eventID: %s not found", eventID))
+		return eventDomain.ErrEventNotFound
+	}
+
+	event.ID = eventID
+	return nil
+}
+
+func (r *EventRepository) Delete(ctx context.Context, eventID string) error {
+	logger.Info(fmt.Sprintf("[Repository] Delete event started by eventID: %s", eventID))
+
+	res, err := r.DB.ExecContext(ctx, "DELETE FROM events WHERE id = ?", eventID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete event by eventID: %s error", eventID), err)
+		return fmt.Errorf("delete event error: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.Info(fmt.Sprintf("[Repository] Delete event by eventID: %s not found", eventID))
+		return eventDomain.ErrEventNotFound
+	}
+	return nil
+}
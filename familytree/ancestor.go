@@ -0,0 +1,40 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+// Lista os ancestrais compartilhados pelas duas pessoas. Retorna vazio quando elas não têm ancestral em comum.
+func (s *Service) CommonAncestors(ctx context.Context, firstPersonName, secondPersonName string) ([]*entity.CommonAncestor, error) {
+	logger.Info(fmt.Sprintf("[Service] CommonAncestors started for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
+
+	found, persons, err := s.personsByName(ctx, firstPersonName, secondPersonName)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] CommonAncestors error for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName), err)
+		return nil, err
+	}
+
+	ancestors := s.Genealogy.CommonAncestors(ctx, found[0], found[1], persons)
+
+	logger.Info(fmt.Sprintf("[Service] CommonAncestors finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
+	return ancestors, nil
+}
+
+func (s *Service) CommonAncestorsByID(ctx context.Context, firstPersonID, secondPersonID string) ([]*entity.CommonAncestor, error) {
+	logger.Info(fmt.Sprintf("[Service] CommonAncestorsByID started for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+
+	found, persons, err := s.personsByID(ctx, firstPersonID, secondPersonID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] CommonAncestorsByID error for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID), err)
+		return nil, err
+	}
+
+	ancestors := s.Genealogy.CommonAncestors(ctx, found[0], found[1], persons)
+
+	logger.Info(fmt.Sprintf("[Service] CommonAncestorsByID finished for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+	return ancestors, nil
+}
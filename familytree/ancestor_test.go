@@ -0,0 +1,75 @@
+package familytree
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *FamilytreeTestSuite) TestCommonAncestors() {
+	ctx := context.Background()
+	common := []*entity.CommonAncestor{{Person: suite.FamilyTree[1].Person, FirstGeneration: 1, SecondGeneration: 1, MostRecent: true}}
+	sibling := &entity.Person{ID: "4", Name: "Leon", Gender: "M"}
+
+	suite.Run("should return the common ancestors of the two people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(append(suite.persons(), sibling), nil)
+		suite.GenealogyMock.EXPECT().CommonAncestors(gomock.Any(), suite.PersonRoot, sibling, gomock.Any()).Return(common)
+
+		ancestors, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CommonAncestors(ctx, "john", "Leon")
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), common, ancestors)
+	})
+
+	suite.Run("should return not found when a person does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		ancestors, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CommonAncestors(ctx, "John", "Leon")
+
+		assert.Nil(suite.T(), ancestors)
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+
+	suite.Run("should reject an ambiguous name", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(append(suite.persons(), &entity.Person{ID: "5", Name: "Robert"}), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CommonAncestors(ctx, "John", "Robert")
+
+		var ambiguousErr *AmbiguousNameError
+		assert.True(suite.T(), errors.As(err, &ambiguousErr))
+	})
+
+	suite.Run("should return an error when trying to get the list of people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+
+		ancestors, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CommonAncestors(ctx, "John", "Leon")
+
+		assert.Nil(suite.T(), ancestors)
+		assert.EqualError(suite.T(), err, "get person error: error database")
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestCommonAncestorsByID() {
+	ctx := context.Background()
+
+	suite.Run("should return the common ancestors of the persons with the IDs", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().CommonAncestors(gomock.Any(), suite.PersonRoot, suite.FamilyTree[2].Person, gomock.Any()).Return(nil)
+
+		ancestors, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CommonAncestorsByID(ctx, "1", "3")
+
+		assert.Nil(suite.T(), err)
+		assert.Empty(suite.T(), ancestors)
+	})
+
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CommonAncestorsByID(ctx, "1", "9")
+
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
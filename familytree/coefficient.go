@@ -0,0 +1,40 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+// Calcula o coeficiente de parentesco de Wright e os coeficientes de endogamia das duas pessoas.
+func (s *Service) Coefficients(ctx context.Context, firstPersonName, secondPersonName string) (*entity.Coefficients, error) {
+	logger.Info(fmt.Sprintf("[Service] Coefficients started for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
+
+	found, persons, err := s.personsByName(ctx, firstPersonName, secondPersonName)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] Coefficients error for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName), err)
+		return nil, err
+	}
+
+	coefficients := s.Genealogy.Coefficients(ctx, found[0], found[1], persons)
+
+	logger.Info(fmt.Sprintf("[Service] Coefficients finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
+	return coefficients, nil
+}
+
+func (s *Service) CoefficientsByID(ctx context.Context, firstPersonID, secondPersonID string) (*entity.Coefficients, error) {
+	logger.Info(fmt.Sprintf("[Service] CoefficientsByID started for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+
+	found, persons, err := s.personsByID(ctx, firstPersonID, secondPersonID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] CoefficientsByID error for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID), err)
+		return nil, err
+	}
+
+	coefficients := s.Genealogy.Coefficients(ctx, found[0], found[1], persons)
+
+	logger.Info(fmt.Sprintf("[Service] CoefficientsByID finished for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+	return coefficients, nil
+}
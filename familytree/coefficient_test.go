@@ -0,0 +1,66 @@
+package familytree
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *FamilytreeTestSuite) TestCoefficients() {
+	ctx := context.Background()
+	coefficients := &entity.Coefficients{Relationship: 0.5, Kinship: 0.25}
+
+	suite.Run("should return the coefficients of the two people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().Coefficients(gomock.Any(), suite.PersonRoot, suite.FamilyTree[1].Person, gomock.Any()).Return(coefficients)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Coefficients(ctx, "John", "Robert")
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), coefficients, result)
+	})
+
+	suite.Run("should return not found when a person does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Coefficients(ctx, "John", "Leon")
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+
+	suite.Run("should return an error when trying to get the list of people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Coefficients(ctx, "John", "Robert")
+
+		assert.Nil(suite.T(), result)
+		assert.EqualError(suite.T(), err, "get person error: error database")
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestCoefficientsByID() {
+	ctx := context.Background()
+
+	suite.Run("should return the coefficients of the persons with the IDs", func() {
+		coefficients := &entity.Coefficients{Relationship: 0.5, Kinship: 0.25}
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().Coefficients(gomock.Any(), suite.PersonRoot, suite.FamilyTree[2].Person, gomock.Any()).Return(coefficients)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CoefficientsByID(ctx, "1", "3")
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), coefficients, result)
+	})
+
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CoefficientsByID(ctx, "9", "1")
+
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
@@ -4,15 +4,38 @@ import (
 	"context"
 
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
 )
 
 type GenealogyInterface interface {
-	BuildFamilyTree(ctx context.Context, rootPerson *entity.Person, persons []*entity.Person, level int) []*entity.Relative
-	GetRelatives(ctx context.Context) []*entity.Relative
+	BuildFamilyTree(ctx context.Context, rootPerson *entity.Person, persons []*entity.Person, level int, mode entity.TreeMode) *entity.FamilyTree
+	DetermineRelationship(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) string
+	KinshipPath(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) *entity.KinshipPath
+	CommonAncestors(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) []*entity.CommonAncestor
+	Coefficients(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) *entity.Coefficients
+	Ancestors(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.Generation
+	Descendants(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.Generation
+	Ahnentafel(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.NumberedPerson
+	DAboville(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.NumberedPerson
 }
 
 type UseCase interface {
-	GetAllFamilyMembers(ctx context.Context, personName string) ([]*entity.Relative, error)
-	CalculateKinshipDistance(ctx context.Context, firstPersonName, secondPersonName string) (int, error)
+	GetAllFamilyMembers(ctx context.Context, personName string, mode entity.TreeMode) ([]*entity.Relative, error)
+	CalculateKinshipDistance(ctx context.Context, firstPersonName, secondPersonName string) (*entity.KinshipPath, error)
 	DetermineRelationship(ctx context.Context, firstPersonName, secondPersonName string) (relationship string, err error)
+	ExportGedcom(ctx context.Context) (*gedcom.Document, error)
+	ExportFamilyGedcom(ctx context.Context, personName string, mode entity.TreeMode) (*gedcom.Document, error)
+	HealthReport(ctx context.Context) (*entity.HealthReport, error)
+	GetAllFamilyMembersByID(ctx context.Context, personID string, mode entity.TreeMode) ([]*entity.Relative, error)
+	DetermineRelationshipByID(ctx context.Context, firstPersonID, secondPersonID string) (string, error)
+	CalculateKinshipDistanceByID(ctx context.Context, firstPersonID, secondPersonID string) (*entity.KinshipPath, error)
+	ExportFamilyGedcomByID(ctx context.Context, personID string, mode entity.TreeMode) (*gedcom.Document, error)
+	CommonAncestors(ctx context.Context, firstPersonName, secondPersonName string) ([]*entity.CommonAncestor, error)
+	CommonAncestorsByID(ctx context.Context, firstPersonID, secondPersonID string) ([]*entity.CommonAncestor, error)
+	Coefficients(ctx context.Context, firstPersonName, secondPersonName string) (*entity.Coefficients, error)
+	CoefficientsByID(ctx context.Context, firstPersonID, secondPersonID string) (*entity.Coefficients, error)
+	Ancestors(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error)
+	Descendants(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error)
+	Ahnentafel(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error)
+	DAboville(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error)
 }
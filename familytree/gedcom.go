@@ -0,0 +1,284 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+// PEDI correspondente a cada tipo de paternidade. O GEDCOM 5.5.1 não possui barriga de aluguel
+// nem doador, que são exportados como filiação de nascimento (sem PEDI).
+var pedigreeByParentage = map[string]string{
+	entity.ParentageAdoptive: gedcom.PedigreeAdopted,
+	entity.ParentageFoster:   gedcom.PedigreeFoster,
+}
+
+// Exporta todas as pessoas e relacionamentos cadastrados.
+func (s *Service) ExportGedcom(ctx context.Context) (*gedcom.Document, error) {
+	logger.Info("[Service] ExportGedcom started")
+
+	persons, err := s.PersonRepo.ListWithRelationships(ctx, nil)
+	if err != nil {
+		logger.Error("[Service] ExportGedcom error: ", err)
+		return nil, fmt.Errorf("get person error: %w", err)
+	}
+
+	doc := newGedcomExport(persons, entity.TreeModeAll).document()
+
+	logger.Info("[Service] ExportGedcom finished")
+	return doc, nil
+}
+
+// Exporta a pessoa e os parentes encontrados pela árvore genealógica.
+// Retorna ErrPersonNotFound quando a pessoa não existe.
+func (s *Service) ExportFamilyGedcom(ctx context.Context, personName string, mode entity.TreeMode) (*gedcom.Document, error) {
+	logger.Info(fmt.Sprintf("[Service] ExportFamilyGedcom started for personName: %s", personName))
+
+	found, persons, err := s.personsByName(ctx, personName)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] ExportFamilyGedcom error for personName: %s", personName), err)
+		return nil, err
+	}
+
+	doc := s.familyGedcom(ctx, found[0], persons, mode)
+
+	logger.Info(fmt.Sprintf("[Service] ExportFamilyGedcom finished for personName: %s", personName))
+	return doc, nil
+}
+
+// Documento com a pessoa e os parentes da sua árvore genealógica.
+func (s *Service) familyGedcom(ctx context.Context, root *entity.Person, persons []*entity.Person, mode entity.TreeMode) *gedcom.Document {
+	included := map[string]bool{}
+	for _, relative := range s.Genealogy.BuildFamilyTree(ctx, root, persons, 0, mode).Relatives() {
+		if relative.Person != nil {
+			included[relative.Person.ID] = true
+		}
+	}
+
+	var members []*entity.Person
+	for _, p := range persons {
+		if included[p.ID] {
+			members = append(members, p)
+		}
+	}
+
+	return newGedcomExport(members, mode).document()
+}
+
+// Estado de uma exportação: referências GEDCOM de cada pessoa e famílias criadas por casal.
+type gedcomExport struct {
+	persons     []*entity.Person
+	mode        entity.TreeMode
+	individuals map[string]*gedcom.Individual
+	families    map[string]*gedcom.Family
+	doc         *gedcom.Document
+}
+
+func newGedcomExport(persons []*entity.Person, mode entity.TreeMode) *gedcomExport {
+	return &gedcomExport{
+		persons:     persons,
+		mode:        mode,
+		individuals: map[string]*gedcom.Individual{},
+		families:    map[string]*gedcom.Family{},
+		doc:         &gedcom.Document{},
+	}
+}
+
+// Monta o documento. Cada união vira uma família (FAM) e os filhos são agrupados na família do
+// casal de pais, criando uma nova quando o casal não tem união cadastrada.
+func (e *gedcomExport) document() *gedcom.Document {
+	for i, p := range e.persons {
+		individual := &gedcom.Individual{
+			XRef: fmt.Sprintf("@I%d@", i+1),
+			Name: p.Name,
+		}
+		if p.Gender == "M" || p.Gender == "F" {
+			individual.Sex = p.Gender
+		}
+		addIndividualEvents(individual, p.Events)
+		e.individuals[p.ID] = individual
+		e.doc.Individuals = append(e.doc.Individuals, individual)
+	}
+
+	exported := map[string]bool{}
+	for _, p := range e.persons {
+		for _, union := range p.Unions {
+			if exported[union.ID] || e.individuals[union.MainPersonID] == nil || e.individuals[union.SecundePersonID] == nil {
+				continue
+			}
+			exported[union.ID] = true
+			e.addUnion(union)
+		}
+	}
+
+	for _, p := range e.persons {
+		e.addChild(p)
+	}
+	return e.doc
+}
+
+func (e *gedcomExport) addUnion(union *entity.Relationship) {
+	family := e.newFamily([]string{union.MainPersonID, union.SecundePersonID})
+	if union.KindOrDefault() == entity.RelationshipKindSpouse {
+		// O MARR também identifica o casamento quando não há data.
+		family.Marriage = toGedcomEvent(union.Start)
+		if family.Marriage == nil {
+			family.Marriage = &gedcom.Event{}
+		}
+		family.Divorce = toGedcomEvent(union.End)
+		return
+	}
+	family.Partnership = toGedcomEvent(union.Start)
+	if family.Partnership == nil {
+		family.Partnership = &gedcom.Event{}
+	}
+	family.Separation = toGedcomEvent(union.End)
+}
+
+// Adiciona a pessoa como filho nas famílias dos pais, separando os pais por tipo de paternidade
+// para que cada família tenha um único PEDI.
+func (e *gedcomExport) addChild(child *entity.Person) {
+	var parentages []string
+	parentsByParentage := map[string][]string{}
+	for _, rr := range child.Relationships {
+		if !rr.IsParent() || !rr.InMode(e.mode) || e.individuals[rr.SecundePersonID] == nil {
+			continue
+		}
+		parentage := rr.ParentageOrDefault()
+		if _, ok := parentsByParentage[parentage]; !ok {
+			parentages = append(parentages, parentage)
+		}
+		parentsByParentage[parentage] = appendUnique(parentsByParentage[parentage], rr.SecundePersonID)
+	}
+
+	individual := e.individuals[child.ID]
+	for _, parentage := range parentages {
+		parents := parentsByParentage[parentage]
+		// Uma família do GEDCOM 5.5.1 tem no máximo dois pais.
+		for len(parents) > 0 {
+			n := min(len(parents), 2)
+			family := e.families[pairKey(parents[:n])]
+			if family == nil {
+				family = e.newFamily(parents[:n])
+			}
+			parents = parents[n:]
+
+			if contains(family.Children, individual.XRef) {
+				continue
+			}
+			family.Children = append(family.Children, individual.XRef)
+			individual.FamC = append(individual.FamC, gedcom.FamilyLink{
+				XRef:     family.XRef,
+				Pedigree: pedigreeByParentage[parentage],
+			})
+		}
+	}
+}
+
+// Cria a família do casal. O homem é o HUSB e a mulher a WIFE; como o GEDCOM 5.5.1 não tem outro
+// papel, em casais do mesmo sexo o primeiro é o HUSB.
+func (e *gedcomExport) newFamily(personIDs []string) *gedcom.Family {
+	family := &gedcom.Family{XRef: fmt.Sprintf("@F%d@", len(e.doc.Families)+1)}
+
+	partners := make([]*gedcom.Individual, 0, len(personIDs))
+	for _, id := range personIDs {
+		partners = append(partners, e.individuals[id])
+	}
+	sort.SliceStable(partners, func(i, j int) bool { return sexOrder(partners[i].Sex) < sexOrder(partners[j].Sex) })
+
+	switch {
+	case len(partners) == 1 && partners[0].Sex == "F":
+		family.Wife = partners[0].XRef
+	case len(partners) == 1:
+		family.Husband = partners[0].XRef
+	default:
+		family.Husband, family.Wife = partners[0].XRef, partners[1].XRef
+	}
+	for _, partner := range partners {
+		partner.FamS = append(partner.FamS, family.XRef)
+	}
+
+	// Com mais de uma união entre as mesmas pessoas, os filhos ficam na primeira família.
+	if key := pairKey(personIDs); e.families[key] == nil {
+		e.families[key] = family
+	}
+	e.doc.Families = append(e.doc.Families, family)
+	return family
+}
+
+func sexOrder(sex string) int {
+	switch sex {
+	case "M":
+		return 0
+	case "F":
+		return 2
+	}
+	return 1
+}
+
+// Chave do casal, sem ordem entre as pessoas.
+func pairKey(personIDs []string) string {
+	ids := append([]string{}, personIDs...)
+	sort.Strings(ids)
+	return strings.Join(ids, ":")
+}
+
+func toGedcomEvent(event *entity.RelationshipEvent) *gedcom.Event {
+	if event == nil {
+		return nil
+	}
+	return &gedcom.Event{
+		Date:  gedcom.FormatDate(event.Date),
+		Place: event.Place,
+	}
+}
+
+// Preenche os eventos do indivíduo. Repetições de nascimento, batismo, óbito e sepultamento e os
+// casamentos vão como EVEN com o tipo no TYPE; eventos custom usam a descrição como TYPE.
+func addIndividualEvents(individual *gedcom.Individual, events []*entity.Event) {
+	for _, event := range events {
+		ge := &gedcom.Event{
+			Date:  gedcom.FormatDate(event.Date),
+			Place: event.Place,
+		}
+		switch {
+		case event.Type == entity.EventBirth && individual.Birth == nil:
+			individual.Birth = ge
+		case event.Type == entity.EventBaptism && individual.Baptism == nil:
+			individual.Baptism = ge
+		case event.Type == entity.EventDeath && individual.Death == nil:
+			individual.Death = ge
+		case event.Type == entity.EventBurial && individual.Burial == nil:
+			individual.Burial = ge
+		case event.Type == entity.EventResidence:
+			individual.Residences = append(individual.Residences, ge)
+		case event.Type == entity.EventCustom:
+			ge.Type = event.Description
+			individual.Events = append(individual.Events, ge)
+		default:
+			ge.Type = event.Type
+			individual.Events = append(individual.Events, ge)
+		}
+	}
+}
+
+func appendUnique(values []string, value string) []string {
+	if contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
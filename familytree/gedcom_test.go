@@ -0,0 +1,144 @@
+package familytree
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// Família com pais casados, um filho adotivo, um filho só da mãe e uma união sem casamento.
+func gedcomPersons() []*entity.Person {
+	marriage := &entity.Relationship{ID: "u1", Kind: entity.RelationshipKindSpouse, MainPersonID: "2", SecundePersonID: "1",
+		Start: &entity.RelationshipEvent{Date: "1975-06-01", Place: "Olinda"}}
+	partnership := &entity.Relationship{ID: "u2", Kind: entity.RelationshipKindPartner, MainPersonID: "3", SecundePersonID: "5"}
+	return []*entity.Person{
+		{ID: "1", Name: "John", Gender: "M", Unions: []*entity.Relationship{marriage}},
+		{ID: "2", Name: "Mary", Gender: "F", Unions: []*entity.Relationship{marriage}},
+		{ID: "3", Name: "Anne", Gender: "F", Unions: []*entity.Relationship{partnership}, Relationships: []*entity.Relationship{
+			{ID: "r1", MainPersonID: "3", SecundePersonID: "1", Parentage: entity.ParentageAdoptive},
+			{ID: "r2", MainPersonID: "3", SecundePersonID: "2", Parentage: entity.ParentageAdoptive},
+		}},
+		{ID: "4", Name: "Paul", Gender: "M", Relationships: []*entity.Relationship{
+			{ID: "r3", MainPersonID: "4", SecundePersonID: "2"},
+		}},
+		{ID: "5", Name: "Lee", Unions: []*entity.Relationship{partnership}},
+	}
+}
+
+func (suite *FamilytreeTestSuite) TestExportGedcom() {
+	ctx := context.Background()
+
+	suite.Run("should group parents and unions into families", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(gedcomPersons(), nil)
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportGedcom(ctx)
+		suite.Require().NoError(err)
+
+		suite.Equal([]*gedcom.Individual{
+			{XRef: "@I1@", Name: "John", Sex: "M", FamS: []string{"@F1@"}},
+			{XRef: "@I2@", Name: "Mary", Sex: "F", FamS: []string{"@F1@", "@F3@"}},
+			{XRef: "@I3@", Name: "Anne", Sex: "F", FamS: []string{"@F2@"}, FamC: []gedcom.FamilyLink{{XRef: "@F1@", Pedigree: gedcom.PedigreeAdopted}}},
+			{XRef: "@I4@", Name: "Paul", Sex: "M", FamC: []gedcom.FamilyLink{{XRef: "@F3@"}}},
+			{XRef: "@I5@", Name: "Lee", FamS: []string{"@F2@"}},
+		}, doc.Individuals)
+		suite.Equal([]*gedcom.Family{
+			{XRef: "@F1@", Husband: "@I1@", Wife: "@I2@", Children: []string{"@I3@"}, Marriage: &gedcom.Event{Date: "1 JUN 1975", Place: "Olinda"}},
+			{XRef: "@F2@", Husband: "@I5@", Wife: "@I3@", Partnership: &gedcom.Event{}},
+			{XRef: "@F3@", Wife: "@I2@", Children: []string{"@I4@"}},
+		}, doc.Families)
+	})
+
+	suite.Run("should export the life events of each person", func() {
+		persons := []*entity.Person{{ID: "1", Name: "John", Gender: "M", Events: []*entity.Event{
+			{Type: entity.EventBirth, Date: "ABT 1950-05", Place: "Recife"},
+			{Type: entity.EventBirth, Date: "1951"},
+			{Type: entity.EventMarriage, Date: "1975"},
+			{Type: entity.EventResidence, Place: "Olinda"},
+			{Type: entity.EventCustom, Date: "BET 1970 AND 1972", Description: "Graduation"},
+		}}}
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(persons, nil)
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportGedcom(ctx)
+		suite.Require().NoError(err)
+
+		suite.Equal(&gedcom.Individual{
+			XRef:       "@I1@",
+			Name:       "John",
+			Sex:        "M",
+			Birth:      &gedcom.Event{Date: "ABT MAY 1950", Place: "Recife"},
+			Residences: []*gedcom.Event{{Place: "Olinda"}},
+			Events: []*gedcom.Event{
+				{Date: "1951", Type: entity.EventBirth},
+				{Date: "1975", Type: entity.EventMarriage},
+				{Date: "BET 1970 AND 1972", Type: "Graduation"},
+			},
+		}, doc.Individuals[0])
+	})
+
+	suite.Run("should return an error when trying to get the list of people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportGedcom(ctx)
+		assert.EqualError(suite.T(), err, "get person error: error database")
+		assert.Nil(suite.T(), doc)
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestExportFamilyGedcom() {
+	ctx := context.Background()
+
+	suite.Run("should export only the relatives found by the family tree", func() {
+		persons := gedcomPersons()
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(persons, nil)
+		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), persons[3], persons, 0, entity.TreeModeBiological).Return(
+			entity.NewFamilyTree(persons[3], []*entity.Relative{{Type: "Root", Person: persons[3]}, {Type: "Mother", Level: 1, Person: persons[1]}}))
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportFamilyGedcom(ctx, "paul", entity.TreeModeBiological)
+		suite.Require().NoError(err)
+
+		suite.Equal([]*gedcom.Individual{
+			{XRef: "@I1@", Name: "Mary", Sex: "F", FamS: []string{"@F1@"}},
+			{XRef: "@I2@", Name: "Paul", Sex: "M", FamC: []gedcom.FamilyLink{{XRef: "@F1@"}}},
+		}, doc.Individuals)
+		suite.Equal([]*gedcom.Family{{XRef: "@F1@", Wife: "@I1@", Children: []string{"@I2@"}}}, doc.Families)
+	})
+
+	suite.Run("should ignore parents outside the tree mode", func() {
+		persons := gedcomPersons()
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(persons, nil)
+		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), persons[2], persons, 0, entity.TreeModeBiological).Return(
+			entity.NewFamilyTree(persons[2], []*entity.Relative{{Type: "Root", Person: persons[2]}, {Type: "Father", Person: persons[0]}}))
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportFamilyGedcom(ctx, "Anne", entity.TreeModeBiological)
+		suite.Require().NoError(err)
+		suite.Len(doc.Individuals, 2)
+		suite.Empty(doc.Families)
+	})
+
+	suite.Run("should return person not found when the person does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(gedcomPersons(), nil)
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportFamilyGedcom(ctx, "Nobody", entity.TreeModeAll)
+		suite.ErrorIs(err, ErrPersonNotFound)
+		suite.Nil(doc)
+	})
+
+	suite.Run("should return an error when trying to get the list of people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		doc, err := service.ExportFamilyGedcom(ctx, "John", entity.TreeModeAll)
+		assert.EqualError(suite.T(), err, "get person error: error database")
+		assert.Nil(suite.T(), doc)
+	})
+}
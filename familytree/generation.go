@@ -0,0 +1,63 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+// Profundidade máxima das consultas de ancestrais e descendentes, para limitar o tamanho da resposta.
+const MaxDepth = 10
+
+var ErrInvalidDepth = fmt.Errorf("depth should be between 1 and %d", MaxDepth)
+
+func checkDepth(depth int) error {
+	if depth < 1 || depth > MaxDepth {
+		return ErrInvalidDepth
+	}
+	return nil
+}
+
+// Lista os ancestrais da pessoa agrupados por geração, até a profundidade informada.
+func (s *Service) Ancestors(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error) {
+	logger.Info(fmt.Sprintf("[Service] Ancestors started for personID: %s and depth: %d", personID, depth))
+
+	if err := checkDepth(depth); err != nil {
+		logger.Error(fmt.Sprintf("[Service] Ancestors error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	found, persons, err := s.personsByID(ctx, personID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] Ancestors error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	generations := s.Genealogy.Ancestors(ctx, found[0], persons, depth, mode)
+
+	logger.Info(fmt.Sprintf("[Service] Ancestors finished for personID: %s and depth: %d", personID, depth))
+	return generations, nil
+}
+
+// Lista os descendentes da pessoa agrupados por geração, até a profundidade informada.
+func (s *Service) Descendants(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error) {
+	logger.Info(fmt.Sprintf("[Service] Descendants started for personID: %s and depth: %d", personID, depth))
+
+	if err := checkDepth(depth); err != nil {
+		logger.Error(fmt.Sprintf("[Service] Descendants error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	found, persons, err := s.personsByID(ctx, personID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] Descendants error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	generations := s.Genealogy.Descendants(ctx, found[0], persons, depth, mode)
+
+	logger.Info(fmt.Sprintf("[Service] Descendants finished for personID: %s and depth: %d", personID, depth))
+	return generations, nil
+}
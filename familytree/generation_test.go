@@ -0,0 +1,72 @@
+package familytree
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *FamilytreeTestSuite) TestAncestors() {
+	ctx := context.Background()
+
+	suite.Run("should return the ancestors grouped by generation", func() {
+		generations := []*entity.Generation{
+			{Level: 0, Persons: []*entity.Person{suite.PersonRoot}},
+			{Level: 1, Persons: []*entity.Person{suite.FamilyTree[1].Person, suite.FamilyTree[2].Person}},
+		}
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().Ancestors(gomock.Any(), suite.PersonRoot, gomock.Any(), 2, entity.TreeModeLegal).Return(generations)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ancestors(ctx, "1", 2, entity.TreeModeLegal)
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), generations, result)
+	})
+
+	suite.Run("should reject a depth above the maximum", func() {
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ancestors(ctx, "1", MaxDepth+1, entity.TreeModeAll)
+
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, ErrInvalidDepth)
+	})
+
+	suite.Run("should reject a depth below one", func() {
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ancestors(ctx, "1", 0, entity.TreeModeAll)
+
+		assert.ErrorIs(suite.T(), err, ErrInvalidDepth)
+	})
+
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ancestors(ctx, "9", 1, entity.TreeModeAll)
+
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestDescendants() {
+	ctx := context.Background()
+
+	suite.Run("should return the descendants grouped by generation", func() {
+		generations := []*entity.Generation{
+			{Level: 0, Persons: []*entity.Person{suite.FamilyTree[1].Person}},
+			{Level: 1, Persons: []*entity.Person{suite.PersonRoot}},
+		}
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().Descendants(gomock.Any(), suite.FamilyTree[1].Person, gomock.Any(), MaxDepth, entity.TreeModeAll).Return(generations)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Descendants(ctx, "2", MaxDepth, entity.TreeModeAll)
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), generations, result)
+	})
+
+	suite.Run("should reject a depth above the maximum", func() {
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Descendants(ctx, "2", MaxDepth+1, entity.TreeModeAll)
+
+		assert.ErrorIs(suite.T(), err, ErrInvalidDepth)
+	})
+}
@@ -0,0 +1,230 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+func (s *Service) HealthReport(ctx context.Context) (*entity.HealthReport, error) {
+	logger.Info("[Service] HealthReport started")
+
+	persons, err := s.PersonRepo.List(ctx, nil)
+	if err != nil {
+		logger.Error("[Service] HealthReport error: ", err)
+		return nil, fmt.Errorf("get person error: %w", err)
+	}
+
+	relationships, err := s.RelationshipRepo.List(ctx, nil)
+	if err != nil {
+		logger.Error("[Service] HealthReport error: ", err)
+		return nil, fmt.Errorf("get relationship error: %w", err)
+	}
+
+	report := checkHealth(persons, relationships)
+
+	logger.Info(fmt.Sprintf("[Service] HealthReport finished with %d issues", len(report.Issues)))
+	return report, nil
+}
+
+// Verifica os dados cadastrados. Os problemas são listados por gravidade: referências quebradas,
+// pessoas incompletas ou duplicadas e, por fim, partes da árvore sem ligação com o restante.
+func checkHealth(persons []*entity.Person, relationships []*entity.Relationship) *entity.HealthReport {
+	report := &entity.HealthReport{
+		Persons:       len(persons),
+		Relationships: len(relationships),
+		Issues:        []entity.HealthIssue{},
+	}
+
+	byID := make(map[string]*entity.Person, len(persons))
+	for _, p := range persons {
+		byID[p.ID] = p
+	}
+
+	report.Issues = append(report.Issues, checkReferences(relationships, byID)...)
+	report.Issues = append(report.Issues, checkGenders(persons)...)
+	report.Issues = append(report.Issues, checkDuplicates(persons)...)
+
+	components := connectedComponents(persons, relationships, byID)
+	report.Components = len(components)
+	report.Issues = append(report.Issues, checkComponents(components)...)
+
+	return report
+}
+
+// Relacionamentos sem nenhuma das pessoas estão órfãos; com apenas uma, apontam para um ID inexistente.
+func checkReferences(relationships []*entity.Relationship, persons map[string]*entity.Person) []entity.HealthIssue {
+	var issues []entity.HealthIssue
+	for _, r := range relationships {
+		ref := entity.EntityRef{Type: entity.EntityRelationship, ID: r.ID}
+
+		var missing []string
+		for _, id := range []string{r.MainPersonID, r.SecundePersonID} {
+			if persons[id] == nil {
+				missing = append(missing, id)
+			}
+		}
+
+		switch len(missing) {
+		case 2:
+			issues = append(issues, entity.HealthIssue{
+				Code:     entity.HealthOrphanedRelationship,
+				Severity: entity.SeverityError,
+				Message:  fmt.Sprintf("%s relationship references no existing person", r.KindOrDefault()),
+				Entity:   ref,
+			})
+		case 1:
+			issues = append(issues, entity.HealthIssue{
+				Code:     entity.HealthDanglingID,
+				Severity: entity.SeverityError,
+				Message:  fmt.Sprintf("%s relationship references unknown person %q", r.KindOrDefault(), missing[0]),
+				Entity:   ref,
+				Related:  []entity.EntityRef{{Type: entity.EntityPerson, ID: r.OtherPersonID(missing[0])}},
+			})
+		}
+	}
+	return issues
+}
+
+func checkGenders(persons []*entity.Person) []entity.HealthIssue {
+	var issues []entity.HealthIssue
+	for _, p := range persons {
+		if p.Gender == "M" || p.Gender == "F" {
+			continue
+		}
+		issues = append(issues, entity.HealthIssue{
+			Code:     entity.HealthMissingGender,
+			Severity: entity.SeverityWarning,
+			Message:  fmt.Sprintf("%s has no gender", p.Name),
+			Entity:   entity.EntityRef{Type: entity.EntityPerson, ID: p.ID},
+		})
+	}
+	return issues
+}
+
+// Pessoas com o mesmo nome (ignorando maiúsculas e espaços) provavelmente são a mesma, a menos que
+// os sexos ou as datas de nascimento cadastradas sejam incompatíveis. Cada repetição referencia a
+// primeira pessoa cadastrada com o nome.
+func checkDuplicates(persons []*entity.Person) []entity.HealthIssue {
+	var issues []entity.HealthIssue
+	seen := map[string][]*entity.Person{}
+	for _, p := range persons {
+		key := normalizeName(p.Name)
+		if key == "" {
+			continue
+		}
+		for _, other := range seen[key] {
+			if !compatible(p, other) {
+				continue
+			}
+			issues = append(issues, entity.HealthIssue{
+				Code:     entity.HealthDuplicatePerson,
+				Severity: entity.SeverityWarning,
+				Message:  fmt.Sprintf("%s is likely a duplicate of another person with the same name", p.Name),
+				Entity:   entity.EntityRef{Type: entity.EntityPerson, ID: p.ID},
+				Related:  []entity.EntityRef{{Type: entity.EntityPerson, ID: other.ID}},
+			})
+			break
+		}
+		seen[key] = append(seen[key], p)
+	}
+	return issues
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func compatible(a, b *entity.Person) bool {
+	if a.Gender != "" && b.Gender != "" && a.Gender != b.Gender {
+		return false
+	}
+	return !birthsDisjoint(a, b)
+}
+
+// Indica se os nascimentos com limites conhecidos não podem ser o mesmo dia.
+func birthsDisjoint(a, b *entity.Person) bool {
+	first, okFirst := a.BirthDate()
+	second, okSecond := b.BirthDate()
+	if !okFirst || !okSecond {
+		return false
+	}
+	firstStart, okFirstStart := first.Earliest()
+	firstEnd, okFirstEnd := first.Latest()
+	secondStart, okSecondStart := second.Earliest()
+	secondEnd, okSecondEnd := second.Latest()
+	if okFirstEnd && okSecondStart && firstEnd.Before(secondStart) {
+		return true
+	}
+	return okSecondEnd && okFirstStart && secondEnd.Before(firstStart)
+}
+
+// Agrupa as pessoas ligadas por qualquer relacionamento, na ordem em que foram cadastradas.
+func connectedComponents(persons []*entity.Person, relationships []*entity.Relationship, byID map[string]*entity.Person) [][]*entity.Person {
+	neighbors := map[string][]string{}
+	for _, r := range relationships {
+		if byID[r.MainPersonID] == nil || byID[r.SecundePersonID] == nil {
+			continue
+		}
+		neighbors[r.MainPersonID] = append(neighbors[r.MainPersonID], r.SecundePersonID)
+		neighbors[r.SecundePersonID] = append(neighbors[r.SecundePersonID], r.MainPersonID)
+	}
+
+	visited := map[string]bool{}
+	var components [][]*entity.Person
+	for _, p := range persons {
+		if visited[p.ID] {
+			continue
+		}
+		visited[p.ID] = true
+		component := []*entity.Person{}
+		queue := []string{p.ID}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			component = append(component, byID[current])
+			for _, id := range neighbors[current] {
+				if !visited[id] {
+					visited[id] = true
+					queue = append(queue, id)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// Cada parte da árvore sem ligação com a maior delas é informada, referenciando a primeira pessoa
+// cadastrada da parte e relacionando as demais.
+func checkComponents(components [][]*entity.Person) []entity.HealthIssue {
+	largest := 0
+	for i, component := range components {
+		if len(component) > len(components[largest]) {
+			largest = i
+		}
+	}
+
+	var issues []entity.HealthIssue
+	for i, component := range components {
+		if i == largest {
+			continue
+		}
+		related := []entity.EntityRef{}
+		for _, p := range component[1:] {
+			related = append(related, entity.EntityRef{Type: entity.EntityPerson, ID: p.ID})
+		}
+		issues = append(issues, entity.HealthIssue{
+			Code:     entity.HealthDisconnectedComponent,
+			Severity: entity.SeverityInfo,
+			Message: fmt.Sprintf("%d person(s) starting with %s are not connected to the largest family (%d persons)",
+				len(component), component[0].Name, len(components[largest])),
+			Entity:  entity.EntityRef{Type: entity.EntityPerson, ID: component[0].ID},
+			Related: related,
+		})
+	}
+	return issues
+}
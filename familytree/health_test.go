@@ -0,0 +1,94 @@
+package familytree
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	personInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/person/inmem"
+	relationshipInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/relationship/inmem"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *FamilytreeTestSuite) TestHealthReport() {
+	ctx := context.Background()
+
+	suite.Run("should report every kind of issue", func() {
+		persons := []*entity.Person{
+			{ID: "1", Name: "John Smith", Gender: "M"},
+			{ID: "2", Name: "Mary", Gender: "F"},
+			{ID: "3", Name: "john  SMITH"},
+			{ID: "4", Name: "Lee", Gender: "M"},
+		}
+		relationships := []*entity.Relationship{
+			{ID: "r1", MainPersonID: "2", SecundePersonID: "1"},
+			{ID: "r2", MainPersonID: "2", SecundePersonID: "9"},
+			{ID: "r3", Kind: entity.RelationshipKindSpouse, MainPersonID: "8", SecundePersonID: "9"},
+			{ID: "r4", MainPersonID: "3", SecundePersonID: "4"},
+		}
+		suite.PersonRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(persons, nil)
+		suite.RelationshipRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(relationships, nil)
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		report, err := service.HealthReport(ctx)
+		suite.Require().NoError(err)
+
+		suite.Equal(4, report.Persons)
+		suite.Equal(4, report.Relationships)
+		suite.Equal(2, report.Components)
+		suite.Equal([]entity.HealthIssue{
+			{Code: entity.HealthDanglingID, Severity: entity.SeverityError, Message: `parent relationship references unknown person "9"`,
+				Entity: entity.EntityRef{Type: entity.EntityRelationship, ID: "r2"}, Related: []entity.EntityRef{{Type: entity.EntityPerson, ID: "2"}}},
+			{Code: entity.HealthOrphanedRelationship, Severity: entity.SeverityError, Message: "spouse relationship references no existing person",
+				Entity: entity.EntityRef{Type: entity.EntityRelationship, ID: "r3"}},
+			{Code: entity.HealthMissingGender, Severity: entity.SeverityWarning, Message: "john  SMITH has no gender",
+				Entity: entity.EntityRef{Type: entity.EntityPerson, ID: "3"}},
+			{Code: entity.HealthDuplicatePerson, Severity: entity.SeverityWarning, Message: "john  SMITH is likely a duplicate of another person with the same name",
+				Entity: entity.EntityRef{Type: entity.EntityPerson, ID: "3"}, Related: []entity.EntityRef{{Type: entity.EntityPerson, ID: "1"}}},
+			{Code: entity.HealthDisconnectedComponent, Severity: entity.SeverityInfo, Message: "2 person(s) starting with john  SMITH are not connected to the largest family (2 persons)",
+				Entity: entity.EntityRef{Type: entity.EntityPerson, ID: "3"}, Related: []entity.EntityRef{{Type: entity.EntityPerson, ID: "4"}}},
+		}, report.Issues)
+	})
+
+	suite.Run("should not report persons with the same name born in different years", func() {
+		persons := []*entity.Person{
+			{ID: "1", Name: "John", Gender: "M", Events: []*entity.Event{{Type: entity.EventBirth, Date: "1900"}}},
+			{ID: "2", Name: "John", Gender: "M", Events: []*entity.Event{{Type: entity.EventBirth, Date: "1930-04"}}},
+			{ID: "3", Name: "John", Gender: "M", Events: []*entity.Event{{Type: entity.EventBirth, Date: "ABT 1900"}}},
+		}
+		relationships := []*entity.Relationship{
+			{ID: "r1", MainPersonID: "2", SecundePersonID: "1"},
+			{ID: "r2", MainPersonID: "3", SecundePersonID: "1"},
+		}
+		report := checkHealth(persons, relationships)
+		suite.Equal(1, report.Components)
+		suite.Len(report.Issues, 1)
+		suite.Equal("3", report.Issues[0].Entity.ID)
+		suite.Equal("1", report.Issues[0].Related[0].ID)
+	})
+
+	suite.Run("should report a person without relationships as disconnected", func() {
+		db := database.NewEmpty()
+		database.NewPerson(db, "Martin", "M", "", "")
+		database.NewPerson(db, "Geovane", "M", "", "")
+		service := NewService(suite.GenealogyMock, personInmemRepo.NewPersonRepository(db), relationshipInmemRepo.NewRelationshipRepository(db))
+
+		report, err := service.HealthReport(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(2, report.Components)
+		suite.Len(report.Issues, 1)
+		suite.Equal(entity.HealthDisconnectedComponent, report.Issues[0].Code)
+		suite.Empty(report.Issues[0].Related)
+	})
+
+	suite.Run("should return an error when trying to list the relationships", func() {
+		suite.PersonRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
+		suite.RelationshipRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		report, err := service.HealthReport(ctx)
+		suite.EqualError(err, "get relationship error: error database")
+		suite.Nil(report)
+	})
+}
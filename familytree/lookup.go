@@ -0,0 +1,152 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+// Mesmo erro do repositório de pessoas, para que as buscas por nome e por ID sejam tratadas igualmente.
+var ErrPersonNotFound = person.ErrNotFound
+
+// Erro de um nome que corresponde a mais de uma pessoa. As rotas por ID resolvem a ambiguidade.
+type AmbiguousNameError struct {
+	Name       string
+	Candidates []*entity.Person
+}
+
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("name %q matches %d persons", e.Name, len(e.Candidates))
+}
+
+func (e *AmbiguousNameError) Unwrap() error {
+	return entity.ErrConflict
+}
+
+// Retorna erro quando o nome corresponde a mais de uma pessoa, sem diferenciar maiúsculas e minúsculas.
+func checkAmbiguousName(persons []*entity.Person, name string) error {
+	var candidates []*entity.Person
+	for _, p := range persons {
+		if strings.EqualFold(p.Name, name) {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) > 1 {
+		return &AmbiguousNameError{Name: name, Candidates: candidates}
+	}
+	return nil
+}
+
+func findPersonByID(persons []*entity.Person, id string) *entity.Person {
+	for _, p := range persons {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// Carrega as pessoas com os relacionamentos e encontra as pessoas dos IDs informados.
+func (s *Service) personsByID(ctx context.Context, ids ...string) ([]*entity.Person, []*entity.Person, error) {
+	persons, err := s.PersonRepo.ListWithRelationships(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get person error: %w", err)
+	}
+
+	found := make([]*entity.Person, 0, len(ids))
+	for _, id := range ids {
+		p := findPersonByID(persons, id)
+		if p == nil {
+			return nil, nil, fmt.Errorf("%w: %s", ErrPersonNotFound, id)
+		}
+		found = append(found, p)
+	}
+	return found, persons, nil
+}
+
+// Carrega as pessoas com os relacionamentos e encontra as pessoas dos nomes informados,
+// rejeitando nomes ambíguos.
+func (s *Service) personsByName(ctx context.Context, names ...string) ([]*entity.Person, []*entity.Person, error) {
+	persons, err := s.PersonRepo.ListWithRelationships(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get person error: %w", err)
+	}
+
+	found := make([]*entity.Person, 0, len(names))
+	for _, name := range names {
+		if err := checkAmbiguousName(persons, name); err != nil {
+			return nil, nil, err
+		}
+		p := findPersonByName(persons, name)
+		if p == nil {
+			return nil, nil, fmt.Errorf("%w: %s", ErrPersonNotFound, name)
+		}
+		found = append(found, p)
+	}
+	return found, persons, nil
+}
+
+func (s *Service) GetAllFamilyMembersByID(ctx context.Context, personID string, mode entity.TreeMode) ([]*entity.Relative, error) {
+	logger.Info(fmt.Sprintf("[Service] GetAllFamilyMembersByID started for personID: %s", personID))
+
+	found, persons, err := s.personsByID(ctx, personID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] GetAllFamilyMembersByID error for personID: %s", personID), err)
+		return nil, err
+	}
+
+	relatives := s.Genealogy.BuildFamilyTree(ctx, found[0], persons, 0, mode).Relatives()
+
+	logger.Info(fmt.Sprintf("[Service] GetAllFamilyMembersByID finished for personID: %s", personID))
+	return relatives, nil
+}
+
+func (s *Service) DetermineRelationshipByID(ctx context.Context, firstPersonID, secondPersonID string) (string, error) {
+	logger.Info(fmt.Sprintf("[Service] DetermineRelationshipByID started for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+
+	found, persons, err := s.personsByID(ctx, firstPersonID, secondPersonID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] DetermineRelationshipByID error for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID), err)
+		return "", err
+	}
+
+	relationship := s.Genealogy.DetermineRelationship(ctx, found[0], found[1], persons)
+
+	logger.Info(fmt.Sprintf("[Service] DetermineRelationshipByID finished for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+	return relationship, nil
+}
+
+func (s *Service) CalculateKinshipDistanceByID(ctx context.Context, firstPersonID, secondPersonID string) (*entity.KinshipPath, error) {
+	logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistanceByID started for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+
+	found, persons, err := s.personsByID(ctx, firstPersonID, secondPersonID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] CalculateKinshipDistanceByID error for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID), err)
+		return nil, err
+	}
+
+	path := s.Genealogy.KinshipPath(ctx, found[0], found[1], persons)
+
+	logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistanceByID finished for firstPersonID: %s and secondPersonID: %s", firstPersonID, secondPersonID))
+	return path, nil
+}
+
+func (s *Service) ExportFamilyGedcomByID(ctx context.Context, personID string, mode entity.TreeMode) (*gedcom.Document, error) {
+	logger.Info(fmt.Sprintf("[Service] ExportFamilyGedcomByID started for personID: %s", personID))
+
+	found, persons, err := s.personsByID(ctx, personID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] ExportFamilyGedcomByID error for personID: %s", personID), err)
+		return nil, err
+	}
+
+	doc := s.familyGedcom(ctx, found[0], persons, mode)
+
+	logger.Info(fmt.Sprintf("[Service] ExportFamilyGedcomByID finished for personID: %s", personID))
+	return doc, nil
+}
@@ -0,0 +1,139 @@
+package familytree
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *FamilytreeTestSuite) TestAmbiguousName() {
+	homonym := &entity.Person{ID: "4", Name: "john", Gender: "M"}
+
+	suite.Run("should reject a name that matches more than one person", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(append(suite.persons(), homonym), nil)
+
+		relatives, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).GetAllFamilyMembers(context.Background(), "John", entity.TreeModeAll)
+
+		var ambiguousErr *AmbiguousNameError
+		assert.Nil(suite.T(), relatives)
+		assert.True(suite.T(), errors.As(err, &ambiguousErr))
+		assert.Equal(suite.T(), "John", ambiguousErr.Name)
+		assert.Equal(suite.T(), []*entity.Person{suite.PersonRoot, homonym}, ambiguousErr.Candidates)
+	})
+
+	suite.Run("should reject an ambiguous second person", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(append(suite.persons(), homonym), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).DetermineRelationship(context.Background(), "Robert", "John")
+
+		var ambiguousErr *AmbiguousNameError
+		assert.True(suite.T(), errors.As(err, &ambiguousErr))
+	})
+
+	suite.Run("should reject an ambiguous name in the kinship distance", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(append(suite.persons(), homonym), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CalculateKinshipDistance(context.Background(), "John", "Robert")
+
+		var ambiguousErr *AmbiguousNameError
+		assert.True(suite.T(), errors.As(err, &ambiguousErr))
+	})
+
+	suite.Run("should reject an ambiguous name in the family export", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(append(suite.persons(), homonym), nil)
+
+		doc, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).ExportFamilyGedcom(context.Background(), "John", entity.TreeModeAll)
+
+		var ambiguousErr *AmbiguousNameError
+		assert.Nil(suite.T(), doc)
+		assert.True(suite.T(), errors.As(err, &ambiguousErr))
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestGetAllFamilyMembersByID() {
+	suite.Run("should return the family tree of the person with the ID", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), suite.PersonRoot, gomock.Any(), 0, entity.TreeModeLegal).Return(entity.NewFamilyTree(suite.PersonRoot, suite.FamilyTree))
+
+		relatives, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).GetAllFamilyMembersByID(context.Background(), "1", entity.TreeModeLegal)
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), suite.FamilyTree, relatives)
+	})
+
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		relatives, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).GetAllFamilyMembersByID(context.Background(), "9", entity.TreeModeAll)
+
+		assert.Nil(suite.T(), relatives)
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+
+	suite.Run("should return an error when trying to get the list of people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+
+		relatives, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).GetAllFamilyMembersByID(context.Background(), "1", entity.TreeModeAll)
+
+		assert.Nil(suite.T(), relatives)
+		assert.EqualError(suite.T(), err, "get person error: error database")
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestDetermineRelationshipByID() {
+	suite.Run("should return the relationship between the persons with the IDs", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().DetermineRelationship(gomock.Any(), suite.PersonRoot, suite.FamilyTree[1].Person, gomock.Any()).Return("Father")
+
+		relationship, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).DetermineRelationshipByID(context.Background(), "1", "2")
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "Father", relationship)
+	})
+
+	suite.Run("should return not found when the second ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).DetermineRelationshipByID(context.Background(), "1", "9")
+
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestCalculateKinshipDistanceByID() {
+	suite.Run("should return the kinship distance between the persons with the IDs", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		kinshipPath := &entity.KinshipPath{Steps: []*entity.KinshipStep{
+			{Person: suite.PersonRoot, Relation: entity.KinshipChildOf},
+			{Person: suite.FamilyTree[2].Person},
+		}}
+		suite.GenealogyMock.EXPECT().KinshipPath(gomock.Any(), suite.PersonRoot, suite.FamilyTree[2].Person, gomock.Any()).Return(kinshipPath)
+
+		path, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CalculateKinshipDistanceByID(context.Background(), "1", "3")
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), kinshipPath, path)
+	})
+
+	suite.Run("should return not found when the first ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).CalculateKinshipDistanceByID(context.Background(), "9", "1")
+
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestExportFamilyGedcomByID() {
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		doc, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).ExportFamilyGedcomByID(context.Background(), "9", entity.TreeModeAll)
+
+		assert.Nil(suite.T(), doc)
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
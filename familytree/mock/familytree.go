@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	gedcom "github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,32 +41,130 @@ func (m *MockGenealogyInterface) EXPECT() *MockGenealogyInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Ahnentafel mocks base method.
+func (m *MockGenealogyInterface) Ahnentafel(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.NumberedPerson {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ahnentafel", ctx, person, persons, depth, mode)
+	ret0, _ := ret[0].([]*entity.NumberedPerson)
+	return ret0
+}
+
+// Ahnentafel indicates an expected call of Ahnentafel.
+func (mr *MockGenealogyInterfaceMockRecorder) Ahnentafel(ctx, person, persons, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ahnentafel", reflect.TypeOf((*MockGenealogyInterface)(nil).Ahnentafel), ctx, person, persons, depth, mode)
+}
+
+// Ancestors mocks base method.
+func (m *MockGenealogyInterface) Ancestors(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.Generation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ancestors", ctx, person, persons, depth, mode)
+	ret0, _ := ret[0].([]*entity.Generation)
+	return ret0
+}
+
+// Ancestors indicates an expected call of Ancestors.
+func (mr *MockGenealogyInterfaceMockRecorder) Ancestors(ctx, person, persons, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ancestors", reflect.TypeOf((*MockGenealogyInterface)(nil).Ancestors), ctx, person, persons, depth, mode)
+}
+
 // BuildFamilyTree mocks base method.
-func (m *MockGenealogyInterface) BuildFamilyTree(ctx context.Context, rootPerson *entity.Person, persons []*entity.Person, level int) []*entity.Relative {
+func (m *MockGenealogyInterface) BuildFamilyTree(ctx context.Context, rootPerson *entity.Person, persons []*entity.Person, level int, mode entity.TreeMode) *entity.FamilyTree {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildFamilyTree", ctx, rootPerson, persons, level)
-	ret0, _ := ret[0].([]*entity.Relative)
+	ret := m.ctrl.Call(m, "BuildFamilyTree", ctx, rootPerson, persons, level, mode)
+	ret0, _ := ret[0].(*entity.FamilyTree)
 	return ret0
 }
 
 // BuildFamilyTree indicates an expected call of BuildFamilyTree.
-func (mr *MockGenealogyInterfaceMockRecorder) BuildFamilyTree(ctx, rootPerson, persons, level any) *gomock.Call {
+func (mr *MockGenealogyInterfaceMockRecorder) BuildFamilyTree(ctx, rootPerson, persons, level, mode any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildFamilyTree", reflect.TypeOf((*MockGenealogyInterface)(nil).BuildFamilyTree), ctx, rootPerson, persons, level)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildFamilyTree", reflect.TypeOf((*MockGenealogyInterface)(nil).BuildFamilyTree), ctx, rootPerson, persons, level, mode)
 }
 
-// GetRelatives mocks base method.
-func (m *MockGenealogyInterface) GetRelatives(ctx context.Context) []*entity.Relative {
+// Coefficients mocks base method.
+func (m *MockGenealogyInterface) Coefficients(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) *entity.Coefficients {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRelatives", ctx)
-	ret0, _ := ret[0].([]*entity.Relative)
+	ret := m.ctrl.Call(m, "Coefficients", ctx, person, relative, persons)
+	ret0, _ := ret[0].(*entity.Coefficients)
+	return ret0
+}
+
+// Coefficients indicates an expected call of Coefficients.
+func (mr *MockGenealogyInterfaceMockRecorder) Coefficients(ctx, person, relative, persons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coefficients", reflect.TypeOf((*MockGenealogyInterface)(nil).Coefficients), ctx, person, relative, persons)
+}
+
+// CommonAncestors mocks base method.
+func (m *MockGenealogyInterface) CommonAncestors(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) []*entity.CommonAncestor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommonAncestors", ctx, person, relative, persons)
+	ret0, _ := ret[0].([]*entity.CommonAncestor)
+	return ret0
+}
+
+// CommonAncestors indicates an expected call of CommonAncestors.
+func (mr *MockGenealogyInterfaceMockRecorder) CommonAncestors(ctx, person, relative, persons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommonAncestors", reflect.TypeOf((*MockGenealogyInterface)(nil).CommonAncestors), ctx, person, relative, persons)
+}
+
+// DAboville mocks base method.
+func (m *MockGenealogyInterface) DAboville(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.NumberedPerson {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DAboville", ctx, person, persons, depth, mode)
+	ret0, _ := ret[0].([]*entity.NumberedPerson)
+	return ret0
+}
+
+// DAboville indicates an expected call of DAboville.
+func (mr *MockGenealogyInterfaceMockRecorder) DAboville(ctx, person, persons, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DAboville", reflect.TypeOf((*MockGenealogyInterface)(nil).DAboville), ctx, person, persons, depth, mode)
+}
+
+// Descendants mocks base method.
+func (m *MockGenealogyInterface) Descendants(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.Generation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Descendants", ctx, person, persons, depth, mode)
+	ret0, _ := ret[0].([]*entity.Generation)
+	return ret0
+}
+
+// Descendants indicates an expected call of Descendants.
+func (mr *MockGenealogyInterfaceMockRecorder) Descendants(ctx, person, persons, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Descendants", reflect.TypeOf((*MockGenealogyInterface)(nil).Descendants), ctx, person, persons, depth, mode)
+}
+
+// DetermineRelationship mocks base method.
+func (m *MockGenealogyInterface) DetermineRelationship(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetermineRelationship", ctx, person, relative, persons)
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// GetRelatives indicates an expected call of GetRelatives.
-func (mr *MockGenealogyInterfaceMockRecorder) GetRelatives(ctx any) *gomock.Call {
+// DetermineRelationship indicates an expected call of DetermineRelationship.
+func (mr *MockGenealogyInterfaceMockRecorder) DetermineRelationship(ctx, person, relative, persons any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatives", reflect.TypeOf((*MockGenealogyInterface)(nil).GetRelatives), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetermineRelationship", reflect.TypeOf((*MockGenealogyInterface)(nil).DetermineRelationship), ctx, person, relative, persons)
+}
+
+// KinshipPath mocks base method.
+func (m *MockGenealogyInterface) KinshipPath(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) *entity.KinshipPath {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KinshipPath", ctx, person, relative, persons)
+	ret0, _ := ret[0].(*entity.KinshipPath)
+	return ret0
+}
+
+// KinshipPath indicates an expected call of KinshipPath.
+func (mr *MockGenealogyInterfaceMockRecorder) KinshipPath(ctx, person, relative, persons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KinshipPath", reflect.TypeOf((*MockGenealogyInterface)(nil).KinshipPath), ctx, person, relative, persons)
 }
 
 // MockUseCase is a mock of UseCase interface.
@@ -91,11 +190,41 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// Ahnentafel mocks base method.
+func (m *MockUseCase) Ahnentafel(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ahnentafel", ctx, personID, depth, mode)
+	ret0, _ := ret[0].([]*entity.NumberedPerson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ahnentafel indicates an expected call of Ahnentafel.
+func (mr *MockUseCaseMockRecorder) Ahnentafel(ctx, personID, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ahnentafel", reflect.TypeOf((*MockUseCase)(nil).Ahnentafel), ctx, personID, depth, mode)
+}
+
+// Ancestors mocks base method.
+func (m *MockUseCase) Ancestors(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ancestors", ctx, personID, depth, mode)
+	ret0, _ := ret[0].([]*entity.Generation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ancestors indicates an expected call of Ancestors.
+func (mr *MockUseCaseMockRecorder) Ancestors(ctx, personID, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ancestors", reflect.TypeOf((*MockUseCase)(nil).Ancestors), ctx, personID, depth, mode)
+}
+
 // CalculateKinshipDistance mocks base method.
-func (m *MockUseCase) CalculateKinshipDistance(ctx context.Context, firstPersonName, secondPersonName string) (int, error) {
+func (m *MockUseCase) CalculateKinshipDistance(ctx context.Context, firstPersonName, secondPersonName string) (*entity.KinshipPath, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CalculateKinshipDistance", ctx, firstPersonName, secondPersonName)
-	ret0, _ := ret[0].(int)
+	ret0, _ := ret[0].(*entity.KinshipPath)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -106,6 +235,111 @@ func (mr *MockUseCaseMockRecorder) CalculateKinshipDistance(ctx, firstPersonName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateKinshipDistance", reflect.TypeOf((*MockUseCase)(nil).CalculateKinshipDistance), ctx, firstPersonName, secondPersonName)
 }
 
+// CalculateKinshipDistanceByID mocks base method.
+func (m *MockUseCase) CalculateKinshipDistanceByID(ctx context.Context, firstPersonID, secondPersonID string) (*entity.KinshipPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateKinshipDistanceByID", ctx, firstPersonID, secondPersonID)
+	ret0, _ := ret[0].(*entity.KinshipPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateKinshipDistanceByID indicates an expected call of CalculateKinshipDistanceByID.
+func (mr *MockUseCaseMockRecorder) CalculateKinshipDistanceByID(ctx, firstPersonID, secondPersonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateKinshipDistanceByID", reflect.TypeOf((*MockUseCase)(nil).CalculateKinshipDistanceByID), ctx, firstPersonID, secondPersonID)
+}
+
+// Coefficients mocks base method.
+func (m *MockUseCase) Coefficients(ctx context.Context, firstPersonName, secondPersonName string) (*entity.Coefficients, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coefficients", ctx, firstPersonName, secondPersonName)
+	ret0, _ := ret[0].(*entity.Coefficients)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Coefficients indicates an expected call of Coefficients.
+func (mr *MockUseCaseMockRecorder) Coefficients(ctx, firstPersonName, secondPersonName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coefficients", reflect.TypeOf((*MockUseCase)(nil).Coefficients), ctx, firstPersonName, secondPersonName)
+}
+
+// CoefficientsByID mocks base method.
+func (m *MockUseCase) CoefficientsByID(ctx context.Context, firstPersonID, secondPersonID string) (*entity.Coefficients, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CoefficientsByID", ctx, firstPersonID, secondPersonID)
+	ret0, _ := ret[0].(*entity.Coefficients)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CoefficientsByID indicates an expected call of CoefficientsByID.
+func (mr *MockUseCaseMockRecorder) CoefficientsByID(ctx, firstPersonID, secondPersonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CoefficientsByID", reflect.TypeOf((*MockUseCase)(nil).CoefficientsByID), ctx, firstPersonID, secondPersonID)
+}
+
+// CommonAncestors mocks base method.
+func (m *MockUseCase) CommonAncestors(ctx context.Context, firstPersonName, secondPersonName string) ([]*entity.CommonAncestor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommonAncestors", ctx, firstPersonName, secondPersonName)
+	ret0, _ := ret[0].([]*entity.CommonAncestor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommonAncestors indicates an expected call of CommonAncestors.
+func (mr *MockUseCaseMockRecorder) CommonAncestors(ctx, firstPersonName, secondPersonName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommonAncestors", reflect.TypeOf((*MockUseCase)(nil).CommonAncestors), ctx, firstPersonName, secondPersonName)
+}
+
+// CommonAncestorsByID mocks base method.
+func (m *MockUseCase) CommonAncestorsByID(ctx context.Context, firstPersonID, secondPersonID string) ([]*entity.CommonAncestor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommonAncestorsByID", ctx, firstPersonID, secondPersonID)
+	ret0, _ := ret[0].([]*entity.CommonAncestor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommonAncestorsByID indicates an expected call of CommonAncestorsByID.
+func (mr *MockUseCaseMockRecorder) CommonAncestorsByID(ctx, firstPersonID, secondPersonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommonAncestorsByID", reflect.TypeOf((*MockUseCase)(nil).CommonAncestorsByID), ctx, firstPersonID, secondPersonID)
+}
+
+// DAboville mocks base method.
+func (m *MockUseCase) DAboville(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DAboville", ctx, personID, depth, mode)
+	ret0, _ := ret[0].([]*entity.NumberedPerson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DAboville indicates an expected call of DAboville.
+func (mr *MockUseCaseMockRecorder) DAboville(ctx, personID, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DAboville", reflect.TypeOf((*MockUseCase)(nil).DAboville), ctx, personID, depth, mode)
+}
+
+// Descendants mocks base method.
+func (m *MockUseCase) Descendants(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Descendants", ctx, personID, depth, mode)
+	ret0, _ := ret[0].([]*entity.Generation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Descendants indicates an expected call of Descendants.
+func (mr *MockUseCaseMockRecorder) Descendants(ctx, personID, depth, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Descendants", reflect.TypeOf((*MockUseCase)(nil).Descendants), ctx, personID, depth, mode)
+}
+
 // DetermineRelationship mocks base method.
 func (m *MockUseCase) DetermineRelationship(ctx context.Context, firstPersonName, secondPersonName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -121,17 +355,107 @@ func (mr *MockUseCaseMockRecorder) DetermineRelationship(ctx, firstPersonName, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetermineRelationship", reflect.TypeOf((*MockUseCase)(nil).DetermineRelationship), ctx, firstPersonName, secondPersonName)
 }
 
+// DetermineRelationshipByID mocks base method.
+func (m *MockUseCase) DetermineRelationshipByID(ctx context.Context, firstPersonID, secondPersonID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetermineRelationshipByID", ctx, firstPersonID, secondPersonID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetermineRelationshipByID indicates an expected call of DetermineRelationshipByID.
+func (mr *MockUseCaseMockRecorder) DetermineRelationshipByID(ctx, firstPersonID, secondPersonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetermineRelationshipByID", reflect.TypeOf((*MockUseCase)(nil).DetermineRelationshipByID), ctx, firstPersonID, secondPersonID)
+}
+
+// ExportFamilyGedcom mocks base method.
+func (m *MockUseCase) ExportFamilyGedcom(ctx context.Context, personName string, mode entity.TreeMode) (*gedcom.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportFamilyGedcom", ctx, personName, mode)
+	ret0, _ := ret[0].(*gedcom.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportFamilyGedcom indicates an expected call of ExportFamilyGedcom.
+func (mr *MockUseCaseMockRecorder) ExportFamilyGedcom(ctx, personName, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFamilyGedcom", reflect.TypeOf((*MockUseCase)(nil).ExportFamilyGedcom), ctx, personName, mode)
+}
+
+// ExportFamilyGedcomByID mocks base method.
+func (m *MockUseCase) ExportFamilyGedcomByID(ctx context.Context, personID string, mode entity.TreeMode) (*gedcom.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportFamilyGedcomByID", ctx, personID, mode)
+	ret0, _ := ret[0].(*gedcom.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportFamilyGedcomByID indicates an expected call of ExportFamilyGedcomByID.
+func (mr *MockUseCaseMockRecorder) ExportFamilyGedcomByID(ctx, personID, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFamilyGedcomByID", reflect.TypeOf((*MockUseCase)(nil).ExportFamilyGedcomByID), ctx, personID, mode)
+}
+
+// ExportGedcom mocks base method.
+func (m *MockUseCase) ExportGedcom(ctx context.Context) (*gedcom.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportGedcom", ctx)
+	ret0, _ := ret[0].(*gedcom.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportGedcom indicates an expected call of ExportGedcom.
+func (mr *MockUseCaseMockRecorder) ExportGedcom(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGedcom", reflect.TypeOf((*MockUseCase)(nil).ExportGedcom), ctx)
+}
+
 // GetAllFamilyMembers mocks base method.
-func (m *MockUseCase) GetAllFamilyMembers(ctx context.Context, personName string) ([]*entity.Relative, error) {
+func (m *MockUseCase) GetAllFamilyMembers(ctx context.Context, personName string, mode entity.TreeMode) ([]*entity.Relative, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllFamilyMembers", ctx, personName)
+	ret := m.ctrl.Call(m, "GetAllFamilyMembers", ctx, personName, mode)
 	ret0, _ := ret[0].([]*entity.Relative)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllFamilyMembers indicates an expected call of GetAllFamilyMembers.
-func (mr *MockUseCaseMockRecorder) GetAllFamilyMembers(ctx, personName any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetAllFamilyMembers(ctx, personName, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFamilyMembers", reflect.TypeOf((*MockUseCase)(nil).GetAllFamilyMembers), ctx, personName, mode)
+}
+
+// GetAllFamilyMembersByID mocks base method.
+func (m *MockUseCase) GetAllFamilyMembersByID(ctx context.Context, personID string, mode entity.TreeMode) ([]*entity.Relative, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFamilyMembersByID", ctx, personID, mode)
+	ret0, _ := ret[0].([]*entity.Relative)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFamilyMembersByID indicates an expected call of GetAllFamilyMembersByID.
+func (mr *MockUseCaseMockRecorder) GetAllFamilyMembersByID(ctx, personID, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFamilyMembersByID", reflect.TypeOf((*MockUseCase)(nil).GetAllFamilyMembersByID), ctx, personID, mode)
+}
+
+// HealthReport mocks base method.
+func (m *MockUseCase) HealthReport(ctx context.Context) (*entity.HealthReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthReport", ctx)
+	ret0, _ := ret[0].(*entity.HealthReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthReport indicates an expected call of HealthReport.
+func (mr *MockUseCaseMockRecorder) HealthReport(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFamilyMembers", reflect.TypeOf((*MockUseCase)(nil).GetAllFamilyMembers), ctx, personName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthReport", reflect.TypeOf((*MockUseCase)(nil).HealthReport), ctx)
 }
@@ -0,0 +1,51 @@
+package familytree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+)
+
+// Numera os ancestrais da pessoa pelo sistema Ahnentafel, até a profundidade informada.
+func (s *Service) Ahnentafel(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error) {
+	logger.Info(fmt.Sprintf("[Service] Ahnentafel started for personID: %s and depth: %d", personID, depth))
+
+	if err := checkDepth(depth); err != nil {
+		logger.Error(fmt.Sprintf("[Service] Ahnentafel error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	found, persons, err := s.personsByID(ctx, personID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] Ahnentafel error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	numbered := s.Genealogy.Ahnentafel(ctx, found[0], persons, depth, mode)
+
+	logger.Info(fmt.Sprintf("[Service] Ahnentafel finished for personID: %s and depth: %d", personID, depth))
+	return numbered, nil
+}
+
+// Numera os descendentes da pessoa pelo sistema d'Aboville, até a profundidade informada.
+func (s *Service) DAboville(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error) {
+	logger.Info(fmt.Sprintf("[Service] DAboville started for personID: %s and depth: %d", personID, depth))
+
+	if err := checkDepth(depth); err != nil {
+		logger.Error(fmt.Sprintf("[Service] DAboville error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	found, persons, err := s.personsByID(ctx, personID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Service] DAboville error for personID: %s and depth: %d", personID, depth), err)
+		return nil, err
+	}
+
+	numbered := s.Genealogy.DAboville(ctx, found[0], persons, depth, mode)
+
+	logger.Info(fmt.Sprintf("[Service] DAboville finished for personID: %s and depth: %d", personID, depth))
+	return numbered, nil
+}
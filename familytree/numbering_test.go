@@ -0,0 +1,66 @@
+package familytree
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *FamilytreeTestSuite) TestAhnentafel() {
+	ctx := context.Background()
+
+	suite.Run("should return the ancestors numbered by Ahnentafel", func() {
+		numbered := []*entity.NumberedPerson{
+			{Number: "1", Person: suite.PersonRoot},
+			{Number: "2", Generation: 1, Person: suite.FamilyTree[1].Person},
+			{Number: "3", Generation: 1, Person: suite.FamilyTree[2].Person},
+		}
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().Ahnentafel(gomock.Any(), suite.PersonRoot, gomock.Any(), 3, entity.TreeModeAll).Return(numbered)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ahnentafel(ctx, "1", 3, entity.TreeModeAll)
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), numbered, result)
+	})
+
+	suite.Run("should reject a depth above the maximum", func() {
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ahnentafel(ctx, "1", MaxDepth+1, entity.TreeModeAll)
+
+		assert.ErrorIs(suite.T(), err, ErrInvalidDepth)
+	})
+
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).Ahnentafel(ctx, "9", 3, entity.TreeModeAll)
+
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+	})
+}
+
+func (suite *FamilytreeTestSuite) TestDAboville() {
+	ctx := context.Background()
+
+	suite.Run("should return the descendants numbered by d'Aboville", func() {
+		numbered := []*entity.NumberedPerson{
+			{Number: "1", Person: suite.FamilyTree[1].Person},
+			{Number: "1.1", Generation: 1, Person: suite.PersonRoot},
+		}
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().DAboville(gomock.Any(), suite.FamilyTree[1].Person, gomock.Any(), 2, entity.TreeModeLegal).Return(numbered)
+
+		result, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).DAboville(ctx, "2", 2, entity.TreeModeLegal)
+
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), numbered, result)
+	})
+
+	suite.Run("should reject a depth below one", func() {
+		_, err := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock).DAboville(ctx, "2", 0, entity.TreeModeAll)
+
+		assert.ErrorIs(suite.T(), err, ErrInvalidDepth)
+	})
+}
@@ -25,24 +25,16 @@ func NewService(genealogy GenealogyInterface, personRepo person.Repository, rela
 	}
 }
 
-func (s *Service) GetAllFamilyMembers(ctx context.Context, personName string) ([]*entity.Relative, error) {
+func (s *Service) GetAllFamilyMembers(ctx context.Context, personName string, mode entity.TreeMode) ([]*entity.Relative, error) {
 	logger.Info(fmt.Sprintf("[Service] GetAllFamilyMembers started for personName: %s", personName))
 
-	person, err := s.PersonRepo.GetByName(ctx, personName)
-
+	found, persons, err := s.personsByName(ctx, personName)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] GetAllFamilyMembers error for personName: %s", personName), err)
-		return nil, fmt.Errorf("get person error: %w", err)
+		return nil, err
 	}
 
-	persons, err := s.PersonRepo.ListWithRelationships(ctx, nil)
-
-	if err != nil {
-		logger.Error(fmt.Sprintf("[Service] GetAllFamilyMembers error for personName: %s", personName), err)
-		return nil, fmt.Errorf("get person error: %w", err)
-	}
-
-	relatives := s.Genealogy.BuildFamilyTree(ctx, person, persons, 0)
+	relatives := s.Genealogy.BuildFamilyTree(ctx, found[0], persons, 0, mode).Relatives()
 
 	logger.Info(fmt.Sprintf("[Service] GetAllFamilyMembers finished for personName: %s", personName))
 	return relatives, nil
@@ -51,64 +43,43 @@ func (s *Service) GetAllFamilyMembers(ctx context.Context, personName string) ([
 func (s *Service) DetermineRelationship(ctx context.Context, firstPersonName, secondPersonName string) (relationship string, err error) {
 	logger.Info(fmt.Sprintf("[Service] DetermineRelationship started for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
 
-	firstPerson, err := s.PersonRepo.GetByName(ctx, firstPersonName)
+	found, persons, err := s.personsByName(ctx, firstPersonName, secondPersonName)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] DetermineRelationship error for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName), err)
-		return "", fmt.Errorf("get person error: %w", err)
+		return "", err
 	}
 
-	persons, err := s.PersonRepo.ListWithRelationships(ctx, nil)
-	if err != nil {
-		logger.Error(fmt.Sprintf("[Service] DetermineRelationship error for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName), err)
-		return "", fmt.Errorf("get person error: %w", err)
-	}
-	relatives := s.Genealogy.BuildFamilyTree(ctx, firstPerson, persons, 1)
-
-	if len(relatives) == 0 {
-		logger.Info(fmt.Sprintf("[Service] DetermineRelationship finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
-		return "unrelated", nil
-	}
-
-	for _, relative := range relatives {
-		if strings.EqualFold(relative.Person.Name, secondPersonName) {
-			logger.Info(fmt.Sprintf("[Service] DetermineRelationship finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
-			return relative.Type, nil
-		}
-	}
+	relationship = s.Genealogy.DetermineRelationship(ctx, found[0], found[1], persons)
 
 	logger.Info(fmt.Sprintf("[Service] DetermineRelationship finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
 
-	return "", nil
+	return relationship, nil
 }
 
-func (s *Service) CalculateKinshipDistance(ctx context.Context, firstPersonName, secondPersonName string) (int, error) {
+// Calcula a distância de parentesco pelo menor caminho de pais e filhos entre as duas pessoas.
+// Retorna nil quando as pessoas não são parentes e ErrPersonNotFound quando alguma não existe.
+func (s *Service) CalculateKinshipDistance(ctx context.Context, firstPersonName, secondPersonName string) (*entity.KinshipPath, error) {
 	logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistance started for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
-	firstPerson, err := s.PersonRepo.GetByName(ctx, firstPersonName)
-	if err != nil {
-		logger.Error(fmt.Sprintf("[Service] CalculateKinshipDistance error for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName), err)
-		return 0, fmt.Errorf("get person error: %w", err)
-	}
-	persons, err := s.PersonRepo.ListWithRelationships(ctx, nil)
+
+	found, persons, err := s.personsByName(ctx, firstPersonName, secondPersonName)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] CalculateKinshipDistance error for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName), err)
-		return 0, fmt.Errorf("get person error: %w", err)
+		return nil, err
 	}
 
-	relatives := s.Genealogy.BuildFamilyTree(ctx, firstPerson, persons, 1)
+	path := s.Genealogy.KinshipPath(ctx, found[0], found[1], persons)
 
-	if len(relatives) == 0 {
-		logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistance finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
-		return 0, nil
-	}
+	logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistance finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
+
+	return path, nil
+}
 
-	for _, relative := range relatives {
-		if strings.EqualFold(relative.Person.Name, secondPersonName) {
-			logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistance finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
-			return relative.Level, nil
+// Encontra a pessoa pelo nome, sem diferenciar maiúsculas e minúsculas.
+func findPersonByName(persons []*entity.Person, name string) *entity.Person {
+	for _, p := range persons {
+		if strings.EqualFold(p.Name, name) {
+			return p
 		}
 	}
-
-	logger.Info(fmt.Sprintf("[Service] CalculateKinshipDistance finished for firstPersonName: %s and secondPersonName: %s", firstPersonName, secondPersonName))
-
-	return 0, nil
+	return nil
 }
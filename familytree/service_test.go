@@ -62,35 +62,40 @@ func (suite *FamilytreeTestSuite) SetupTest() {
 
 }
 
+func (suite *FamilytreeTestSuite) persons() []*entity.Person {
+	persons := make([]*entity.Person, 0, len(suite.FamilyTree))
+	for _, relative := range suite.FamilyTree {
+		persons = append(persons, relative.Person)
+	}
+	return persons
+}
+
 func (suite *FamilytreeTestSuite) TestGetAllFamilyMembers() {
 	ctx := context.Background()
 	suite.Run("should return to the family tree successfully", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 0).Return(suite.FamilyTree)
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 0, entity.TreeModeAll).Return(entity.NewFamilyTree(suite.PersonRoot, suite.FamilyTree))
 
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		family, err := service.GetAllFamilyMembers(ctx, "John")
+		family, err := service.GetAllFamilyMembers(ctx, "John", entity.TreeModeAll)
 		assert.Nil(suite.T(), err)
 		assert.Len(suite.T(), family, 3)
 	})
 
-	suite.Run("should return an error when trying to get the person", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+	suite.Run("should return an error when trying to get the list of people", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		family, err := service.GetAllFamilyMembers(ctx, "John")
+		family, err := service.GetAllFamilyMembers(ctx, "John", entity.TreeModeAll)
 		assert.NotNil(suite.T(), err)
 		assert.Error(suite.T(), err, "get person error: %w", "error database")
 		assert.Nil(suite.T(), family)
 	})
 
-	suite.Run("should return an error when trying to get the list of people", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
+	suite.Run("should return person not found when the person does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		family, err := service.GetAllFamilyMembers(ctx, "John")
-		assert.NotNil(suite.T(), err)
-		assert.Error(suite.T(), err, "get person error: %w", "error database")
+		family, err := service.GetAllFamilyMembers(ctx, "Nobody", entity.TreeModeAll)
+		assert.ErrorIs(suite.T(), err, entity.ErrNotFound)
 		assert.Nil(suite.T(), family)
 	})
 }
@@ -98,27 +103,16 @@ func (suite *FamilytreeTestSuite) TestGetAllFamilyMembers() {
 func (suite *FamilytreeTestSuite) TestDetermineRelationship() {
 	ctx := context.Background()
 	suite.Run("should return the relationship between two people successfully", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 1).Return(suite.FamilyTree)
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().DetermineRelationship(gomock.Any(), suite.PersonRoot, suite.FamilyTree[1].Person, gomock.Any()).Return("Father")
 
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		relationship, err := service.DetermineRelationship(ctx, "John", "Robert")
+		relationship, err := service.DetermineRelationship(ctx, "John", "robert")
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), "Father", relationship)
 	})
 
-	suite.Run("should return an error when trying to get the person", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
-		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		relationship, err := service.DetermineRelationship(ctx, "John", "Robert")
-		assert.NotNil(suite.T(), err)
-		assert.Error(suite.T(), err, "get person error: %w", "error database")
-		assert.Empty(suite.T(), relationship)
-	})
-
 	suite.Run("should return an error when trying to get the list of people", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
 		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
 		relationship, err := service.DetermineRelationship(ctx, "John", "Robert")
@@ -128,9 +122,8 @@ func (suite *FamilytreeTestSuite) TestDetermineRelationship() {
 	})
 
 	suite.Run("should return unrelated when the people are not related", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 1).Return([]*entity.Relative{})
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().DetermineRelationship(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("unrelated")
 
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
 		relationship, err := service.DetermineRelationship(ctx, "John", "Robert")
@@ -138,66 +131,65 @@ func (suite *FamilytreeTestSuite) TestDetermineRelationship() {
 		assert.Equal(suite.T(), "unrelated", relationship)
 	})
 
-	suite.Run("should return empty for person not found in the family", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 1).Return(suite.FamilyTree)
+	suite.Run("should return person not found for person not found in the family", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
 		relationship, err := service.DetermineRelationship(ctx, "John", "Leon")
-		assert.Nil(suite.T(), err)
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+		assert.EqualError(suite.T(), err, "person not found: Leon")
 		assert.Empty(suite.T(), relationship)
 	})
+
+	suite.Run("should return person not found when the first person does not exist", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
+		_, err := service.DetermineRelationship(ctx, "Leon", "John")
+		assert.ErrorIs(suite.T(), err, entity.ErrNotFound)
+	})
 }
 
 func (suite *FamilytreeTestSuite) TestCalculateKinshipDistance() {
 	ctx := context.Background()
+	kinshipPath := &entity.KinshipPath{Steps: []*entity.KinshipStep{
+		{Person: suite.PersonRoot, Relation: entity.KinshipChildOf},
+		{Person: suite.FamilyTree[1].Person},
+	}}
+
 	suite.Run("should return the kinship distance between two people successfully", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 1).Return(suite.FamilyTree)
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().KinshipPath(gomock.Any(), suite.PersonRoot, suite.FamilyTree[1].Person, gomock.Any()).Return(kinshipPath)
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		distance, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
+		path, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
 		assert.Nil(suite.T(), err)
-		assert.Equal(suite.T(), 1, distance)
-	})
-
-	suite.Run("should return an error when trying to get the person", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
-		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		distance, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
-		assert.NotNil(suite.T(), err)
-		assert.Error(suite.T(), err, "get person error: %w", "error database")
-		assert.Zero(suite.T(), distance)
+		assert.Equal(suite.T(), kinshipPath, path)
+		assert.Equal(suite.T(), 1, path.Distance())
 	})
 
 	suite.Run("should return an error when trying to get the list of people", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
 		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(nil, errors.New("error database"))
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		distance, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
+		path, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
 		assert.NotNil(suite.T(), err)
 		assert.Error(suite.T(), err, "get person error: %w", "error database")
-		assert.Zero(suite.T(), distance)
+		assert.Nil(suite.T(), path)
 	})
 
 	suite.Run("should return unrelated when the people are not related", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 1).Return([]*entity.Relative{})
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
+		suite.GenealogyMock.EXPECT().KinshipPath(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		distance, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
+		path, err := service.CalculateKinshipDistance(ctx, "John", "Robert")
 		assert.Nil(suite.T(), err)
-		assert.Zero(suite.T(), distance)
+		assert.Nil(suite.T(), path)
+		assert.Zero(suite.T(), path.Distance())
 	})
 
-	suite.Run("should return empty for person not found in the family", func() {
-		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(suite.PersonRoot, nil)
-		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
-		suite.GenealogyMock.EXPECT().BuildFamilyTree(gomock.Any(), gomock.Any(), gomock.Any(), 1).Return(suite.FamilyTree)
+	suite.Run("should return person not found for person not found in the family", func() {
+		suite.PersonRepoMock.EXPECT().ListWithRelationships(gomock.Any(), gomock.Any()).Return(suite.persons(), nil)
 		service := NewService(suite.GenealogyMock, suite.PersonRepoMock, suite.RelationshipRepoMock)
-		distance, err := service.CalculateKinshipDistance(ctx, "John", "Leon")
-		assert.Nil(suite.T(), err)
-		assert.Zero(suite.T(), distance)
+		path, err := service.CalculateKinshipDistance(ctx, "John", "Leon")
+		assert.ErrorIs(suite.T(), err, ErrPersonNotFound)
+		assert.Nil(suite.T(), path)
 	})
 }
 
@@ -0,0 +1,13 @@
+package importer
+
+import (
+	"context"
+	"io"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+type UseCase interface {
+	// Options pode ser nil, importando todos os indivíduos como pessoas novas.
+	ImportGedcom(ctx context.Context, r io.Reader, options *entity.ImportOptions) (*entity.ImportSummary, error)
+}
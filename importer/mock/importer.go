@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: importer/importer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=importer/importer.go -destination=importer/mock/importer.go
+//
+
+// Package mock_importer is a generated GoMock package.
+package mock_importer
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	entity "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ImportGedcom mocks base method.
+func (m *MockUseCase) ImportGedcom(ctx context.Context, r io.Reader, options *entity.ImportOptions) (*entity.ImportSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportGedcom", ctx, r, options)
+	ret0, _ := ret[0].(*entity.ImportSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportGedcom indicates an expected call of ImportGedcom.
+func (mr *MockUseCaseMockRecorder) ImportGedcom(ctx, r, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportGedcom", reflect.TypeOf((*MockUseCase)(nil).ImportGedcom), ctx, r, options)
+}
@@ -0,0 +1,133 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	personInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/person/inmem"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/genealogy"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	relationshipInmemRepo "github.com/GeovaneCavalcante/tree-genealogical/relationship/inmem"
+)
+
+// Árvore com casamento, divórcio, união sem casamento, adoção, casal do mesmo sexo e eventos da vida.
+func loadRoundTripFamily(db *database.Database) {
+	john := database.NewPerson(db, "John Smith", "M", "", "")
+	db.AddEvent(entity.Event{ID: "john-birth", PersonID: john.ID, Type: entity.EventBirth, Date: "ABT 1950", Place: "Recife"})
+	db.AddEvent(entity.Event{ID: "john-residence", PersonID: john.ID, Type: entity.EventResidence, Date: "FROM 1970 TO 1980", Place: "Olinda"})
+	db.AddEvent(entity.Event{ID: "john-graduation", PersonID: john.ID, Type: entity.EventCustom, Date: "1972-12", Description: "Graduation"})
+	db.AddEvent(entity.Event{ID: "john-death", PersonID: john.ID, Type: entity.EventDeath})
+	mary := database.NewPerson(db, "Mary Smith", "F", "", "")
+	database.NewPerson(db, "Paul Smith", "M", john.ID, mary.ID)
+	anne := database.NewPerson(db, "Anne Smith", "F", "", "")
+	db.AddRelationship(entity.Relationship{ID: "adoption-john", MainPersonID: anne.ID, SecundePersonID: john.ID, Parentage: entity.ParentageAdoptive})
+	db.AddRelationship(entity.Relationship{ID: "adoption-mary", MainPersonID: anne.ID, SecundePersonID: mary.ID, Parentage: entity.ParentageAdoptive})
+	db.AddRelationship(entity.Relationship{ID: "marriage", Kind: entity.RelationshipKindSpouse, MainPersonID: john.ID, SecundePersonID: mary.ID,
+		Start: &entity.RelationshipEvent{Date: "1975-06-01", Place: "Olinda"}, End: &entity.RelationshipEvent{Date: "1990"}})
+
+	ellen := database.NewPerson(db, "Ellen", "F", "", "")
+	oprah := database.NewPerson(db, "Oprah", "F", "", "")
+	eric := database.NewPerson(db, "Eric", "M", ellen.ID, oprah.ID)
+	db.AddRelationship(entity.Relationship{ID: "partnership", Kind: entity.RelationshipKindPartner, MainPersonID: eric.ID, SecundePersonID: anne.ID})
+	database.NewPerson(db, "Melody", "F", eric.ID, anne.ID)
+	database.NewPerson(db, "Ariel", "F", "", mary.ID)
+}
+
+func exportGedcom(ctx context.Context, db *database.Database, personName string) (string, error) {
+	service := familytree.NewService(genealogy.NewFamilyTree(), personInmemRepo.NewPersonRepository(db), relationshipInmemRepo.NewRelationshipRepository(db))
+
+	var (
+		doc *gedcom.Document
+		err error
+	)
+	if personName == "" {
+		doc, err = service.ExportGedcom(ctx)
+	} else {
+		doc, err = service.ExportFamilyGedcom(ctx, personName, entity.TreeModeAll)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := gedcom.Encode(&buf, doc); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func importGedcom(ctx context.Context, data string) (*database.Database, *entity.ImportSummary, error) {
+	db := database.NewEmpty()
+	summary, err := importGedcomInto(ctx, db, data, nil)
+	return db, summary, err
+}
+
+func importGedcomInto(ctx context.Context, db *database.Database, data string, options *entity.ImportOptions) (*entity.ImportSummary, error) {
+	personRepo := personInmemRepo.NewPersonRepository(db)
+	relationshipService := relationship.NewService(relationshipInmemRepo.NewRelationshipRepository(db))
+	return NewService(personRepo, relationshipService).ImportGedcom(ctx, bytes.NewBufferString(data), options)
+}
+
+func (suite *ImporterTestSuite) TestRoundTrip() {
+	ctx := context.Background()
+	source := database.NewEmpty()
+	loadRoundTripFamily(source)
+
+	suite.Run("importing the whole export should give back the same tree", func() {
+		exported, err := exportGedcom(ctx, source, "")
+		suite.Require().NoError(err)
+
+		imported, summary, err := importGedcom(ctx, exported)
+		suite.Require().NoError(err)
+		suite.Empty(summary.Issues)
+		suite.Equal(entity.ImportCounts{Created: len(source.Persons())}, summary.Persons)
+		suite.Equal(entity.ImportCounts{Created: len(source.Relationships())}, summary.Relationships)
+
+		reexported, err := exportGedcom(ctx, imported, "")
+		suite.Require().NoError(err)
+		suite.Equal(exported, reexported)
+
+		marriage := findRelationship(imported, entity.RelationshipKindSpouse)
+		suite.Require().NotNil(marriage)
+		suite.Equal(&entity.RelationshipEvent{Date: "1975-06-01", Place: "Olinda"}, marriage.Start)
+		suite.Equal(&entity.RelationshipEvent{Date: "1990"}, marriage.End)
+
+		john := findPerson(imported, "John Smith")
+		suite.Require().NotNil(john)
+		suite.Equal(&entity.Event{ID: john.Events[0].ID, PersonID: john.ID, Type: entity.EventBirth, Date: "ABT 1950", Place: "Recife"}, john.Event(entity.EventBirth))
+		suite.Equal("FROM 1970 TO 1980", john.Event(entity.EventResidence).Date)
+		suite.Equal("Graduation", john.Event(entity.EventCustom).Description)
+		suite.NotNil(john.Event(entity.EventDeath))
+	})
+
+	suite.Run("importing the export of a person should give back the same family", func() {
+		exported, err := exportGedcom(ctx, source, "Paul Smith")
+		suite.Require().NoError(err)
+
+		imported, summary, err := importGedcom(ctx, exported)
+		suite.Require().NoError(err)
+		suite.Empty(summary.Issues)
+
+		reexported, err := exportGedcom(ctx, imported, "Paul Smith")
+		suite.Require().NoError(err)
+		suite.Equal(exported, reexported)
+	})
+}
+
+func findPerson(db *database.Database, name string) *entity.Person {
+	person, _ := personInmemRepo.NewPersonRepository(db).GetByName(context.Background(), name)
+	return person
+}
+
+func findRelationship(db *database.Database, kind string) *entity.Relationship {
+	for _, rr := range db.Relationships() {
+		if rr.KindOrDefault() == kind {
+			return &rr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,423 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+)
+
+// Tipo de paternidade correspondente a cada PEDI do GEDCOM.
+var parentageByPedigree = map[string]string{
+	gedcom.PedigreeBirth:   entity.ParentageBiological,
+	gedcom.PedigreeAdopted: entity.ParentageAdoptive,
+	gedcom.PedigreeFoster:  entity.ParentageFoster,
+}
+
+// Os relacionamentos são criados pelo serviço de relacionamentos, passando pelas mesmas regras
+// de consistência da árvore que a API.
+type Service struct {
+	PersonRepo          person.Repository
+	RelationshipService relationship.UseCase
+}
+
+func NewService(personRepo person.Repository, relationshipService relationship.UseCase) *Service {
+	return &Service{
+		PersonRepo:          personRepo,
+		RelationshipService: relationshipService,
+	}
+}
+
+// Estado de uma importação: ID da pessoa de cada referência do arquivo (XREF), pessoas já
+// ligadas a alguma referência, relacionamentos já existentes e o que foi criado, desfeito em caso de falha.
+type gedcomImport struct {
+	doc                  *gedcom.Document
+	options              entity.ImportOptions
+	ids                  map[string]string
+	persons              map[string]bool
+	relationships        map[string]bool
+	createdPersons       []string
+	createdRelationships []string
+	summary              *entity.ImportSummary
+}
+
+// Importa pessoas e relacionamentos de um arquivo GEDCOM. Cada indivíduo é identificado pela sua
+// referência (XREF) e vira uma pessoa nova; com MergeByName, o indivíduo com o nome de uma pessoa
+// já cadastrada é ligado a ela, desde que ela não esteja ligada a outra referência do arquivo.
+// Relacionamentos que deixariam a árvore inconsistente são registrados como inválidos. A importação
+// é atômica: em caso de falha, as pessoas e os relacionamentos já criados são removidos.
+func (s *Service) ImportGedcom(ctx context.Context, r io.Reader, options *entity.ImportOptions) (*entity.ImportSummary, error) {
+	logger.Info("[Service] ImportGedcom started")
+
+	doc, err := gedcom.Parse(r)
+	var parseErrors gedcom.Errors
+	if err != nil && !errors.As(err, &parseErrors) {
+		logger.Error("[Service] ImportGedcom error: ", err)
+		return nil, fmt.Errorf("parse gedcom error: %w", err)
+	}
+
+	existing, _, err := s.RelationshipService.List(ctx, nil)
+	if err != nil {
+		logger.Error("[Service] ImportGedcom error: ", err)
+		return nil, fmt.Errorf("list relationship error: %w", err)
+	}
+
+	imp := &gedcomImport{
+		doc:           doc,
+		ids:           map[string]string{},
+		persons:       map[string]bool{},
+		relationships: map[string]bool{},
+		summary:       &entity.ImportSummary{},
+	}
+	if options != nil {
+		imp.options = *options
+	}
+	for _, e := range parseErrors {
+		imp.issue(e.Line, "", e.Message)
+	}
+	for _, rr := range existing {
+		imp.relationships[relationshipKey(rr)] = true
+	}
+
+	if err := s.importRecords(ctx, imp); err != nil {
+		if rollbackErr := s.rollback(ctx, imp); rollbackErr != nil {
+			err = fmt.Errorf("%w; %v", err, rollbackErr)
+		}
+		logger.Error("[Service] ImportGedcom error: ", err)
+		return nil, err
+	}
+
+	imp.checkFamilyLinks()
+	sort.SliceStable(imp.summary.Issues, func(i, j int) bool { return imp.summary.Issues[i].Line < imp.summary.Issues[j].Line })
+
+	logger.Info(fmt.Sprintf("[Service] ImportGedcom finished persons created: %d relationships created: %d",
+		imp.summary.Persons.Created, imp.summary.Relationships.Created))
+	return imp.summary, nil
+}
+
+func (s *Service) importRecords(ctx context.Context, imp *gedcomImport) error {
+	for _, individual := range imp.doc.Individuals {
+		if err := s.importIndividual(ctx, imp, individual); err != nil {
+			return err
+		}
+	}
+	for _, family := range imp.doc.Families {
+		if err := s.importFamily(ctx, imp, family); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove os relacionamentos e as pessoas criados pela importação. As pessoas que não puderam
+// ser removidas são informadas no erro.
+func (s *Service) rollback(ctx context.Context, imp *gedcomImport) error {
+	var failures []string
+	for i := len(imp.createdRelationships) - 1; i >= 0; i-- {
+		if err := s.RelationshipService.Delete(ctx, imp.createdRelationships[i]); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	var left []string
+	for i := len(imp.createdPersons) - 1; i >= 0; i-- {
+		if err := s.PersonRepo.Delete(ctx, imp.createdPersons[i], true); err != nil {
+			failures = append(failures, err.Error())
+			left = append(left, imp.createdPersons[i])
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("rollback error: %s; persons not removed: [%s]", strings.Join(failures, "; "), strings.Join(left, ", "))
+}
+
+func (s *Service) importIndividual(ctx context.Context, imp *gedcomImport, individual *gedcom.Individual) error {
+	if message := imp.validateIndividual(individual); message != "" {
+		imp.summary.Persons.Invalid++
+		imp.issue(individual.Line, individual.XRef, message)
+		return nil
+	}
+
+	if imp.options.MergeByName {
+		found, err := s.PersonRepo.GetByName(ctx, individual.Name)
+		if err != nil && !errors.Is(err, person.ErrNotFound) {
+			return fmt.Errorf("get person error: %w", err)
+		}
+		if found != nil && !imp.persons[found.ID] {
+			imp.summary.Persons.Skipped++
+			imp.link(individual.XRef, found.ID)
+			return nil
+		}
+	}
+
+	p := &entity.Person{
+		Name:   individual.Name,
+		Gender: toGender(individual.Sex),
+		Events: toPersonEvents(individual),
+	}
+	if err := s.PersonRepo.Create(ctx, p); err != nil {
+		return fmt.Errorf("create person error: %w", err)
+	}
+	imp.summary.Persons.Created++
+	imp.createdPersons = append(imp.createdPersons, p.ID)
+	imp.link(individual.XRef, p.ID)
+	return nil
+}
+
+func (imp *gedcomImport) link(xref, personID string) {
+	imp.ids[xref] = personID
+	imp.persons[personID] = true
+}
+
+// Cria os relacionamentos de paternidade de cada filho com cada um dos pais e a união entre os pais.
+// A união é um casamento quando a família tem MARR ou DIV e uma união sem casamento quando tem EVEN
+// do tipo partnership ou separation. Sem esses eventos os pais não recebem uma união.
+func (s *Service) importFamily(ctx context.Context, imp *gedcomImport, family *gedcom.Family) error {
+	if family.XRef == "" {
+		imp.summary.Relationships.Invalid++
+		return nil
+	}
+
+	parents := imp.resolve(family, imp.parentsOf(family))
+	children, pedigrees := imp.childrenOf(family)
+	children = imp.resolve(family, children)
+
+	for _, childID := range children {
+		for _, parentID := range parents {
+			rr := &entity.Relationship{
+				Kind:            entity.RelationshipKindParent,
+				MainPersonID:    childID,
+				SecundePersonID: parentID,
+				Parentage:       parentageByPedigree[pedigrees[childID]],
+			}
+			if err := s.createRelationship(ctx, imp, family, rr); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(parents) != 2 {
+		return nil
+	}
+
+	var rr *entity.Relationship
+	switch {
+	case family.Marriage != nil || family.Divorce != nil:
+		rr = &entity.Relationship{
+			Kind:  entity.RelationshipKindSpouse,
+			Start: toRelationshipEvent(family.Marriage),
+			End:   toRelationshipEvent(family.Divorce),
+		}
+	case family.Partnership != nil || family.Separation != nil:
+		rr = &entity.Relationship{
+			Kind:  entity.RelationshipKindPartner,
+			Start: toRelationshipEvent(family.Partnership),
+			End:   toRelationshipEvent(family.Separation),
+		}
+	default:
+		return nil
+	}
+	rr.MainPersonID, rr.SecundePersonID = parents[0], parents[1]
+	return s.createRelationship(ctx, imp, family, rr)
+}
+
+// Cria o relacionamento da família. As violações das regras da árvore (ex.: ciclo ou mais de dois
+// pais biológicos) e as pessoas que não existem mais são registradas na família, sem interromper
+// a importação.
+func (s *Service) createRelationship(ctx context.Context, imp *gedcomImport, family *gedcom.Family, rr *entity.Relationship) error {
+	key := relationshipKey(rr)
+	if imp.relationships[key] {
+		imp.summary.Relationships.Skipped++
+		return nil
+	}
+	err := s.RelationshipService.Create(ctx, rr)
+	var validationErr *relationship.ValidationError
+	if errors.As(err, &validationErr) {
+		imp.summary.Relationships.Invalid++
+		imp.issue(family.Line, family.XRef, validationErr.Error())
+		return nil
+	}
+	if errors.Is(err, relationship.ErrUnknownPerson) {
+		imp.summary.Relationships.Invalid++
+		imp.issue(family.Line, family.XRef, relationship.ErrUnknownPerson.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	imp.relationships[key] = true
+	imp.createdRelationships = append(imp.createdRelationships, rr.ID)
+	imp.summary.Relationships.Created++
+	return nil
+}
+
+func (imp *gedcomImport) validateIndividual(individual *gedcom.Individual) string {
+	switch {
+	case individual.XRef == "":
+		return "individual without cross-reference"
+	case imp.ids[individual.XRef] != "":
+		return fmt.Sprintf("duplicate cross-reference %s", individual.XRef)
+	case individual.Name == "":
+		return "individual without NAME"
+	}
+	return ""
+}
+
+// Pais da família: HUSB, WIFE e os indivíduos que apontam para ela com FAMS.
+func (imp *gedcomImport) parentsOf(family *gedcom.Family) []string {
+	var xrefs []string
+	for _, xref := range []string{family.Husband, family.Wife} {
+		if xref != "" {
+			xrefs = appendUnique(xrefs, xref)
+		}
+	}
+	for _, individual := range imp.doc.Individuals {
+		for _, famS := range individual.FamS {
+			if famS == family.XRef {
+				xrefs = appendUnique(xrefs, individual.XRef)
+			}
+		}
+	}
+	return xrefs
+}
+
+// Filhos da família: CHIL e os indivíduos que apontam para ela com FAMC, com o PEDI de cada um.
+func (imp *gedcomImport) childrenOf(family *gedcom.Family) ([]string, map[string]string) {
+	xrefs := append([]string{}, family.Children...)
+	pedigrees := map[string]string{}
+	for _, individual := range imp.doc.Individuals {
+		for _, famC := range individual.FamC {
+			if famC.XRef != family.XRef {
+				continue
+			}
+			xrefs = appendUnique(xrefs, individual.XRef)
+			if id := imp.ids[individual.XRef]; id != "" {
+				pedigrees[id] = famC.Pedigree
+			}
+		}
+	}
+	return xrefs, pedigrees
+}
+
+// Converte as referências em IDs de pessoas, registrando as que não foram importadas.
+func (imp *gedcomImport) resolve(family *gedcom.Family, xrefs []string) []string {
+	ids := []string{}
+	for _, xref := range xrefs {
+		if id := imp.ids[xref]; id != "" {
+			ids = appendUnique(ids, id)
+			continue
+		}
+		imp.summary.Relationships.Invalid++
+		if imp.doc.Individual(xref) == nil {
+			imp.issue(family.Line, family.XRef, fmt.Sprintf("unknown individual %s", xref))
+		} else {
+			imp.issue(family.Line, family.XRef, fmt.Sprintf("individual %s was not imported", xref))
+		}
+	}
+	return ids
+}
+
+// Registra os FAMC e FAMS que apontam para famílias inexistentes.
+func (imp *gedcomImport) checkFamilyLinks() {
+	for _, individual := range imp.doc.Individuals {
+		for _, famC := range individual.FamC {
+			if imp.doc.Family(famC.XRef) == nil {
+				imp.summary.Relationships.Invalid++
+				imp.issue(famC.Line, individual.XRef, fmt.Sprintf("unknown family %s", famC.XRef))
+			}
+		}
+		for _, famS := range individual.FamS {
+			if imp.doc.Family(famS) == nil {
+				imp.summary.Relationships.Invalid++
+				imp.issue(individual.Line, individual.XRef, fmt.Sprintf("unknown family %s", famS))
+			}
+		}
+	}
+}
+
+func (imp *gedcomImport) issue(line int, record, message string) {
+	imp.summary.Issues = append(imp.summary.Issues, entity.ImportIssue{
+		Line:    line,
+		Record:  record,
+		Message: message,
+	})
+}
+
+// Chave para identificar relacionamentos repetidos. Uniões não possuem ordem entre as pessoas.
+func relationshipKey(rr *entity.Relationship) string {
+	main, secunde := rr.MainPersonID, rr.SecundePersonID
+	if rr.IsUnion() && secunde < main {
+		main, secunde = secunde, main
+	}
+	return rr.KindOrDefault() + ":" + main + ":" + secunde
+}
+
+// SEX U (desconhecido) ou ausente, ambos válidos no GEDCOM, viram pessoas sem sexo informado.
+func toGender(sex string) string {
+	if sex == "M" || sex == "F" {
+		return sex
+	}
+	return ""
+}
+
+// Eventos sem data e local (ex.: "1 MARR Y") apenas indicam o tipo da união.
+func toRelationshipEvent(event *gedcom.Event) *entity.RelationshipEvent {
+	if event == nil || (event.Date == "" && event.Place == "") {
+		return nil
+	}
+	return &entity.RelationshipEvent{
+		Date:  gedcom.ISODate(event.Date),
+		Place: event.Place,
+	}
+}
+
+// Converte os eventos do indivíduo. EVEN com TYPE de um tipo conhecido (ex.: marriage) vira um
+// evento desse tipo; os demais viram eventos custom, com o TYPE na descrição.
+func toPersonEvents(individual *gedcom.Individual) []*entity.Event {
+	var events []*entity.Event
+	add := func(eventType string, event *gedcom.Event, description string) {
+		if event == nil {
+			return
+		}
+		events = append(events, &entity.Event{
+			Type:        eventType,
+			Date:        gedcom.ISODate(event.Date),
+			Place:       event.Place,
+			Description: description,
+		})
+	}
+
+	add(entity.EventBirth, individual.Birth, "")
+	add(entity.EventBaptism, individual.Baptism, "")
+	add(entity.EventDeath, individual.Death, "")
+	add(entity.EventBurial, individual.Burial, "")
+	for _, residence := range individual.Residences {
+		add(entity.EventResidence, residence, "")
+	}
+	for _, event := range individual.Events {
+		switch eventType := strings.ToLower(event.Type); eventType {
+		case entity.EventBirth, entity.EventBaptism, entity.EventMarriage, entity.EventDeath, entity.EventBurial, entity.EventResidence:
+			add(eventType, event, "")
+		default:
+			add(entity.EventCustom, event, event.Type)
+		}
+	}
+	return events
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
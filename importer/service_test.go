@@ -0,0 +1,384 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	mock_person "github.com/GeovaneCavalcante/tree-genealogical/person/mock"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	mock_relationship "github.com/GeovaneCavalcante/tree-genealogical/relationship/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const family = `0 HEAD
+0 @I1@ INDI
+1 NAME John /Smith/
+1 SEX M
+1 FAMS @F1@
+0 @I2@ INDI
+1 NAME Mary /Smith/
+1 SEX F
+1 FAMS @F1@
+0 @I3@ INDI
+1 NAME Anne /Smith/
+1 SEX F
+1 FAMC @F1@
+2 PEDI adopted
+0 @I4@ INDI
+1 NAME Nobody
+1 FAMC @F1@
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 CHIL @I3@
+1 CHIL @I9@
+1 MARR
+2 DATE 1975
+0 TRLR
+`
+
+type ImporterTestSuite struct {
+	suite.Suite
+	PersonRepoMock          *mock_person.MockRepository
+	RelationshipServiceMock *mock_relationship.MockUseCase
+	created                 []*entity.Relationship
+}
+
+func (suite *ImporterTestSuite) SetupTest() {
+	ctrl := gomock.NewController(suite.T())
+	suite.PersonRepoMock = mock_person.NewMockRepository(ctrl)
+	suite.RelationshipServiceMock = mock_relationship.NewMockUseCase(ctrl)
+	suite.created = nil
+}
+
+// Simula o repositório gerando o ID a partir do nome da pessoa.
+func (suite *ImporterTestSuite) expectPersons(existing map[string]string) {
+	suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, name string) (*entity.Person, error) {
+		if id, ok := existing[name]; ok {
+			return &entity.Person{ID: id, Name: name}, nil
+		}
+		return nil, person.ErrNotFound
+	}).AnyTimes()
+	suite.PersonRepoMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.Person) error {
+		p.ID = "id-" + p.Name
+		return nil
+	}).AnyTimes()
+}
+
+func (suite *ImporterTestSuite) expectRelationships(existing []*entity.Relationship) {
+	suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(existing, len(existing), nil)
+	suite.RelationshipServiceMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rr *entity.Relationship) error {
+		suite.created = append(suite.created, rr)
+		return nil
+	}).AnyTimes()
+}
+
+func (suite *ImporterTestSuite) TestImportGedcom() {
+	ctx := context.Background()
+
+	suite.Run("should create persons and relationships", func() {
+		suite.SetupTest()
+		suite.expectPersons(nil)
+		suite.expectRelationships(nil)
+
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), nil)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 4}, summary.Persons)
+		suite.Equal(entity.ImportCounts{Created: 5, Invalid: 1}, summary.Relationships)
+		suite.Equal([]entity.ImportIssue{
+			{Line: 18, Record: "@F1@", Message: "unknown individual @I9@"},
+		}, summary.Issues)
+
+		suite.Require().Len(suite.created, 5)
+		suite.Equal(&entity.Relationship{Kind: entity.RelationshipKindParent, MainPersonID: "id-Anne Smith", SecundePersonID: "id-John Smith", Parentage: entity.ParentageAdoptive}, suite.created[0])
+		suite.Equal("id-Mary Smith", suite.created[1].SecundePersonID)
+		suite.Equal(&entity.Relationship{Kind: entity.RelationshipKindParent, MainPersonID: "id-Nobody", SecundePersonID: "id-John Smith"}, suite.created[2])
+		suite.Equal(entity.RelationshipKindSpouse, suite.created[4].Kind)
+		suite.Equal(&entity.RelationshipEvent{Date: "1975"}, suite.created[4].Start)
+	})
+
+	suite.Run("should create the union according to the family events", func() {
+		suite.SetupTest()
+		suite.expectPersons(nil)
+		suite.expectRelationships(nil)
+
+		input := `0 @I1@ INDI
+1 NAME John
+1 SEX M
+0 @I2@ INDI
+1 NAME Mary
+1 SEX F
+0 @I3@ INDI
+1 NAME Paul
+1 SEX M
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 EVEN
+2 TYPE partnership
+2 DATE 12 MAY 1990
+1 EVEN
+2 TYPE separation
+0 @F2@ FAM
+1 HUSB @I3@
+1 WIFE @I2@
+0 @F3@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 MARR Y
+`
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(input), nil)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 2}, summary.Relationships)
+		suite.Require().Len(suite.created, 2)
+		suite.Equal(&entity.Relationship{
+			Kind:            entity.RelationshipKindPartner,
+			MainPersonID:    "id-John",
+			SecundePersonID: "id-Mary",
+			Start:           &entity.RelationshipEvent{Date: "1990-05-12"},
+		}, suite.created[0])
+		suite.Equal(&entity.Relationship{
+			Kind:            entity.RelationshipKindSpouse,
+			MainPersonID:    "id-John",
+			SecundePersonID: "id-Mary",
+		}, suite.created[1])
+	})
+
+	suite.Run("should merge persons by name and skip relationships that already exist", func() {
+		suite.SetupTest()
+		suite.expectPersons(map[string]string{"John Smith": "john", "Mary Smith": "mary"})
+		suite.expectRelationships([]*entity.Relationship{
+			{Kind: entity.RelationshipKindSpouse, MainPersonID: "mary", SecundePersonID: "john"},
+		})
+
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), &entity.ImportOptions{MergeByName: true})
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 2, Skipped: 2}, summary.Persons)
+		suite.Equal(entity.ImportCounts{Created: 4, Skipped: 1, Invalid: 1}, summary.Relationships)
+	})
+
+	suite.Run("should not merge persons by name without the option", func() {
+		suite.SetupTest()
+		suite.expectPersons(map[string]string{"John Smith": "john", "Mary Smith": "mary"})
+		suite.expectRelationships(nil)
+
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), nil)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 4}, summary.Persons)
+		suite.Equal("id-John Smith", suite.created[0].SecundePersonID)
+	})
+
+	suite.Run("should report line errors and unknown families", func() {
+		suite.SetupTest()
+		suite.expectPersons(nil)
+		suite.expectRelationships(nil)
+
+		input := "0 @I1@ INDI\n1 NAME John\n1 SEX M\n1 FAMC @F9@\nbroken\n"
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(input), nil)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 1}, summary.Persons)
+		suite.Equal(entity.ImportCounts{Invalid: 1}, summary.Relationships)
+		suite.Equal([]entity.ImportIssue{
+			{Line: 4, Record: "@I1@", Message: "unknown family @F9@"},
+			{Line: 5, Message: `invalid level "broken"`},
+		}, summary.Issues)
+	})
+
+	suite.Run("should return error when the repository fails", func() {
+		suite.SetupTest()
+		suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+		suite.PersonRepoMock.EXPECT().GetByName(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), &entity.ImportOptions{MergeByName: true})
+		suite.Nil(summary)
+		suite.EqualError(err, "get person error: error")
+	})
+
+	suite.Run("should register the relationships that break the tree rules", func() {
+		suite.SetupTest()
+		suite.expectPersons(nil)
+		suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+		suite.RelationshipServiceMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rr *entity.Relationship) error {
+			if rr.MainPersonID == "id-Nobody" {
+				return fmt.Errorf("create relationship error: %w", &relationship.ValidationError{Violations: []relationship.Violation{
+					{Field: "child", Code: relationship.ViolationBiologicalParents, Message: "a person cannot have more than 2 biological parents"},
+				}})
+			}
+			return nil
+		}).AnyTimes()
+
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), nil)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 3, Invalid: 3}, summary.Relationships)
+		suite.Contains(summary.Issues, entity.ImportIssue{Line: 18, Record: "@F1@", Message: "invalid relationship: a person cannot have more than 2 biological parents"})
+	})
+
+	suite.Run("should remove what was created when the import fails", func() {
+		suite.SetupTest()
+		suite.expectPersons(nil)
+		suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+		suite.RelationshipServiceMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rr *entity.Relationship) error {
+			if rr.MainPersonID == "id-Nobody" {
+				return errors.New("create relationship error: database error")
+			}
+			rr.ID = "rel-" + rr.SecundePersonID
+			return nil
+		}).AnyTimes()
+
+		deleteRelationship := suite.RelationshipServiceMock.EXPECT().Delete(gomock.Any(), "rel-id-Mary Smith").Return(nil)
+		suite.RelationshipServiceMock.EXPECT().Delete(gomock.Any(), "rel-id-John Smith").Return(nil).After(deleteRelationship)
+		for _, id := range []string{"id-Nobody", "id-Anne Smith", "id-Mary Smith", "id-John Smith"} {
+			suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), id, true).Return(nil)
+		}
+
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), nil)
+		suite.Nil(summary)
+		suite.EqualError(err, "create relationship error: database error")
+	})
+
+	suite.Run("should report the persons not removed when the rollback fails", func() {
+		suite.SetupTest()
+		suite.expectPersons(nil)
+		suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+		suite.RelationshipServiceMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("create relationship error: database error"))
+		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), "id-Nobody", true).Return(errors.New("delete error"))
+		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), gomock.Any(), true).Return(nil).Times(3)
+
+		_, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), nil)
+		suite.EqualError(err, "create relationship error: database error; rollback error: delete error; persons not removed: [id-Nobody]")
+	})
+
+	suite.Run("should return error when listing relationships fails", func() {
+		suite.SetupTest()
+		suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, errors.New("error"))
+
+		_, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(ctx, strings.NewReader(family), nil)
+		suite.EqualError(err, "list relationship error: error")
+	})
+}
+
+const namesakes = `0 @I1@ INDI
+1 NAME John /Smith/
+1 SEX M
+1 FAMS @F1@
+0 @I2@ INDI
+1 NAME John /Smith/
+1 SEX U
+1 FAMC @F1@
+0 @F1@ FAM
+1 HUSB @I1@
+1 CHIL @I2@
+`
+
+func (suite *ImporterTestSuite) TestImportGedcomNamesakes() {
+	ctx := context.Background()
+
+	suite.Run("should create one person per cross-reference", func() {
+		db, summary, err := importGedcom(ctx, namesakes)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 2}, summary.Persons)
+		suite.Equal(entity.ImportCounts{Created: 1}, summary.Relationships)
+		persons := db.Persons()
+		suite.Require().Len(persons, 2)
+		suite.NotEqual(persons[0].ID, persons[1].ID)
+		suite.Equal("M", persons[0].Gender)
+		suite.Equal("", persons[1].Gender)
+
+		rr := db.Relationships()[0]
+		suite.Equal(persons[1].ID, rr.MainPersonID)
+		suite.Equal(persons[0].ID, rr.SecundePersonID)
+	})
+
+	suite.Run("should merge only one cross-reference with the registered person", func() {
+		db := database.NewEmpty()
+		john := database.NewPerson(db, "John Smith", "M", "", "")
+
+		summary, err := importGedcomInto(ctx, db, namesakes, &entity.ImportOptions{MergeByName: true})
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 1, Skipped: 1}, summary.Persons)
+		suite.Require().Len(db.Persons(), 2)
+		rr := db.Relationships()[0]
+		suite.Equal(john.ID, rr.SecundePersonID)
+		suite.NotEqual(john.ID, rr.MainPersonID)
+	})
+}
+
+func (suite *ImporterTestSuite) TestImportGedcomTreeRules() {
+	suite.Run("should not store a cycle between parent and child", func() {
+		input := `0 @I1@ INDI
+1 NAME John
+1 SEX M
+1 FAMC @F2@
+0 @I2@ INDI
+1 NAME Paul
+1 SEX M
+1 FAMC @F1@
+0 @F1@ FAM
+1 HUSB @I1@
+0 @F2@ FAM
+1 HUSB @I2@
+`
+		db, summary, err := importGedcom(context.Background(), input)
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 1, Invalid: 1}, summary.Relationships)
+		suite.Equal([]entity.ImportIssue{{Line: 11, Record: "@F2@", Message: "invalid relationship: the parent is a descendant of the child"}}, summary.Issues)
+		suite.Len(db.Relationships(), 1)
+	})
+}
+
+func (suite *ImporterTestSuite) TestImportGedcomDanglingFamilyReference() {
+	suite.Run("should record the relationship with a person that no longer exists", func() {
+		suite.expectPersons(map[string]string{"John": "removed"})
+		suite.RelationshipServiceMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+		suite.RelationshipServiceMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rr *entity.Relationship) error {
+			if rr.SecundePersonID == "removed" {
+				return fmt.Errorf("create relationship error: %w", relationship.ErrUnknownPerson)
+			}
+			suite.created = append(suite.created, rr)
+			return nil
+		}).AnyTimes()
+
+		input := `0 @I1@ INDI
+1 NAME John
+1 SEX M
+1 FAMS @F1@
+0 @I2@ INDI
+1 NAME Mary
+1 SEX F
+1 FAMS @F1@
+0 @I3@ INDI
+1 NAME Paul
+1 SEX M
+1 FAMC @F1@
+0 @F1@ FAM
+`
+		summary, err := NewService(suite.PersonRepoMock, suite.RelationshipServiceMock).ImportGedcom(context.Background(), strings.NewReader(input), &entity.ImportOptions{MergeByName: true})
+		suite.Require().NoError(err)
+
+		suite.Equal(entity.ImportCounts{Created: 2, Skipped: 1}, summary.Persons)
+		suite.Equal(entity.ImportCounts{Created: 1, Invalid: 1}, summary.Relationships)
+		suite.Equal([]entity.ImportIssue{{Line: 13, Record: "@F1@", Message: "relationship person does not exist"}}, summary.Issues)
+		suite.Require().Len(suite.created, 1)
+		suite.Equal("id-Mary", suite.created[0].SecundePersonID)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ImporterTestSuite))
+}
@@ -0,0 +1,10 @@
+package entity
+
+// Ancestral compartilhado por duas pessoas, com o número de gerações de cada uma até ele.
+type CommonAncestor struct {
+	Person           *Person
+	FirstGeneration  int
+	SecondGeneration int
+	// Ancestral comum mais recente: não é ancestral de nenhum outro ancestral comum.
+	MostRecent bool
+}
@@ -0,0 +1,12 @@
+package entity
+
+// Coeficientes genéticos de duas pessoas, calculados apenas com os pais genéticos.
+type Coefficients struct {
+	// Coeficiente de parentesco de Wright (r): fração esperada de genes em comum por descendência.
+	Relationship float64
+	// Coeficiente de consanguinidade (kinship): probabilidade de um gene sorteado em cada pessoa ser idêntico por descendência.
+	Kinship float64
+	// Coeficientes de endogamia (F) de cada pessoa.
+	FirstInbreeding  float64
+	SecondInbreeding float64
+}
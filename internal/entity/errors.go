@@ -0,0 +1,37 @@
+package entity
+
+import "errors"
+
+// Tipos de erro do domínio. Os erros de cada pacote indicam o tipo pelo Unwrap, para
+// que as camadas externas o identifiquem com errors.Is sem conhecer cada erro.
+var (
+	ErrNotFound   = errors.New("not found")
+	ErrConflict   = errors.New("conflict")
+	ErrValidation = errors.New("validation failed")
+)
+
+// Erro do domínio com mensagem própria e um dos tipos acima.
+type DomainError struct {
+	Kind    error
+	Message string
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Kind
+}
+
+func NewNotFoundError(message string) *DomainError {
+	return &DomainError{Kind: ErrNotFound, Message: message}
+}
+
+func NewConflictError(message string) *DomainError {
+	return &DomainError{Kind: ErrConflict, Message: message}
+}
+
+func NewValidationError(message string) *DomainError {
+	return &DomainError{Kind: ErrValidation, Message: message}
+}
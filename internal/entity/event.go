@@ -0,0 +1,23 @@
+package entity
+
+// Tipos de evento da vida de uma pessoa. Eventos custom são descritos em Description.
+const (
+	EventBirth     = "birth"
+	EventBaptism   = "baptism"
+	EventMarriage  = "marriage"
+	EventDeath     = "death"
+	EventBurial    = "burial"
+	EventResidence = "residence"
+	EventCustom    = "custom"
+)
+
+// Evento da vida de uma pessoa. Date aceita datas parciais e aproximadas (1890, ABT 1890,
+// BET 1900 AND 1905), no formato de pkg/dates.
+type Event struct {
+	ID          string
+	PersonID    string
+	Type        string
+	Date        string
+	Place       string
+	Description string
+}
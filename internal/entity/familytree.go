@@ -0,0 +1,39 @@
+package entity
+
+// Resultado imutável do cálculo de uma árvore genealógica.
+type FamilyTree struct {
+	root      *Person
+	relatives []Relative
+}
+
+func NewFamilyTree(root *Person, relatives []*Relative) *FamilyTree {
+	ft := &FamilyTree{
+		root:      root,
+		relatives: make([]Relative, 0, len(relatives)),
+	}
+	for _, r := range relatives {
+		ft.relatives = append(ft.relatives, *r)
+	}
+	return ft
+}
+
+// Pessoa a partir da qual a árvore foi construída.
+func (ft *FamilyTree) Root() *Person {
+	if ft == nil {
+		return nil
+	}
+	return ft.root
+}
+
+// Retorna uma cópia dos parentes encontrados, alterações não afetam a árvore.
+func (ft *FamilyTree) Relatives() []*Relative {
+	if ft == nil {
+		return nil
+	}
+	relatives := make([]*Relative, 0, len(ft.relatives))
+	for _, r := range ft.relatives {
+		relative := r
+		relatives = append(relatives, &relative)
+	}
+	return relatives
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+)
+
+// Campos de ordenação das listagens. Sem campo, a ordem é a de cadastro.
+const (
+	SortName = "name"
+)
+
+// Direção da ordenação das listagens.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Paginação por deslocamento. Limit zero retorna todos os registros a partir do Offset.
+type Page struct {
+	Offset int
+	Limit  int
+}
+
+// Aplica a paginação sobre uma lista já filtrada e ordenada.
+func Paginate[T any](items []T, page Page) []T {
+	if page.Offset >= len(items) {
+		return items[:0]
+	}
+	items = items[page.Offset:]
+	if page.Limit > 0 && page.Limit < len(items) {
+		items = items[:page.Limit]
+	}
+	return items
+}
+
+type PersonFilter struct {
+	// Trecho do nome, sem diferenciar maiúsculas e minúsculas.
+	Name   string
+	Gender string
+	// Filhos do pai/mãe informado.
+	ParentID string
+	// Pais/mães do filho informado.
+	ChildID string
+	Sort    string
+	Order   string
+	Page
+}
+
+// Verifica os campos da pessoa. Os filtros por pai/mãe e filho dependem dos
+// relacionamentos e ficam a cargo de cada repositório.
+func (f *PersonFilter) Match(person *Person) bool {
+	if f == nil {
+		return true
+	}
+	if f.Name != "" && !strings.Contains(strings.ToLower(person.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	return f.Gender == "" || person.Gender == f.Gender
+}
+
+// Ordena as pessoas mantendo a ordem de cadastro entre nomes iguais.
+func (f *PersonFilter) SortPersons(persons []*Person) {
+	if f == nil {
+		return
+	}
+	if f.Sort == SortName {
+		sort.SliceStable(persons, func(i, j int) bool {
+			return strings.ToLower(persons[i].Name) < strings.ToLower(persons[j].Name)
+		})
+	}
+	if f.Order == OrderDesc {
+		reverse(persons)
+	}
+}
+
+type RelationshipFilter struct {
+	Kind string
+	// Relacionamentos em que a pessoa participa, em qualquer ponta.
+	PersonID string
+	// Paternidades do pai/mãe informado.
+	ParentID string
+	// Paternidades do filho informado.
+	ChildID string
+	Order   string
+	Page
+}
+
+func (f *RelationshipFilter) Match(relationship *Relationship) bool {
+	if f == nil {
+		return true
+	}
+	if f.Kind != "" && relationship.KindOrDefault() != f.Kind {
+		return false
+	}
+	if f.PersonID != "" && relationship.MainPersonID != f.PersonID && relationship.SecundePersonID != f.PersonID {
+		return false
+	}
+	if f.ParentID != "" && (!relationship.IsParent() || relationship.SecundePersonID != f.ParentID) {
+		return false
+	}
+	return f.ChildID == "" || relationship.IsParent() && relationship.MainPersonID == f.ChildID
+}
+
+func (f *RelationshipFilter) SortRelationships(relationships []*Relationship) {
+	if f != nil && f.Order == OrderDesc {
+		reverse(relationships)
+	}
+}
+
+func reverse[T any](items []T) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
@@ -0,0 +1,7 @@
+package entity
+
+// Pessoas a uma mesma distância de gerações da pessoa consultada. O nível 0 é a própria pessoa.
+type Generation struct {
+	Level   int
+	Persons []*Person
+}
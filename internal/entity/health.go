@@ -0,0 +1,47 @@
+package entity
+
+// Gravidade de um problema de qualidade dos dados da árvore.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Códigos dos problemas encontrados no relatório de saúde da árvore.
+const (
+	HealthOrphanedRelationship  = "orphaned_relationship"
+	HealthDanglingID            = "dangling_id"
+	HealthMissingGender         = "missing_gender"
+	HealthDuplicatePerson       = "duplicate_person"
+	HealthDisconnectedComponent = "disconnected_component"
+)
+
+// Tipos de registro referenciados por um problema.
+const (
+	EntityPerson       = "person"
+	EntityRelationship = "relationship"
+)
+
+// Referência ao registro com problema.
+type EntityRef struct {
+	Type string
+	ID   string
+}
+
+// Problema encontrado nos dados. Related lista outros registros envolvidos, como a pessoa
+// da qual outra parece ser duplicada.
+type HealthIssue struct {
+	Code     string
+	Severity string
+	Message  string
+	Entity   EntityRef
+	Related  []EntityRef
+}
+
+// Resultado da verificação de todas as pessoas e relacionamentos cadastrados.
+type HealthReport struct {
+	Persons       int
+	Relationships int
+	Components    int
+	Issues        []HealthIssue
+}
@@ -0,0 +1,28 @@
+package entity
+
+// Quantidade de registros criados, ignorados por já existirem e inválidos em uma importação.
+type ImportCounts struct {
+	Created int
+	Skipped int
+	Invalid int
+}
+
+// Problema encontrado em uma linha ou registro do arquivo importado.
+type ImportIssue struct {
+	Line    int
+	Record  string
+	Message string
+}
+
+// Resumo de uma importação de arquivo.
+type ImportSummary struct {
+	Persons       ImportCounts
+	Relationships ImportCounts
+	Issues        []ImportIssue
+}
+
+// Opções de uma importação. Com MergeByName, indivíduos com o nome de uma pessoa já cadastrada
+// são ligados a ela em vez de criar uma nova pessoa.
+type ImportOptions struct {
+	MergeByName bool
+}
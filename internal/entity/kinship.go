@@ -0,0 +1,43 @@
+package entity
+
+import "strings"
+
+// Ligação entre uma pessoa do caminho de parentesco e a pessoa seguinte.
+const (
+	KinshipChildOf  = "child_of"
+	KinshipParentOf = "parent_of"
+)
+
+// Pessoa do caminho de parentesco e a sua ligação com a próxima pessoa. Na última pessoa a ligação é vazia.
+type KinshipStep struct {
+	Person   *Person
+	Relation string
+}
+
+// Menor caminho entre duas pessoas percorrendo as ligações de pais e filhos.
+type KinshipPath struct {
+	Steps []*KinshipStep
+}
+
+// Número de gerações percorridas no caminho. Um caminho nil indica pessoas sem parentesco.
+func (p *KinshipPath) Distance() int {
+	if p == nil || len(p.Steps) == 0 {
+		return 0
+	}
+	return len(p.Steps) - 1
+}
+
+// Caminho legível, ex.: Bruce → (child of) Phoebe → (child of) Martin.
+func (p *KinshipPath) String() string {
+	if p == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, step := range p.Steps {
+		b.WriteString(step.Person.Name)
+		if step.Relation != "" {
+			b.WriteString(" → (" + strings.ReplaceAll(step.Relation, "_", " ") + ") ")
+		}
+	}
+	return b.String()
+}
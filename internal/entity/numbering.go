@@ -0,0 +1,16 @@
+package entity
+
+// Sistemas de numeração de relatórios genealógicos.
+const (
+	// Ahnentafel (Sosa-Stradonitz): a pessoa é 1, o pai de n é 2n e a mãe 2n+1.
+	NumberingAhnentafel = "ahnentafel"
+	// d'Aboville: a pessoa é 1 e os filhos de n são n.1, n.2... em ordem de nascimento.
+	NumberingDAboville = "daboville"
+)
+
+// Pessoa de um relatório numerado. Com colapso de pedigree, a mesma pessoa pode ter mais de um número.
+type NumberedPerson struct {
+	Number     string
+	Generation int
+	Person     *Person
+}
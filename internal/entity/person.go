@@ -1,9 +1,39 @@
 package entity
 
+import "github.com/GeovaneCavalcante/tree-genealogical/pkg/dates"
+
 type Person struct {
-	ID            string          `json:"id"`
-	Name          string          `json:"name"`
-	Gender        string          `json:"gender"`
-	Level         int             `json:"level"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Gender string `json:"gender"`
+	Level  int    `json:"level"`
+	// Relacionamentos de paternidade em que a pessoa é o filho.
 	Relationships []*Relationship `json:"relationships"`
+	// Casamentos e uniões em que a pessoa participa, em qualquer ponta.
+	Unions []*Relationship `json:"unions"`
+	// Eventos da vida da pessoa (nascimento, batismo, óbito...).
+	Events []*Event `json:"events"`
+}
+
+// Primeiro evento do tipo informado, ou nil quando a pessoa não possui.
+func (p *Person) Event(eventType string) *Event {
+	for _, e := range p.Events {
+		if e.Type == eventType {
+			return e
+		}
+	}
+	return nil
+}
+
+// Data de nascimento da pessoa. Retorna false quando não há nascimento com data válida.
+func (p *Person) BirthDate() (dates.Date, bool) {
+	birth := p.Event(EventBirth)
+	if birth == nil || birth.Date == "" {
+		return dates.Date{}, false
+	}
+	d, err := dates.Parse(birth.Date)
+	if err != nil {
+		return dates.Date{}, false
+	}
+	return d, true
 }
@@ -1,9 +1,110 @@
 package entity
 
+// Tipos de relacionamento. Em um relacionamento de paternidade o MainPerson é o
+// filho e o SecundePerson o pai/mãe. Em casamentos e uniões as duas pontas são
+// os parceiros, sem ordem definida.
+const (
+	RelationshipKindParent  = "parent"
+	RelationshipKindSpouse  = "spouse"
+	RelationshipKindPartner = "partner"
+)
+
+// Tipos de paternidade de um relacionamento de paternidade. O doador é o pai/mãe genético e a
+// gestante por substituição não é pai/mãe genético nem legal.
+const (
+	ParentageBiological = "biological"
+	ParentageAdoptive   = "adoptive"
+	ParentageFoster     = "foster"
+	ParentageSurrogate  = "surrogate"
+	ParentageDonor      = "donor"
+)
+
+// Modo de cálculo da árvore genealógica quanto ao tipo de paternidade.
+type TreeMode string
+
+const (
+	// Considera todos os relacionamentos de paternidade.
+	TreeModeAll TreeMode = ""
+	// Considera apenas os pais genéticos (biológicos e doadores).
+	TreeModeBiological TreeMode = "biological"
+	// Considera apenas os pais legais (biológicos e adotivos).
+	TreeModeLegal TreeMode = "legal"
+)
+
+func (m TreeMode) Valid() bool {
+	return m == TreeModeAll || m == TreeModeBiological || m == TreeModeLegal
+}
+
+// Evento que inicia ou encerra um relacionamento (ex.: casamento e divórcio).
+type RelationshipEvent struct {
+	Date  string
+	Place string
+}
+
 type Relationship struct {
 	ID              string
+	Kind            string
 	MainPersonID    string
 	MainPerson      *Person
 	SecundePersonID string
 	SecundePerson   *Person
+	Start           *RelationshipEvent
+	End             *RelationshipEvent
+	Parentage       string
+}
+
+// Relacionamentos antigos não possuem tipo e são tratados como paternidade.
+func (r *Relationship) KindOrDefault() string {
+	if r.Kind == "" {
+		return RelationshipKindParent
+	}
+	return r.Kind
+}
+
+func (r *Relationship) IsParent() bool {
+	return r.KindOrDefault() == RelationshipKindParent
 }
+
+// Relacionamentos de paternidade sem tipo são biológicos.
+func (r *Relationship) ParentageOrDefault() string {
+	if r.Parentage == "" {
+		return ParentageBiological
+	}
+	return r.Parentage
+}
+
+// Verifica se o relacionamento de paternidade é considerado no modo informado.
+func (r *Relationship) InMode(mode TreeMode) bool {
+	switch mode {
+	case TreeModeBiological:
+		return r.ParentageOrDefault() == ParentageBiological || r.Parentage == ParentageDonor
+	case TreeModeLegal:
+		return r.ParentageOrDefault() == ParentageBiological || r.Parentage == ParentageAdoptive
+	}
+	return true
+}
+
+// Casamento ou união entre duas pessoas.
+func (r *Relationship) IsUnion() bool {
+	return r.Kind == RelationshipKindSpouse || r.Kind == RelationshipKindPartner
+}
+
+// Verifica se o relacionamento já foi encerrado (ex.: divórcio).
+func (r *Relationship) Ended() bool {
+	return r.End != nil
+}
+
+// Retorna o ID da outra ponta do relacionamento, ou vazio se a pessoa não faz parte dele.
+func (r *Relationship) OtherPersonID(personID string) string {
+	switch personID {
+	case r.MainPersonID:
+		return r.SecundePersonID
+	case r.SecundePersonID:
+		return r.MainPersonID
+	}
+	return ""
+}
+
+// Verificação de um relacionamento contra as pessoas, com os seus eventos, e os relacionamentos
+// cadastrados, executada pelos repositórios junto com a gravação. O erro retornado cancela a gravação.
+type RelationshipCheck func(persons []*Person, relationships []*Relationship) error
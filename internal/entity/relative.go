@@ -4,4 +4,6 @@ type Relative struct {
 	Type   string
 	Level  int
 	Person *Person
+	// Parente por afinidade (sogro, cunhado, genro...), e não consanguíneo.
+	InLaw bool
 }
@@ -0,0 +1,180 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/event"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a life event
+// @Description Create a birth, baptism, marriage, death, burial, residence or custom event for a person. Dates may be partial (1890, 1890-05), approximate (ABT 1890), bounded (BEF 1900) or ranges (BET 1900 AND 1905).
+// @Tags event
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Param event body presenter.EventRequest true "Event"
+// @Success 201 {object} presenter.EventResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /person/{id}/events [post]
+func createEventHandler(s event.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Create event started")
+		var e presenter.EventRequest
+		if err := bindData(c, &e); err != nil {
+			logger.Error("[Handler] Create event error: ", err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := e.Validate(); err != nil {
+			logger.Error("[Handler] Create event error: ", err)
+			respondInvalidRequest(c, err)
+			return
+		}
+
+		ee := e.ToEvent()
+
+		if err := s.Create(c, c.Param("id"), ee); err != nil {
+			logger.Error("[Handler] Create event error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Create event finished")
+		respondAccept(c, http.StatusCreated, presenter.NewEventResponse(ee))
+	}
+}
+
+// @Summary List life events
+// @Description List the life events of a person
+// @Tags event
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Success 200 {array} presenter.EventResponse
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /person/{id}/events [get]
+func listEventHandler(s event.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] List event started")
+
+		events, err := s.List(c, c.Param("id"))
+		if err != nil {
+			logger.Error("[Handler] List event error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] List event finished")
+		respondAccept(c, http.StatusOK, presenter.NewEventsResponse(events))
+	}
+}
+
+// @Summary Get a life event
+// @Description Get a life event of a person
+// @Tags event
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Param eventId path string true "Event ID"
+// @Success 200 {object} presenter.EventResponse
+// @Failure 404 {object} presenter.ProblemResponse "Person or event not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /person/{id}/events/{eventId} [get]
+func getEventHandler(s event.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Get event started")
+
+		e, err := s.Get(c, c.Param("id"), c.Param("eventId"))
+		if err != nil {
+			logger.Error("[Handler] Get event error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Get event finished")
+		respondAccept(c, http.StatusOK, presenter.NewEventResponse(e))
+	}
+}
+
+// @Summary Update a life event
+// @Description Update a life event of a person
+// @Tags event
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Param eventId path string true "Event ID"
+// @Param event body presenter.EventRequest true "Event"
+// @Success 200 {object} presenter.EventResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person or event not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /person/{id}/events/{eventId} [put]
+func updateEventHandler(s event.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Update event started")
+		var e presenter.EventRequest
+		if err := bindData(c, &e); err != nil {
+			logger.Error("[Handler] Update event error: ", err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := e.Validate(); err != nil {
+			logger.Error("[Handler] Update event error: ", err)
+			respondInvalidRequest(c, err)
+			return
+		}
+
+		ee := e.ToEvent()
+
+		if err := s.Update(c, c.Param("id"), c.Param("eventId"), ee); err != nil {
+			logger.Error("[Handler] Update event error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Update event finished")
+		respondAccept(c, http.StatusOK, presenter.NewEventResponse(ee))
+	}
+}
+
+// @Summary Delete a life event
+// @Description Delete a life event of a person
+// @Tags event
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Param eventId path string true "Event ID"
+// @Success 204
+// @Failure 404 {object} presenter.ProblemResponse "Person or event not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /person/{id}/events/{eventId} [delete]
+func deleteEventHandler(s event.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Delete event started")
+
+		if err := s.Delete(c, c.Param("id"), c.Param("eventId")); err != nil {
+			logger.Error("[Handler] Delete event error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Delete event finished")
+		respondAccept(c, http.StatusNoContent, nil)
+	}
+}
+
+func MakeEventHandlers(r *gin.RouterGroup, s event.UseCase) {
+	r.Handle("POST", "/:id/events", createEventHandler(s))
+	r.Handle("GET", "/:id/events", listEventHandler(s))
+	r.Handle("GET", "/:id/events/:eventId", getEventHandler(s))
+	r.Handle("PUT", "/:id/events/:eventId", updateEventHandler(s))
+	r.Handle("DELETE", "/:id/events/:eventId", deleteEventHandler(s))
+}
@@ -0,0 +1,160 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/event"
+	mock_event "github.com/GeovaneCavalcante/tree-genealogical/event/mock"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type EventHandlersTestSuite struct {
+	suite.Suite
+	EventService *mock_event.MockUseCase
+	Router       *gin.Engine
+	BaseUrl      string
+	Event        *entity.Event
+}
+
+func (suite *EventHandlersTestSuite) SetupTest() {
+	ctrl := gomock.NewController(suite.T())
+	suite.EventService = mock_event.NewMockUseCase(ctrl)
+	suite.Router = gin.Default()
+	suite.BaseUrl = "/api/v1/person/1/events"
+
+	MakeEventHandlers(suite.Router.Group("/api/v1/person"), suite.EventService)
+
+	suite.Event = &entity.Event{ID: "10", PersonID: "1", Type: entity.EventBirth, Date: "ABT 1890", Place: "Recife"}
+}
+
+func (suite *EventHandlersTestSuite) TestCreateEvent() {
+	suite.Run("should create the event with the normalized date", func() {
+		suite.EventService.EXPECT().Create(gomock.Any(), "1", gomock.Any()).DoAndReturn(func(_ interface{}, _ string, e *entity.Event) error {
+			e.ID = "10"
+			return nil
+		})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, strings.NewReader(`{"type":"birth","date":"ABT 12 MAY 1890","place":"Recife"}`))
+		req.Header.Set("Content-Type", "application/json")
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+		assert.Equal(suite.T(), `{"id":"10","type":"birth","date":"ABT 1890-05-12","place":"Recife"}`, w.Body.String())
+	})
+
+	suite.Run("should return bad request when the date is invalid", func() {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, strings.NewReader(`{"type":"birth","date":"someday"}`))
+		req.Header.Set("Content-Type", "application/json")
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("should return not found when the person does not exist", func() {
+		suite.EventService.EXPECT().Create(gomock.Any(), "1", gomock.Any()).Return(event.ErrPersonNotFound)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, strings.NewReader(`{"type":"death","date":"1950"}`))
+		req.Header.Set("Content-Type", "application/json")
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "person not found")
+	})
+}
+
+func (suite *EventHandlersTestSuite) TestListEvent() {
+	suite.Run("should list the events of the person", func() {
+		suite.EventService.EXPECT().List(gomock.Any(), "1").Return([]*entity.Event{suite.Event}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `[{"id":"10","type":"birth","date":"ABT 1890","place":"Recife"}]`, w.Body.String())
+	})
+
+	suite.Run("should return an empty list when the person has no events", func() {
+		suite.EventService.EXPECT().List(gomock.Any(), "1").Return([]*entity.Event{}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `[]`, w.Body.String())
+	})
+
+	suite.Run("should return internal server error when the service fails", func() {
+		suite.EventService.EXPECT().List(gomock.Any(), "1").Return(nil, errors.New("list event error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (suite *EventHandlersTestSuite) TestGetEvent() {
+	suite.Run("should return the event", func() {
+		suite.EventService.EXPECT().Get(gomock.Any(), "1", "10").Return(suite.Event, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl+"/10", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+	})
+
+	suite.Run("should return not found when the event does not exist", func() {
+		suite.EventService.EXPECT().Get(gomock.Any(), "1", "11").Return(nil, event.ErrEventNotFound)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl+"/11", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "event not found")
+	})
+}
+
+func (suite *EventHandlersTestSuite) TestUpdateEvent() {
+	suite.Run("should update the event", func() {
+		suite.EventService.EXPECT().Update(gomock.Any(), "1", "10", gomock.Any()).DoAndReturn(func(_ interface{}, _, id string, e *entity.Event) error {
+			e.ID = id
+			return nil
+		})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", suite.BaseUrl+"/10", strings.NewReader(`{"type":"custom","date":"1910","description":"Graduation"}`))
+		req.Header.Set("Content-Type", "application/json")
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `{"id":"10","type":"custom","date":"1910","description":"Graduation"}`, w.Body.String())
+	})
+
+	suite.Run("should return not found when the event does not exist", func() {
+		suite.EventService.EXPECT().Update(gomock.Any(), "1", "11", gomock.Any()).Return(event.ErrEventNotFound)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", suite.BaseUrl+"/11", strings.NewReader(`{"type":"death"}`))
+		req.Header.Set("Content-Type", "application/json")
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+}
+
+func (suite *EventHandlersTestSuite) TestDeleteEvent() {
+	suite.Run("should delete the event", func() {
+		suite.EventService.EXPECT().Delete(gomock.Any(), "1", "10").Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", suite.BaseUrl+"/10", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	})
+}
@@ -0,0 +1,140 @@
+package gin
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const gedcomContentType = "application/x-gedcom; charset=utf-8"
+
+// @Summary Export to GEDCOM
+// @Description Export all persons and relationships as a GEDCOM 5.5.1 file. Parent pairs and unions are grouped into FAM records.
+// @Tags export
+// @Produce octet-stream
+// @Success 200 {file} file "GEDCOM file"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /export/gedcom [get]
+func exportGedcomHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Export gedcom started")
+
+		doc, err := s.ExportGedcom(c)
+		if err != nil {
+			logger.Error("[Handler] Export gedcom error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Export gedcom finished")
+
+		respondGedcom(c, "familytree.ged", doc)
+	}
+}
+
+// @Summary Export family members to GEDCOM
+// @Description Export a person and the relatives found in their family tree as a GEDCOM 5.5.1 file
+// @Tags familytree
+// @Produce octet-stream
+// @Param personName path string true "Person Name"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Success 200 {file} file "GEDCOM file"
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Name matches more than one person"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/members/{personName}/gedcom [get]
+func exportFamilyGedcomHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Export family gedcom started")
+		personName := c.Param("personName")
+
+		if IsEmpty(personName) {
+			logger.Error("[Handler] Export family gedcom error: personName should not be empty", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "personName should not be empty")
+			return
+		}
+
+		mode := entity.TreeMode(c.Query("mode"))
+		if !mode.Valid() {
+			logger.Error("[Handler] Export family gedcom error: invalid mode", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "mode should be biological or legal")
+			return
+		}
+
+		doc, err := s.ExportFamilyGedcom(c, personName, mode)
+		if err != nil {
+			logger.Error("[Handler] Export family gedcom error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Export family gedcom finished")
+
+		respondGedcom(c, fmt.Sprintf("%s.ged", personName), doc)
+	}
+}
+
+// @Summary Export family members to GEDCOM by person ID
+// @Description Export the person with the given ID and the relatives found in their family tree as a GEDCOM 5.5.1 file
+// @Tags familytree
+// @Produce octet-stream
+// @Param id path string true "Person ID"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Success 200 {file} file "GEDCOM file"
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/gedcom [get]
+func exportFamilyGedcomByIDHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Export family gedcom by ID started")
+		personID := c.Param("id")
+
+		if IsEmpty(personID) {
+			logger.Error("[Handler] Export family gedcom by ID error: id should not be empty", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "id should not be empty")
+			return
+		}
+
+		mode := entity.TreeMode(c.Query("mode"))
+		if !mode.Valid() {
+			logger.Error("[Handler] Export family gedcom by ID error: invalid mode", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "mode should be biological or legal")
+			return
+		}
+
+		doc, err := s.ExportFamilyGedcomByID(c, personID, mode)
+		if err != nil {
+			logger.Error("[Handler] Export family gedcom by ID error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Export family gedcom by ID finished")
+
+		respondGedcom(c, fmt.Sprintf("%s.ged", personID), doc)
+	}
+}
+
+// Responde o documento como um arquivo GEDCOM para download.
+func respondGedcom(c *gin.Context, filename string, doc *gedcom.Document) {
+	var buf bytes.Buffer
+	if err := gedcom.Encode(&buf, doc); err != nil {
+		logger.Error("[Handler] Encode gedcom error: ", err)
+		respondProblemDetail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, gedcomContentType, buf.Bytes())
+}
+
+func MakeExportHandlers(r *gin.RouterGroup, s familytree.UseCase) {
+	r.GET("/gedcom", exportGedcomHandler(s))
+}
@@ -0,0 +1,134 @@
+package gin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	mock_familytree "github.com/GeovaneCavalcante/tree-genealogical/familytree/mock"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/gedcom"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ExportHandlersTestSuite struct {
+	suite.Suite
+	FamilyTreeService *mock_familytree.MockUseCase
+	Router            *gin.Engine
+	Document          *gedcom.Document
+	ExpectedGedcom    string
+}
+
+func (suite *ExportHandlersTestSuite) SetupTest() {
+	ctrl := gomock.NewController(suite.T())
+	suite.FamilyTreeService = mock_familytree.NewMockUseCase(ctrl)
+	suite.Router = gin.Default()
+
+	MakeExportHandlers(suite.Router.Group("/api/v1/export"), suite.FamilyTreeService)
+	MakeFamilyTreeHandlers(suite.Router.Group("/api/v1/familytree"), suite.FamilyTreeService)
+
+	suite.Document = &gedcom.Document{
+		Individuals: []*gedcom.Individual{{XRef: "@I1@", Name: "John", Sex: "M"}},
+	}
+	suite.ExpectedGedcom = "0 HEAD\n1 SOUR TREE-GENEALOGICAL\n1 SUBM @SUBM1@\n1 GEDC\n2 VERS 5.5.1\n2 FORM LINEAGE-LINKED\n1 CHAR UTF-8\n" +
+		"0 @I1@ INDI\n1 NAME John\n1 SEX M\n0 @SUBM1@ SUBM\n1 NAME TREE-GENEALOGICAL\n0 TRLR\n"
+}
+
+func (suite *ExportHandlersTestSuite) TestExportGedcom() {
+	suite.Run("should return the GEDCOM file", func() {
+		suite.FamilyTreeService.EXPECT().ExportGedcom(gomock.Any()).Return(suite.Document, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/export/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), gedcomContentType, w.Header().Get("Content-Type"))
+		assert.Equal(suite.T(), `attachment; filename="familytree.ged"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(suite.T(), suite.ExpectedGedcom, w.Body.String())
+	})
+
+	suite.Run("should return error when the export fails", func() {
+		suite.FamilyTreeService.EXPECT().ExportGedcom(gomock.Any()).Return(nil, errors.New("error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/export/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+		assertProblem(suite.T(), w, "error")
+	})
+}
+
+func (suite *ExportHandlersTestSuite) TestExportFamilyGedcom() {
+	suite.Run("should return the GEDCOM file of the person family", func() {
+		suite.FamilyTreeService.EXPECT().ExportFamilyGedcom(gomock.Any(), "John", entity.TreeModeLegal).Return(suite.Document, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/members/John/gedcom?mode=legal", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `attachment; filename="John.ged"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(suite.T(), suite.ExpectedGedcom, w.Body.String())
+	})
+
+	suite.Run("should return not found when the person does not exist", func() {
+		suite.FamilyTreeService.EXPECT().ExportFamilyGedcom(gomock.Any(), "John", entity.TreeModeAll).Return(nil, fmt.Errorf("%w: John", familytree.ErrPersonNotFound))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/members/John/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "person not found: John")
+	})
+
+	suite.Run("should return error when the mode is invalid", func() {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/members/John/gedcom?mode=other", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("should return error when personName is empty", func() {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/members/%20/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("should return error when the export fails", func() {
+		suite.FamilyTreeService.EXPECT().ExportFamilyGedcom(gomock.Any(), "John", entity.TreeModeAll).Return(nil, errors.New("error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/members/John/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (suite *ExportHandlersTestSuite) TestExportFamilyGedcomByID() {
+	suite.Run("should return the GEDCOM file of the person family", func() {
+		suite.FamilyTreeService.EXPECT().ExportFamilyGedcomByID(gomock.Any(), "1", entity.TreeModeAll).Return(suite.Document, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/persons/1/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `attachment; filename="1.ged"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(suite.T(), suite.ExpectedGedcom, w.Body.String())
+	})
+
+	suite.Run("should return not found when the person does not exist", func() {
+		suite.FamilyTreeService.EXPECT().ExportFamilyGedcomByID(gomock.Any(), "9", entity.TreeModeAll).Return(nil, familytree.ErrPersonNotFound)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/familytree/persons/9/gedcom", nil)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+}
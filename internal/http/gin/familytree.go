@@ -1,23 +1,34 @@
 package gin
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/chart"
 	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
 // @Summary Find family members
-// @Description Find family members
+// @Description Find family members. With Accept text/vnd.graphviz the tree is returned as a DOT graph and with image/svg+xml as an SVG chart.
 // @Tags familytree
 // @Accept json,xml
-// @Produce json,xml
+// @Produce json,xml,text/vnd.graphviz,image/svg+xml
 // @Param personName path string true "Person Name"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Param chart query string false "Graph restricted to the ancestors (pedigree) or descendants of the person" Enums(pedigree, descendants)
 // @Success 200 {object} presenter.FamilyTreeResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Name matches more than one person"
+// @Failure 406 {object} presenter.ProblemResponse "No acceptable representation"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /familytree/members/{personName} [get]
 func findFamilyMembersHandler(s familytree.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -26,18 +37,33 @@ func findFamilyMembersHandler(s familytree.UseCase) gin.HandlerFunc {
 
 		if IsEmpty(personName) {
 			logger.Error("[Handler] Find family members error: personName should not be empty", nil)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": "personName should not be empty"})
+			respondProblemDetail(c, http.StatusBadRequest, "personName should not be empty")
+			return
+		}
+
+		mode := entity.TreeMode(c.Query("mode"))
+		if !mode.Valid() {
+			logger.Error("[Handler] Find family members error: invalid mode", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "mode should be biological or legal")
+			return
+		}
+
+		chartKind := chart.Kind(c.Query("chart"))
+		if !chartKind.Valid() {
+			logger.Error("[Handler] Find family members error: invalid chart", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "chart should be pedigree or descendants")
 			return
 		}
 
-		relatives, err := s.GetAllFamilyMembers(c, personName)
+		relatives, err := s.GetAllFamilyMembers(c, personName, mode)
 		if err != nil {
 			logger.Error("[Handler] Find family members error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
 		r := presenter.NewFamilyTreeResponse(relatives)
+		r.UseChart(chartKind)
 
 		logger.Info("[Handler] Find family members finished")
 
@@ -53,31 +79,23 @@ func findFamilyMembersHandler(s familytree.UseCase) gin.HandlerFunc {
 // @Param firstPersonName path string true "First Person Name"
 // @Param secondPersonName path string true "Second Person Name"
 // @Success 200 {object} presenter.DetermineRelationResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Name matches more than one person"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /familytree/relationship/{firstPersonName}/{secondPersonName} [get]
 func determineRelationshipHandler(s familytree.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("[Handler] Determine relationship started")
-		firstPersonName := c.Param("firstPersonName")
-		secondPersonName := c.Param("secondPersonName")
-
-		if firstPersonName == secondPersonName {
-			logger.Error("[Handler] Determine relationship error: firstPersonName and secondPersonName should be different", nil)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": "firstPersonName and secondPersonName should be different"})
-			return
-		}
-
-		if IsEmpty(firstPersonName) || IsEmpty(secondPersonName) {
-			logger.Error("[Handler] Determine relationship error: firstPersonName and secondPersonName should not be empty", nil)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": "firstPersonName and secondPersonName should not be empty"})
+		firstPersonName, secondPersonName, ok := personPairParams(c, "Determine relationship", "firstPersonName", "secondPersonName")
+		if !ok {
 			return
 		}
 
 		relationship, err := s.DetermineRelationship(c, firstPersonName, secondPersonName)
 		if err != nil {
 			logger.Error("[Handler] Determine relationship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -91,38 +109,30 @@ func determineRelationshipHandler(s familytree.UseCase) gin.HandlerFunc {
 }
 
 // @Summary Determine kinship distance
-// @Description Determine kinship distance
+// @Description Determine the kinship distance as the shortest path over parent and child links, with the ordered chain of people and the direction of each link (child_of or parent_of)
 // @Tags familytree
 // @Accept json,xml
 // @Produce json,xml
 // @Param firstPersonName path string true "First Person Name"
 // @Param secondPersonName path string true "Second Person Name"
 // @Success 200 {object} presenter.KinshipDistanceResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Name matches more than one person"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /familytree/kinship/distance/{firstPersonName}/{secondPersonName} [get]
 func determineKinshipHandler(s familytree.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("[Handler] Determine kinship started")
-		firstPersonName := c.Param("firstPersonName")
-		secondPersonName := c.Param("secondPersonName")
-
-		if firstPersonName == secondPersonName {
-			logger.Error("[Handler] Determine kinship error: firstPersonName and secondPersonName should be different", nil)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": "firstPersonName and secondPersonName should be different"})
-			return
-		}
-
-		if IsEmpty(firstPersonName) || IsEmpty(secondPersonName) {
-			logger.Error("[Handler] Determine kinship error: firstPersonName and secondPersonName should not be empty", nil)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": "firstPersonName and secondPersonName should not be empty"})
+		firstPersonName, secondPersonName, ok := personPairParams(c, "Determine kinship", "firstPersonName", "secondPersonName")
+		if !ok {
 			return
 		}
 
 		distance, err := s.CalculateKinshipDistance(c, firstPersonName, secondPersonName)
 		if err != nil {
 			logger.Error("[Handler] Determine kinship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -134,8 +144,468 @@ func determineKinshipHandler(s familytree.UseCase) gin.HandlerFunc {
 	}
 }
 
+// @Summary Find family members by person ID
+// @Description Find family members of the person with the given ID. With Accept text/vnd.graphviz the tree is returned as a DOT graph and with image/svg+xml as an SVG chart.
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml,text/vnd.graphviz,image/svg+xml
+// @Param id path string true "Person ID"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Param chart query string false "Graph restricted to the ancestors (pedigree) or descendants of the person" Enums(pedigree, descendants)
+// @Success 200 {object} presenter.FamilyTreeResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 406 {object} presenter.ProblemResponse "No acceptable representation"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/members [get]
+func findFamilyMembersByIDHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Find family members by ID started")
+		personID := c.Param("id")
+
+		if IsEmpty(personID) {
+			logger.Error("[Handler] Find family members by ID error: id should not be empty", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "id should not be empty")
+			return
+		}
+
+		mode := entity.TreeMode(c.Query("mode"))
+		if !mode.Valid() {
+			logger.Error("[Handler] Find family members by ID error: invalid mode", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "mode should be biological or legal")
+			return
+		}
+
+		chartKind := chart.Kind(c.Query("chart"))
+		if !chartKind.Valid() {
+			logger.Error("[Handler] Find family members by ID error: invalid chart", nil)
+			respondProblemDetail(c, http.StatusBadRequest, "chart should be pedigree or descendants")
+			return
+		}
+
+		relatives, err := s.GetAllFamilyMembersByID(c, personID, mode)
+		if err != nil {
+			logger.Error("[Handler] Find family members by ID error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		r := presenter.NewFamilyTreeResponse(relatives)
+		r.UseChart(chartKind)
+
+		logger.Info("[Handler] Find family members by ID finished")
+
+		respondAccept(c, http.StatusOK, r)
+	}
+}
+
+// @Summary Determine relationship by person IDs
+// @Description Determine the relationship between the persons with the given IDs
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "First Person ID"
+// @Param otherId path string true "Second Person ID"
+// @Success 200 {object} presenter.DetermineRelationResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/relationship/{otherId} [get]
+func determineRelationshipByIDHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Determine relationship by ID started")
+		firstPersonID, secondPersonID, ok := personPairParams(c, "Determine relationship by ID", "id", "otherId")
+		if !ok {
+			return
+		}
+
+		relationship, err := s.DetermineRelationshipByID(c, firstPersonID, secondPersonID)
+		if err != nil {
+			logger.Error("[Handler] Determine relationship by ID error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Determine relationship by ID finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewDetermineRelationResponse(relationship))
+	}
+}
+
+// @Summary Determine kinship distance by person IDs
+// @Description Determine the kinship distance between the persons with the given IDs as the shortest path over parent and child links, with the ordered chain of people
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "First Person ID"
+// @Param otherId path string true "Second Person ID"
+// @Success 200 {object} presenter.KinshipDistanceResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/kinship/distance/{otherId} [get]
+func determineKinshipByIDHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Determine kinship by ID started")
+		firstPersonID, secondPersonID, ok := personPairParams(c, "Determine kinship by ID", "id", "otherId")
+		if !ok {
+			return
+		}
+
+		distance, err := s.CalculateKinshipDistanceByID(c, firstPersonID, secondPersonID)
+		if err != nil {
+			logger.Error("[Handler] Determine kinship by ID error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Determine kinship by ID finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewKinshipDistanceResponse(distance))
+	}
+}
+
+// @Summary Common ancestors
+// @Description List the ancestors shared by two persons, nearest first, with the number of generations from each person and the most recent common ancestors marked. A person who is an ancestor of the other is their common ancestor, zero generations away from themselves. Persons without shared ancestors return related false.
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param firstPersonName path string true "First Person Name"
+// @Param secondPersonName path string true "Second Person Name"
+// @Success 200 {object} presenter.CommonAncestorsResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Name matches more than one person"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/common-ancestors/{firstPersonName}/{secondPersonName} [get]
+func commonAncestorsHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Common ancestors started")
+		firstPersonName, secondPersonName, ok := personPairParams(c, "Common ancestors", "firstPersonName", "secondPersonName")
+		if !ok {
+			return
+		}
+
+		ancestors, err := s.CommonAncestors(c, firstPersonName, secondPersonName)
+		if err != nil {
+			logger.Error("[Handler] Common ancestors error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Common ancestors finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewCommonAncestorsResponse(ancestors))
+	}
+}
+
+// @Summary Common ancestors by person IDs
+// @Description List the ancestors shared by the persons with the given IDs, nearest first, with the most recent common ancestors marked
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "First Person ID"
+// @Param otherId path string true "Second Person ID"
+// @Success 200 {object} presenter.CommonAncestorsResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/common-ancestors/{otherId} [get]
+func commonAncestorsByIDHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Common ancestors by ID started")
+		firstPersonID, secondPersonID, ok := personPairParams(c, "Common ancestors by ID", "id", "otherId")
+		if !ok {
+			return
+		}
+
+		ancestors, err := s.CommonAncestorsByID(c, firstPersonID, secondPersonID)
+		if err != nil {
+			logger.Error("[Handler] Common ancestors by ID error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Common ancestors by ID finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewCommonAncestorsResponse(ancestors))
+	}
+}
+
+// @Summary Coefficient of relationship
+// @Description Wright's coefficient of relationship between two persons, their kinship coefficient and the inbreeding coefficient of each one. Only genetic parents (biological and donor) are considered and ancestors reached by more than one path (pedigree collapse) are counted once per path.
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param firstPersonName path string true "First Person Name"
+// @Param secondPersonName path string true "Second Person Name"
+// @Success 200 {object} presenter.CoefficientsResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Name matches more than one person"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/coefficients/{firstPersonName}/{secondPersonName} [get]
+func coefficientsHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Coefficients started")
+		firstPersonName, secondPersonName, ok := personPairParams(c, "Coefficients", "firstPersonName", "secondPersonName")
+		if !ok {
+			return
+		}
+
+		coefficients, err := s.Coefficients(c, firstPersonName, secondPersonName)
+		if err != nil {
+			logger.Error("[Handler] Coefficients error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Coefficients finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewCoefficientsResponse(coefficients))
+	}
+}
+
+// @Summary Coefficient of relationship by person IDs
+// @Description Wright's coefficient of relationship, kinship coefficient and inbreeding coefficients of the persons with the given IDs
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "First Person ID"
+// @Param otherId path string true "Second Person ID"
+// @Success 200 {object} presenter.CoefficientsResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/coefficients/{otherId} [get]
+func coefficientsByIDHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Coefficients by ID started")
+		firstPersonID, secondPersonID, ok := personPairParams(c, "Coefficients by ID", "id", "otherId")
+		if !ok {
+			return
+		}
+
+		coefficients, err := s.CoefficientsByID(c, firstPersonID, secondPersonID)
+		if err != nil {
+			logger.Error("[Handler] Coefficients by ID error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Coefficients by ID finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewCoefficientsResponse(coefficients))
+	}
+}
+
+// @Summary Ancestors by generation
+// @Description List the ancestors of the person grouped by generation, starting with the person at level 0, up to the given depth
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Param depth query int false "Number of generations, from 1 to 10 (default 10)"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Success 200 {object} presenter.GenerationsResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/ancestors [get]
+func ancestorsHandler(s familytree.UseCase) gin.HandlerFunc {
+	return generationsHandler("Ancestors", s.Ancestors)
+}
+
+// @Summary Descendants by generation
+// @Description List the descendants of the person grouped by generation, starting with the person at level 0, up to the given depth
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Person ID"
+// @Param depth query int false "Number of generations, from 1 to 10 (default 10)"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Success 200 {object} presenter.GenerationsResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/descendants [get]
+func descendantsHandler(s familytree.UseCase) gin.HandlerFunc {
+	return generationsHandler("Descendants", s.Descendants)
+}
+
+// Valida o ID, a profundidade e o modo e responde as gerações encontradas pela consulta.
+func generationsHandler(name string, query func(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.Generation, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] %s started", name))
+		personID, depth, mode, ok := generationParams(c, name)
+		if !ok {
+			return
+		}
+
+		generations, err := query(c, personID, depth, mode)
+		if err != nil {
+			logger.Error(fmt.Sprintf("[Handler] %s error: ", name), err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] %s finished", name))
+
+		respondAccept(c, http.StatusOK, presenter.NewGenerationsResponse(depth, generations))
+	}
+}
+
+// @Summary Ahnentafel numbering
+// @Description Number the ancestors of the person by the Ahnentafel (Sosa-Stradonitz) system: the person is 1, the father of n is 2n and the mother 2n+1. With Accept text/plain the list is returned as a printable report.
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml,plain
+// @Param id path string true "Person ID"
+// @Param depth query int false "Number of generations, from 1 to 10 (default 10)"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Success 200 {object} presenter.NumberingResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 406 {object} presenter.ProblemResponse "No acceptable representation"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/ahnentafel [get]
+func ahnentafelHandler(s familytree.UseCase) gin.HandlerFunc {
+	return numberingHandler("Ahnentafel", entity.NumberingAhnentafel, s.Ahnentafel)
+}
+
+// @Summary d'Aboville numbering
+// @Description Number the descendants of the person by the d'Aboville system: the person is 1 and the children of n are n.1, n.2... in birth order. With Accept text/plain the list is returned as a printable report.
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml,plain
+// @Param id path string true "Person ID"
+// @Param depth query int false "Number of generations, from 1 to 10 (default 10)"
+// @Param mode query string false "Parentage considered: biological (genetic parents) or legal (biological and adoptive parents)" Enums(biological, legal)
+// @Success 200 {object} presenter.NumberingResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 406 {object} presenter.ProblemResponse "No acceptable representation"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/persons/{id}/daboville [get]
+func dAbovilleHandler(s familytree.UseCase) gin.HandlerFunc {
+	return numberingHandler("DAboville", entity.NumberingDAboville, s.DAboville)
+}
+
+// Valida o ID, a profundidade e o modo e responde o relatório numerado.
+func numberingHandler(name, numbering string, query func(ctx context.Context, personID string, depth int, mode entity.TreeMode) ([]*entity.NumberedPerson, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] %s started", name))
+		personID, depth, mode, ok := generationParams(c, name)
+		if !ok {
+			return
+		}
+
+		persons, err := query(c, personID, depth, mode)
+		if err != nil {
+			logger.Error(fmt.Sprintf("[Handler] %s error: ", name), err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] %s finished", name))
+
+		respondAccept(c, http.StatusOK, presenter.NewNumberingResponse(numbering, depth, persons))
+	}
+}
+
+// Lê os IDs ou nomes das duas pessoas da rota, que devem ser informados e diferentes.
+func personPairParams(c *gin.Context, name, firstParam, secondParam string) (string, string, bool) {
+	first := c.Param(firstParam)
+	second := c.Param(secondParam)
+
+	// Os nomes são buscados sem diferenciar maiúsculas, então "John" e "john" são a mesma pessoa.
+	if strings.EqualFold(first, second) {
+		detail := fmt.Sprintf("%s and %s should be different", firstParam, secondParam)
+		logger.Error(fmt.Sprintf("[Handler] %s error: %s", name, detail), nil)
+		respondProblemDetail(c, http.StatusBadRequest, detail)
+		return "", "", false
+	}
+
+	if IsEmpty(first) || IsEmpty(second) {
+		detail := fmt.Sprintf("%s and %s should not be empty", firstParam, secondParam)
+		logger.Error(fmt.Sprintf("[Handler] %s error: %s", name, detail), nil)
+		respondProblemDetail(c, http.StatusBadRequest, detail)
+		return "", "", false
+	}
+
+	return first, second, true
+}
+
+// Lê o ID da pessoa, a profundidade e o modo das consultas por geração. A profundidade
+// não informada é a máxima; os limites são verificados pelo serviço.
+func generationParams(c *gin.Context, name string) (string, int, entity.TreeMode, bool) {
+	personID := c.Param("id")
+	if IsEmpty(personID) {
+		logger.Error(fmt.Sprintf("[Handler] %s error: id should not be empty", name), nil)
+		respondProblemDetail(c, http.StatusBadRequest, "id should not be empty")
+		return "", 0, "", false
+	}
+
+	depth := familytree.MaxDepth
+	if value := c.Query("depth"); value != "" {
+		var err error
+		if depth, err = strconv.Atoi(value); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] %s error: invalid depth", name), err)
+			respondProblemDetail(c, http.StatusBadRequest, familytree.ErrInvalidDepth.Error())
+			return "", 0, "", false
+		}
+	}
+
+	mode := entity.TreeMode(c.Query("mode"))
+	if !mode.Valid() {
+		logger.Error(fmt.Sprintf("[Handler] %s error: invalid mode", name), nil)
+		respondProblemDetail(c, http.StatusBadRequest, "mode should be biological or legal")
+		return "", 0, "", false
+	}
+
+	return personID, depth, mode, true
+}
+
+// @Summary Tree health report
+// @Description Scan all persons and relationships for data-quality issues: orphaned relationships, dangling person IDs, missing genders, likely duplicate people and disconnected components. Each issue has a severity (error, warning or info) and a reference to the offending entity.
+// @Tags familytree
+// @Accept json,xml
+// @Produce json,xml
+// @Success 200 {object} presenter.HealthReportResponse
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /familytree/health-report [get]
+func healthReportHandler(s familytree.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Health report started")
+
+		report, err := s.HealthReport(c)
+		if err != nil {
+			logger.Error("[Handler] Health report error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Health report finished")
+		respondAccept(c, http.StatusOK, presenter.NewHealthReportResponse(report))
+	}
+}
+
 func MakeFamilyTreeHandlers(r *gin.RouterGroup, s familytree.UseCase) {
 	r.Handle("GET", "/members/:personName", findFamilyMembersHandler(s))
+	r.Handle("GET", "/members/:personName/gedcom", exportFamilyGedcomHandler(s))
 	r.Handle("GET", "/relationship/:firstPersonName/:secondPersonName", determineRelationshipHandler(s))
 	r.Handle("GET", "/kinship/distance/:firstPersonName/:secondPersonName", determineKinshipHandler(s))
+	r.Handle("GET", "/persons/:id/members", findFamilyMembersByIDHandler(s))
+	r.Handle("GET", "/persons/:id/gedcom", exportFamilyGedcomByIDHandler(s))
+	r.Handle("GET", "/persons/:id/relationship/:otherId", determineRelationshipByIDHandler(s))
+	r.Handle("GET", "/persons/:id/kinship/distance/:otherId", determineKinshipByIDHandler(s))
+	r.Handle("GET", "/common-ancestors/:firstPersonName/:secondPersonName", commonAncestorsHandler(s))
+	r.Handle("GET", "/persons/:id/common-ancestors/:otherId", commonAncestorsByIDHandler(s))
+	r.Handle("GET", "/coefficients/:firstPersonName/:secondPersonName", coefficientsHandler(s))
+	r.Handle("GET", "/persons/:id/coefficients/:otherId", coefficientsByIDHandler(s))
+	r.Handle("GET", "/persons/:id/ancestors", ancestorsHandler(s))
+	r.Handle("GET", "/persons/:id/descendants", descendantsHandler(s))
+	r.Handle("GET", "/persons/:id/ahnentafel", ahnentafelHandler(s))
+	r.Handle("GET", "/persons/:id/daboville", dAbovilleHandler(s))
+	r.Handle("GET", "/health-report", healthReportHandler(s))
 }
@@ -1,10 +1,12 @@
 package gin
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
 	mock_familytree "github.com/GeovaneCavalcante/tree-genealogical/familytree/mock"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/gin-gonic/gin"
@@ -70,7 +72,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestGetFamilyTree() {
 	suite.Run("should return success when getting family tree", func() {
 
 		responseExpected := "{\"members\":[{\"name\":\"John\",\"typeRelationship\":\"Root\",\"relationships\":[]},{\"name\":\"Robert\",\"typeRelationship\":\"Father\",\"relationships\":[]},{\"name\":\"Maria\",\"typeRelationship\":\"Mother\",\"relationships\":[]}]}"
-		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name).Return(suite.FamilyTree, nil)
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name, entity.TreeModeAll).Return(suite.FamilyTree, nil)
 
 		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s", suite.BaseUrl, suite.PersonRoot.Name), nil)
 
@@ -83,7 +85,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestGetFamilyTree() {
 	})
 
 	suite.Run("should return error when getting family tree", func() {
-		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name).Return(nil, fmt.Errorf("get family tree error"))
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name, entity.TreeModeAll).Return(nil, fmt.Errorf("get family tree error"))
 
 		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s", suite.BaseUrl, suite.PersonRoot.Name), nil)
 
@@ -92,7 +94,88 @@ func (suite *FamilyTreeHandlersTestSuite) TestGetFamilyTree() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"get family tree error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "get family tree error")
+	})
+
+	suite.Run("should return not found when the person name does not exist", func() {
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), "Nobody", entity.TreeModeAll).Return(nil, fmt.Errorf("get person error: %w", familytree.ErrPersonNotFound))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s", suite.BaseUrl, "Nobody"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "get person error: person not found")
+	})
+
+	suite.Run("should pass the mode to the service", func() {
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name, entity.TreeModeLegal).Return(suite.FamilyTree, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s?mode=legal", suite.BaseUrl, suite.PersonRoot.Name), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+	})
+
+	suite.Run("should return error when getting family tree with invalid mode", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s?mode=foster", suite.BaseUrl, suite.PersonRoot.Name), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "mode should be biological or legal")
+	})
+
+	suite.Run("should return the family tree as a DOT graph", func() {
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name, entity.TreeModeAll).Return(suite.FamilyTree, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s", suite.BaseUrl, suite.PersonRoot.Name), nil)
+		req.Header.Set("Accept", "text/vnd.graphviz")
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "text/vnd.graphviz; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(suite.T(), w.Body.String(), `"2" [label="Robert\nFather", fillcolor="#a6cee3"];`)
+		assert.Contains(suite.T(), w.Body.String(), `"3" [label="Maria\nMother", fillcolor="#fbb4c4"];`)
+	})
+
+	suite.Run("should return a pedigree chart as SVG", func() {
+		suite.FamilyTree[0].Person.Relationships = []*entity.Relationship{{MainPersonID: "1", SecundePersonID: "2"}}
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), suite.PersonRoot.Name, entity.TreeModeAll).Return(suite.FamilyTree, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s?chart=pedigree", suite.BaseUrl, suite.PersonRoot.Name), nil)
+		req.Header.Set("Accept", "image/svg+xml")
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "image/svg+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(suite.T(), w.Body.String(), ">Robert</text>")
+		assert.NotContains(suite.T(), w.Body.String(), ">Maria</text>")
+		assert.Contains(suite.T(), w.Body.String(), "<path")
+	})
+
+	suite.Run("should return error when getting family tree with invalid chart", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s?chart=fan", suite.BaseUrl, suite.PersonRoot.Name), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "chart should be pedigree or descendants")
 	})
 
 	suite.Run("should return error when getting family tree with invalid person name", func() {
@@ -103,7 +186,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestGetFamilyTree() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"personName should not be empty\"}", w.Body.String())
+		assertProblem(suite.T(), w, "personName should not be empty")
 	})
 }
 
@@ -146,7 +229,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineRelationship() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"determine relationship error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "determine relationship error")
 	})
 
 	suite.Run("should return error when determining relationship with invalid person name", func() {
@@ -157,7 +240,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineRelationship() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"firstPersonName and secondPersonName should not be empty\"}", w.Body.String())
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should not be empty")
 	})
 
 	suite.Run("should return error when determining relationship with names equals", func() {
@@ -168,14 +251,33 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineRelationship() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"firstPersonName and secondPersonName should be different\"}", w.Body.String())
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should be different")
 	})
+
+	suite.Run("should return error when determining relationship with names that differ only in case", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/relationship/%s/%s", suite.BaseUrl, "John", "john"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should be different")
+	})
+}
+
+// Caminho de John até o pai Robert.
+func (suite *FamilyTreeHandlersTestSuite) kinshipPath() *entity.KinshipPath {
+	return &entity.KinshipPath{Steps: []*entity.KinshipStep{
+		{Person: suite.PersonRoot, Relation: entity.KinshipChildOf},
+		{Person: suite.FamilyTree[1].Person},
+	}}
 }
 
 func (suite *FamilyTreeHandlersTestSuite) TestDetermineKinship() {
 	suite.Run("should return success when determining kinship", func() {
-		responseExpected := "{\"distance\":1}"
-		suite.FamilyTreeService.EXPECT().CalculateKinshipDistance(gomock.Any(), suite.PersonRoot.Name, "Robert").Return(1, nil)
+		responseExpected := "{\"distance\":1,\"related\":true,\"explanation\":\"John → (child of) Robert\",\"path\":[{\"id\":\"1\",\"name\":\"John\",\"relation\":\"child_of\"},{\"id\":\"2\",\"name\":\"Robert\"}]}"
+		suite.FamilyTreeService.EXPECT().CalculateKinshipDistance(gomock.Any(), suite.PersonRoot.Name, "Robert").Return(suite.kinshipPath(), nil)
 
 		req, err := http.NewRequest("GET", fmt.Sprintf("%s/kinship/distance/%s/%s", suite.BaseUrl, suite.PersonRoot.Name, "Robert"), nil)
 
@@ -187,8 +289,21 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineKinship() {
 		assert.Equal(suite.T(), responseExpected, w.Body.String())
 	})
 
+	suite.Run("should return not related when there is no path between the persons", func() {
+		suite.FamilyTreeService.EXPECT().CalculateKinshipDistance(gomock.Any(), suite.PersonRoot.Name, "Robert").Return(nil, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/kinship/distance/%s/%s", suite.BaseUrl, suite.PersonRoot.Name, "Robert"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"distance\":0,\"related\":false,\"path\":[]}", w.Body.String())
+	})
+
 	suite.Run("should return error when determining kinship", func() {
-		suite.FamilyTreeService.EXPECT().CalculateKinshipDistance(gomock.Any(), suite.PersonRoot.Name, "Robert").Return(0, fmt.Errorf("determine kinship error"))
+		suite.FamilyTreeService.EXPECT().CalculateKinshipDistance(gomock.Any(), suite.PersonRoot.Name, "Robert").Return(nil, fmt.Errorf("determine kinship error"))
 
 		req, err := http.NewRequest("GET", fmt.Sprintf("%s/kinship/distance/%s/%s", suite.BaseUrl, suite.PersonRoot.Name, "Robert"), nil)
 
@@ -197,7 +312,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineKinship() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"determine kinship error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "determine kinship error")
 	})
 
 	suite.Run("should return error when determining kinship with invalid person name", func() {
@@ -208,7 +323,7 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineKinship() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"firstPersonName and secondPersonName should not be empty\"}", w.Body.String())
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should not be empty")
 	})
 
 	suite.Run("should return error when determining kinship with names equals", func() {
@@ -219,7 +334,490 @@ func (suite *FamilyTreeHandlersTestSuite) TestDetermineKinship() {
 		suite.Router.ServeHTTP(w, req)
 
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"firstPersonName and secondPersonName should be different\"}", w.Body.String())
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should be different")
 	})
 
 }
+
+func (suite *FamilyTreeHandlersTestSuite) TestHealthReport() {
+	suite.Run("should return the health report", func() {
+		report := &entity.HealthReport{Persons: 1, Components: 1, Issues: []entity.HealthIssue{
+			{Code: entity.HealthMissingGender, Severity: entity.SeverityWarning, Message: "John has no gender",
+				Entity: entity.EntityRef{Type: entity.EntityPerson, ID: "1"}},
+		}}
+		suite.FamilyTreeService.EXPECT().HealthReport(gomock.Any()).Return(report, nil)
+
+		req, err := http.NewRequest("GET", suite.BaseUrl+"/health-report", nil)
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `{"persons":1,"relationships":0,"components":1,"summary":{"errors":0,"warnings":1,"infos":0},"issues":[{"code":"missing_gender","severity":"warning","message":"John has no gender","entity":{"type":"person","id":"1"}}]}`, w.Body.String())
+	})
+
+	suite.Run("should return error when building the health report", func() {
+		suite.FamilyTreeService.EXPECT().HealthReport(gomock.Any()).Return(nil, errors.New("health report error"))
+
+		req, err := http.NewRequest("GET", suite.BaseUrl+"/health-report", nil)
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+		assertProblem(suite.T(), w, "health report error")
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestAmbiguousName() {
+	suite.Run("should return conflict with the candidates when the name is ambiguous", func() {
+		ambiguousErr := &familytree.AmbiguousNameError{
+			Name:       "John",
+			Candidates: []*entity.Person{suite.PersonRoot, {ID: "4", Name: "john", Gender: "M"}},
+		}
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembers(gomock.Any(), "John", entity.TreeModeAll).Return(nil, ambiguousErr)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s", suite.BaseUrl, "John"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+		assert.Equal(suite.T(), `{"type":"/problems/ambiguous-name","title":"Name matches more than one person","status":409,"detail":"name \"John\" matches 2 persons","instance":"/api/v1/familytree/members/John","name":"John","candidates":[{"id":"1","name":"John","gender":"M"},{"id":"4","name":"john","gender":"M"}]}`, w.Body.String())
+	})
+
+	suite.Run("should return conflict when determining relationship with an ambiguous name", func() {
+		suite.FamilyTreeService.EXPECT().DetermineRelationship(gomock.Any(), "John", "Robert").Return("", &familytree.AmbiguousNameError{Name: "John"})
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/relationship/%s/%s", suite.BaseUrl, "John", "Robert"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestGetFamilyTreeByID() {
+	suite.Run("should return success when getting family tree by ID", func() {
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembersByID(gomock.Any(), "1", entity.TreeModeBiological).Return(suite.FamilyTree, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/members?mode=biological", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"typeRelationship\":\"Father\"")
+	})
+
+	suite.Run("should return not found when the person does not exist", func() {
+		suite.FamilyTreeService.EXPECT().GetAllFamilyMembersByID(gomock.Any(), "9", entity.TreeModeAll).Return(nil, fmt.Errorf("%w: 9", familytree.ErrPersonNotFound))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/members", suite.BaseUrl, "9"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "person not found: 9")
+	})
+
+	suite.Run("should return error when getting family tree by ID with invalid mode", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/members?mode=other", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestDetermineRelationshipByID() {
+	suite.Run("should return success when determining relationship by ID", func() {
+		suite.FamilyTreeService.EXPECT().DetermineRelationshipByID(gomock.Any(), "1", "2").Return("Father", nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/relationship/%s", suite.BaseUrl, "1", "2"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"relationship\":\"Father\"}", w.Body.String())
+	})
+
+	suite.Run("should return error when determining relationship with equal IDs", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/relationship/%s", suite.BaseUrl, "1", "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "id and otherId should be different")
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestDetermineKinshipByID() {
+	suite.Run("should return success when determining kinship by ID", func() {
+		suite.FamilyTreeService.EXPECT().CalculateKinshipDistanceByID(gomock.Any(), "1", "2").Return(suite.kinshipPath(), nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/kinship/distance/%s", suite.BaseUrl, "1", "2"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"explanation\":\"John → (child of) Robert\"")
+	})
+
+	suite.Run("should return error when determining kinship by ID", func() {
+		suite.FamilyTreeService.EXPECT().CalculateKinshipDistanceByID(gomock.Any(), "1", "2").Return(nil, errors.New("error"))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/kinship/distance/%s", suite.BaseUrl, "1", "2"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestCommonAncestors() {
+	suite.Run("should return the common ancestors", func() {
+		ancestors := []*entity.CommonAncestor{{Person: suite.FamilyTree[1].Person, FirstGeneration: 1, SecondGeneration: 1, MostRecent: true}}
+		suite.FamilyTreeService.EXPECT().CommonAncestors(gomock.Any(), "John", "Leon").Return(ancestors, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/common-ancestors/%s/%s", suite.BaseUrl, "John", "Leon"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"related\":true,\"ancestors\":[{\"id\":\"2\",\"name\":\"Robert\",\"firstGeneration\":1,\"secondGeneration\":1,\"mostRecent\":true}]}", w.Body.String())
+	})
+
+	suite.Run("should return unrelated when there is no common ancestor", func() {
+		suite.FamilyTreeService.EXPECT().CommonAncestors(gomock.Any(), "John", "Leon").Return(nil, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/common-ancestors/%s/%s", suite.BaseUrl, "John", "Leon"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"related\":false,\"ancestors\":[]}", w.Body.String())
+	})
+
+	suite.Run("should return not found when a person does not exist", func() {
+		suite.FamilyTreeService.EXPECT().CommonAncestors(gomock.Any(), "John", "Leon").Return(nil, fmt.Errorf("%w: Leon", familytree.ErrPersonNotFound))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/common-ancestors/%s/%s", suite.BaseUrl, "John", "Leon"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+
+	suite.Run("should return error when a person name is empty", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/common-ancestors/%s/%s", suite.BaseUrl, "John", " "), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("should return the common ancestors by ID", func() {
+		suite.FamilyTreeService.EXPECT().CommonAncestorsByID(gomock.Any(), "1", "4").Return(nil, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/common-ancestors/%s", suite.BaseUrl, "1", "4"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"related\":false,\"ancestors\":[]}", w.Body.String())
+	})
+
+	suite.Run("should return error when the names are equal", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/common-ancestors/John/John", suite.BaseUrl), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should be different")
+	})
+
+	suite.Run("should return error when the IDs are equal", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/1/common-ancestors/1", suite.BaseUrl), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "id and otherId should be different")
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestCoefficients() {
+	suite.Run("should return the coefficients", func() {
+		coefficients := &entity.Coefficients{Relationship: 0.5, Kinship: 0.25}
+		suite.FamilyTreeService.EXPECT().Coefficients(gomock.Any(), "John", "Leon").Return(coefficients, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/coefficients/%s/%s", suite.BaseUrl, "John", "Leon"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"relationship\":0.5,\"kinship\":0.25,\"firstInbreeding\":0,\"secondInbreeding\":0}", w.Body.String())
+	})
+
+	suite.Run("should return conflict when a name is ambiguous", func() {
+		suite.FamilyTreeService.EXPECT().Coefficients(gomock.Any(), "John", "Leon").Return(nil, &familytree.AmbiguousNameError{Name: "John"})
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/coefficients/%s/%s", suite.BaseUrl, "John", "Leon"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	})
+
+	suite.Run("should return the coefficients by ID", func() {
+		suite.FamilyTreeService.EXPECT().CoefficientsByID(gomock.Any(), "1", "4").Return(&entity.Coefficients{Relationship: 0.125, Kinship: 0.0625}, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/coefficients/%s", suite.BaseUrl, "1", "4"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"relationship\":0.125")
+	})
+
+	suite.Run("should return not found when the ID does not exist", func() {
+		suite.FamilyTreeService.EXPECT().CoefficientsByID(gomock.Any(), "1", "9").Return(nil, familytree.ErrPersonNotFound)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/coefficients/%s", suite.BaseUrl, "1", "9"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+
+	suite.Run("should return error when the names are equal", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/coefficients/John/John", suite.BaseUrl), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "firstPersonName and secondPersonName should be different")
+	})
+
+	suite.Run("should return error when the IDs are equal", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/1/coefficients/1", suite.BaseUrl), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "id and otherId should be different")
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestAncestors() {
+	suite.Run("should return the ancestors grouped by generation", func() {
+		generations := []*entity.Generation{
+			{Level: 0, Persons: []*entity.Person{suite.PersonRoot}},
+			{Level: 1, Persons: []*entity.Person{suite.FamilyTree[1].Person}},
+		}
+		suite.FamilyTreeService.EXPECT().Ancestors(gomock.Any(), "1", 2, entity.TreeModeAll).Return(generations, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ancestors?depth=2", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"depth\":2,\"generations\":[{\"level\":0,\"persons\":[{\"id\":\"1\",\"name\":\"John\",\"gender\":\"M\"}]},{\"level\":1,\"persons\":[{\"id\":\"2\",\"name\":\"Robert\",\"gender\":\"M\"}]}]}", w.Body.String())
+	})
+
+	suite.Run("should use the maximum depth when depth is not informed", func() {
+		suite.FamilyTreeService.EXPECT().Ancestors(gomock.Any(), "1", familytree.MaxDepth, entity.TreeModeLegal).Return(nil, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ancestors?mode=legal", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+	})
+
+	suite.Run("should return error when depth is above the maximum", func() {
+		suite.FamilyTreeService.EXPECT().Ancestors(gomock.Any(), "1", 50, entity.TreeModeAll).Return(nil, familytree.ErrInvalidDepth)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ancestors?depth=50", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "depth should be between 1 and 10")
+	})
+
+	suite.Run("should return error when depth is not a number", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ancestors?depth=all", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestDescendants() {
+	suite.Run("should return the descendants grouped by generation", func() {
+		generations := []*entity.Generation{{Level: 0, Persons: []*entity.Person{suite.FamilyTree[1].Person}}}
+		suite.FamilyTreeService.EXPECT().Descendants(gomock.Any(), "2", 1, entity.TreeModeBiological).Return(generations, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/descendants?depth=1&mode=biological", suite.BaseUrl, "2"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"depth\":1")
+	})
+
+	suite.Run("should return not found when the person does not exist", func() {
+		suite.FamilyTreeService.EXPECT().Descendants(gomock.Any(), "9", familytree.MaxDepth, entity.TreeModeAll).Return(nil, familytree.ErrPersonNotFound)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/descendants", suite.BaseUrl, "9"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+
+	suite.Run("should return error when the mode is invalid", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/descendants?mode=other", suite.BaseUrl, "2"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestAhnentafel() {
+	numbered := []*entity.NumberedPerson{
+		{Number: "1", Person: suite.PersonRoot},
+		{Number: "2", Generation: 1, Person: suite.FamilyTree[1].Person},
+	}
+
+	suite.Run("should return the ancestors numbered by Ahnentafel", func() {
+		suite.FamilyTreeService.EXPECT().Ahnentafel(gomock.Any(), "1", 1, entity.TreeModeAll).Return(numbered, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ahnentafel?depth=1", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"numbering\":\"ahnentafel\",\"depth\":1,\"persons\":[{\"number\":\"1\",\"generation\":0,\"id\":\"1\",\"name\":\"John\",\"gender\":\"M\"},{\"number\":\"2\",\"generation\":1,\"id\":\"2\",\"name\":\"Robert\",\"gender\":\"M\"}]}", w.Body.String())
+	})
+
+	suite.Run("should return the Ahnentafel report as plain text", func() {
+		suite.FamilyTreeService.EXPECT().Ahnentafel(gomock.Any(), "1", familytree.MaxDepth, entity.TreeModeAll).Return(numbered, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ahnentafel", suite.BaseUrl, "1"), nil)
+		req.Header.Set("Accept", "text/plain")
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "Ahnentafel report\n\n1. John\n  2. Robert\n", w.Body.String())
+	})
+
+	suite.Run("should return error when depth is not a number", func() {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/ahnentafel?depth=x", suite.BaseUrl, "1"), nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *FamilyTreeHandlersTestSuite) TestDAboville() {
+	suite.Run("should return the d'Aboville report as plain text", func() {
+		numbered := []*entity.NumberedPerson{
+			{Number: "1", Person: suite.FamilyTree[1].Person},
+			{Number: "1.1", Generation: 1, Person: suite.PersonRoot},
+		}
+		suite.FamilyTreeService.EXPECT().DAboville(gomock.Any(), "2", 2, entity.TreeModeAll).Return(numbered, nil)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/daboville?depth=2", suite.BaseUrl, "2"), nil)
+		req.Header.Set("Accept", "text/plain")
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "d'Aboville report\n\n1. Robert\n  1.1. John\n", w.Body.String())
+	})
+
+	suite.Run("should return the error as JSON when the report is requested as plain text", func() {
+		suite.FamilyTreeService.EXPECT().DAboville(gomock.Any(), "9", familytree.MaxDepth, entity.TreeModeAll).Return(nil, familytree.ErrPersonNotFound)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/persons/%s/daboville", suite.BaseUrl, "9"), nil)
+		req.Header.Set("Accept", "text/plain")
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "person not found")
+	})
+}
@@ -0,0 +1,92 @@
+package gin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// Tamanho da página das listagens quando o limit não é informado, e o maior permitido.
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	errInvalidLimit  = fmt.Errorf("limit should be between 1 and %d", MaxLimit)
+	errInvalidOffset = errors.New("offset should be zero or greater")
+	errInvalidOrder  = errors.New("order should be asc or desc")
+	errInvalidSort   = errors.New("sort should be name")
+	errInvalidGender = errors.New("gender should be F or M")
+)
+
+// Lê os filtros da listagem de pessoas: name, gender, parentId, childId, sort, order, offset e limit.
+func personFilterQuery(c *gin.Context) (*entity.PersonFilter, error) {
+	page, order, err := pageQuery(c)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &entity.PersonFilter{
+		Name:     c.Query("name"),
+		Gender:   c.Query("gender"),
+		ParentID: c.Query("parentId"),
+		ChildID:  c.Query("childId"),
+		Sort:     c.Query("sort"),
+		Order:    order,
+		Page:     page,
+	}
+	if filter.Gender != "" && filter.Gender != "F" && filter.Gender != "M" {
+		return nil, errInvalidGender
+	}
+	if filter.Sort != "" && filter.Sort != entity.SortName {
+		return nil, errInvalidSort
+	}
+	return filter, nil
+}
+
+// Lê os filtros da listagem de relacionamentos do tipo informado: personId, parentId, childId, order, offset e limit.
+func relationshipFilterQuery(c *gin.Context, kind string) (*entity.RelationshipFilter, error) {
+	page, order, err := pageQuery(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.RelationshipFilter{
+		Kind:     kind,
+		PersonID: c.Query("personId"),
+		ParentID: c.Query("parentId"),
+		ChildID:  c.Query("childId"),
+		Order:    order,
+		Page:     page,
+	}, nil
+}
+
+func pageQuery(c *gin.Context) (entity.Page, string, error) {
+	page := entity.Page{Limit: DefaultLimit}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			return page, "", errInvalidLimit
+		}
+		page.Limit = limit
+	}
+
+	if value := c.Query("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return page, "", errInvalidOffset
+		}
+		page.Offset = offset
+	}
+
+	order := c.Query("order")
+	if order != "" && order != entity.OrderAsc && order != entity.OrderDesc {
+		return page, "", errInvalidOrder
+	}
+	return page, order, nil
+}
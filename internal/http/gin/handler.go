@@ -1,14 +1,18 @@
 package gin
 
 import (
-	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"strings"
 
 	"github.com/GeovaneCavalcante/tree-genealogical/config"
 	_ "github.com/GeovaneCavalcante/tree-genealogical/docs"
+	"github.com/GeovaneCavalcante/tree-genealogical/event"
 	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/importer"
 	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/chart"
 	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -16,11 +20,17 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-type errorResponse struct {
-	Error string `json:"error" xml:"error"`
+// Respostas que podem ser desenhadas como gráfico (DOT e SVG).
+type graphResponse interface {
+	Graph() *chart.Graph
 }
 
-func Handlers(envs *config.Environments, personService person.UseCase, relationshipServoce relationship.UseCase, familyTreeService familytree.UseCase) *gin.Engine {
+// Respostas que podem ser impressas como relatório em texto.
+type textResponse interface {
+	Text() string
+}
+
+func Handlers(envs *config.Environments, personService person.UseCase, relationshipServoce relationship.UseCase, familyTreeService familytree.UseCase, importService importer.UseCase, eventService event.UseCase) *gin.Engine {
 	r := gin.Default()
 
 	r.GET("/health", healthHandler)
@@ -32,6 +42,7 @@ func Handlers(envs *config.Environments, personService person.UseCase, relations
 
 	pG := v1.Group("/person")
 	MakePersonHandlers(pG, personService)
+	MakeEventHandlers(pG, eventService)
 
 	rG := v1.Group("/relationship")
 	MakeRelationshipHandlers(rG, relationshipServoce)
@@ -39,6 +50,12 @@ func Handlers(envs *config.Environments, personService person.UseCase, relations
 	fG := v1.Group("/familytree")
 	MakeFamilyTreeHandlers(fG, familyTreeService)
 
+	eG := v1.Group("/export")
+	MakeExportHandlers(eG, familyTreeService)
+
+	iG := v1.Group("/import")
+	MakeImportHandlers(iG, importService)
+
 	return r
 }
 
@@ -46,49 +63,43 @@ func healthHandler(c *gin.Context) {
 	c.String(http.StatusOK, "App is healthy")
 }
 
+// Responde os dados na representação negociada pelo Accept, com pesos e curingas.
+// Sem representação aceita para os dados, responde 406 com os tipos disponíveis.
 func respondAccept(c *gin.Context, status int, data interface{}) {
-	fmt.Println(c.GetHeader("Accept"))
-	switch c.GetHeader("Accept") {
-	case "text/xml", "application/xml":
-		c.XML(status, data)
-		return
-	case "application/json":
-		c.JSON(status, data)
+	c.Header("Vary", "Accept")
+	if status == http.StatusNoContent {
+		c.Status(status)
 		return
-	case "application/x-yaml", "text/yaml", "text/x-yaml", "application/yaml":
-		yamlData, err := yaml.Marshal(data)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
-			return
-		}
-		c.Data(status, "application/x-yaml", yamlData)
+	}
+	encoder, ok := responseNegotiator.Negotiate(c.GetHeader("Accept"), data)
+	if !ok {
+		respondProblemDetail(c, http.StatusNotAcceptable, "acceptable media types: "+strings.Join(responseNegotiator.MediaTypes(data), ", "))
 		return
-	default:
-		c.JSON(status, data)
+	}
+	body, err := encoder.Encode(data)
+	if err != nil {
+		respondProblemDetail(c, http.StatusInternalServerError, err.Error())
 		return
 	}
+	c.Data(status, encoder.ContentType, body)
 }
 
+// Lê o corpo pelo tipo de mídia do Content-Type, ignorando parâmetros como o charset.
+// Sem Content-Type ou com um tipo desconhecido, o corpo é lido como JSON.
 func bindData(c *gin.Context, obj interface{}) error {
-	switch c.GetHeader("Content-Type") {
-	case "application/xml", "text/xml", "application/json":
-		if err := c.ShouldBind(obj); err != nil {
-			return err
-		}
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	switch mediaType {
+	case "application/xml", "text/xml":
+		return c.ShouldBindXML(obj)
 	case "application/x-yaml", "text/yaml", "text/x-yaml", "application/yaml":
 		body, err := io.ReadAll(c.Request.Body)
 		if err != nil {
 			return err
 		}
-		if err := yaml.Unmarshal(body, obj); err != nil {
-			return err
-		}
+		return yaml.Unmarshal(body, obj)
 	default:
-		if err := c.BindJSON(obj); err != nil {
-			return err
-		}
+		return c.ShouldBindJSON(obj)
 	}
-	return nil
 }
 
 func IsEmpty(value string) bool {
@@ -7,8 +7,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	mock_event "github.com/GeovaneCavalcante/tree-genealogical/event/mock"
 	mock_familytree "github.com/GeovaneCavalcante/tree-genealogical/familytree/mock"
+	mock_importer "github.com/GeovaneCavalcante/tree-genealogical/importer/mock"
 	mock_person "github.com/GeovaneCavalcante/tree-genealogical/person/mock"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/chart"
 	mock_relationship "github.com/GeovaneCavalcante/tree-genealogical/relationship/mock"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +25,20 @@ func (b badYAML) MarshalYAML() (interface{}, error) {
 	return nil, errors.New("expected error")
 }
 
+type graphData struct {
+	graph *chart.Graph
+}
+
+func (g graphData) Graph() *chart.Graph {
+	return g.graph
+}
+
+type textData struct{}
+
+func (textData) Text() string {
+	return "1. John\n"
+}
+
 type TestStruct struct {
 	Name string `json:"name" xml:"name" yaml:"name"`
 }
@@ -37,6 +54,8 @@ type HandlersTestSuite struct {
 	FamilyTreeService   *mock_familytree.MockUseCase
 	PersonService       *mock_person.MockUseCase
 	RelationshipService *mock_relationship.MockUseCase
+	ImportService       *mock_importer.MockUseCase
+	EventService        *mock_event.MockUseCase
 }
 
 func (suite *HandlersTestSuite) SetupTest() {
@@ -44,11 +63,13 @@ func (suite *HandlersTestSuite) SetupTest() {
 	suite.FamilyTreeService = mock_familytree.NewMockUseCase(ctrl)
 	suite.PersonService = mock_person.NewMockUseCase(ctrl)
 	suite.RelationshipService = mock_relationship.NewMockUseCase(ctrl)
+	suite.ImportService = mock_importer.NewMockUseCase(ctrl)
+	suite.EventService = mock_event.NewMockUseCase(ctrl)
 }
 
 func (suite *HandlersTestSuite) TestHandlers() {
 	suite.T().Run("Should return a gin.Engine", func(t *testing.T) {
-		r := Handlers(nil, suite.PersonService, suite.RelationshipService, suite.FamilyTreeService)
+		r := Handlers(nil, suite.PersonService, suite.RelationshipService, suite.FamilyTreeService, suite.ImportService, suite.EventService)
 		assert.NotNil(t, r)
 		assert.IsType(t, &gin.Engine{}, r)
 	})
@@ -80,6 +101,11 @@ func (suite *HandlersTestSuite) TestRespondAccept() {
 			{"application/x-yaml", http.StatusOK, "message: ok\n"},
 			{"text/yaml", http.StatusOK, "message: ok\n"},
 			{"", http.StatusOK, `{"message":"ok"}`},
+			{"application/json, text/plain;q=0.5", http.StatusOK, `{"message":"ok"}`},
+			{"application/xml;q=0.9,*/*;q=0.1", http.StatusOK, `<map><message>ok</message></map>`},
+			{"text/*", http.StatusOK, `<map><message>ok</message></map>`},
+			{"*/*", http.StatusOK, `{"message":"ok"}`},
+			{"text/plain, application/yaml;q=0.5", http.StatusOK, "message: ok\n"},
 		}
 
 		for _, tt := range tests {
@@ -116,6 +142,81 @@ func (suite *HandlersTestSuite) TestRespondAccept() {
 
 		assert.Contains(t, w.Body.String(), "Internal Server Error", "Response body should contain the expected error message")
 	})
+
+	suite.T().Run("Should return 406 when no representation is acceptable", func(t *testing.T) {
+		for _, accept := range []string{"text/vnd.graphviz", "image/svg+xml", "text/plain", "image/png", "application/json;q=0"} {
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Accept", accept)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			respondAccept(c, http.StatusOK, gin.H{"message": "ok"})
+
+			assert.Equal(t, http.StatusNotAcceptable, w.Code, accept)
+			assertProblem(t, w, "acceptable media types: application/json, application/xml, text/xml, application/x-yaml, application/yaml, text/yaml, text/x-yaml")
+		}
+	})
+
+	suite.T().Run("Should vary on Accept and send the charset", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Accept", "application/xml")
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+
+		respondAccept(c, http.StatusOK, gin.H{"message": "ok"})
+
+		assert.Equal(t, "Accept", w.Header().Get("Vary"))
+		assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+	})
+}
+
+func (suite *HandlersTestSuite) TestRespondGraph() {
+	graph := graphData{graph: &chart.Graph{Root: "1", Nodes: []chart.Node{{ID: "1", Name: "John", Relation: "Root", Gender: "M"}}}}
+
+	tests := []struct {
+		acceptHeader    string
+		expectedType    string
+		expectedContent string
+	}{
+		{"text/vnd.graphviz", "text/vnd.graphviz; charset=utf-8", `"1" [label="John\nRoot", fillcolor="#a6cee3", penwidth=2];`},
+		{"image/svg+xml", "image/svg+xml; charset=utf-8", `<text x="96" y="36" text-anchor="middle" font-size="13">John</text>`},
+		{"image/*, application/json;q=0.5", "image/svg+xml; charset=utf-8", `<text x="96" y="36" text-anchor="middle" font-size="13">John</text>`},
+		{"text/vnd.graphviz;q=0.2, application/json;q=0.8", "application/json; charset=utf-8", `{}`},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.acceptHeader, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Accept", tt.acceptHeader)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			respondAccept(c, http.StatusOK, graph)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Body.String(), tt.expectedContent)
+		})
+	}
+}
+
+func (suite *HandlersTestSuite) TestRespondText() {
+	suite.T().Run("Should return the text report", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Accept", "text/plain")
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+
+		respondAccept(c, http.StatusOK, textData{})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "1. John\n", w.Body.String())
+	})
 }
 
 func (suite *HandlersTestSuite) TestBindData() {
@@ -146,6 +247,30 @@ func (suite *HandlersTestSuite) TestBindData() {
 				expected:    TestStruct{Name: "John"},
 				expectError: false,
 			},
+			{
+				contentType: "application/json; charset=utf-8",
+				requestBody: `{"name":"John"}`,
+				expected:    TestStruct{Name: "John"},
+				expectError: false,
+			},
+			{
+				contentType: "Text/XML; charset=UTF-8",
+				requestBody: `<TestStruct><name>John</name></TestStruct>`,
+				expected:    TestStruct{Name: "John"},
+				expectError: false,
+			},
+			{
+				contentType: "application/yaml; charset=utf-8",
+				requestBody: `name: John`,
+				expected:    TestStruct{Name: "John"},
+				expectError: false,
+			},
+			{
+				contentType: "",
+				requestBody: `{"name":"John"}`,
+				expected:    TestStruct{Name: "John"},
+				expectError: false,
+			},
 		}
 
 		for _, tt := range tests {
@@ -235,4 +360,9 @@ func TestSuite(t *testing.T) {
 	suite.Run(t, new(FamilyTreeHandlersTestSuite))
 	suite.Run(t, new(PersonHandlersTestSuite))
 	suite.Run(t, new(RelationshipHandlersTestSuite))
+	suite.Run(t, new(UnionHandlersTestSuite))
+	suite.Run(t, new(ImportHandlersTestSuite))
+	suite.Run(t, new(ExportHandlersTestSuite))
+	suite.Run(t, new(EventHandlersTestSuite))
+	suite.Run(t, new(ProblemHandlersTestSuite))
 }
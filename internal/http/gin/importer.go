@@ -0,0 +1,88 @@
+package gin
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/importer"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Tamanho máximo do arquivo GEDCOM aceito na importação.
+const maxGedcomSize = 10 << 20
+
+// @Summary Import a GEDCOM file
+// @Description Import persons and relationships from a GEDCOM 5.5.1 file, sent as the request body or as the multipart field "file". Each individual becomes a new person, unless merge=true links it to the registered person with the same name.
+// @Tags import
+// @Accept plain,mpfd
+// @Produce json,xml
+// @Param file formData file false "GEDCOM file"
+// @Param merge query bool false "Link individuals to the registered persons with the same name"
+// @Success 200 {object} presenter.ImportSummaryResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /import/gedcom [post]
+func importGedcomHandler(s importer.UseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("[Handler] Import gedcom started")
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxGedcomSize)
+
+		merge, err := strconv.ParseBool(c.DefaultQuery("merge", "false"))
+		if err != nil {
+			logger.Error("[Handler] Import gedcom error: invalid merge", err)
+			respondProblemDetail(c, http.StatusBadRequest, "merge should be true or false")
+			return
+		}
+
+		data, err := readGedcom(c)
+		if err != nil {
+			logger.Error("[Handler] Import gedcom error: ", err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		summary, err := s.ImportGedcom(c, bytes.NewReader(data), &entity.ImportOptions{MergeByName: merge})
+		if err != nil {
+			logger.Error("[Handler] Import gedcom error: ", err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info("[Handler] Import gedcom finished")
+
+		respondAccept(c, http.StatusOK, presenter.NewImportSummaryResponse(summary))
+	}
+}
+
+// Lê o arquivo do campo "file" quando a requisição é multipart, caso contrário lê o corpo da requisição.
+func readGedcom(c *gin.Context) ([]byte, error) {
+	var r io.Reader = c.Request.Body
+	if mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type")); mediaType == "multipart/form-data" {
+		file, _, err := c.Request.FormFile("file")
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errors.New("gedcom file is empty")
+	}
+	return data, nil
+}
+
+func MakeImportHandlers(r *gin.RouterGroup, s importer.UseCase) {
+	r.POST("/gedcom", importGedcomHandler(s))
+}
@@ -0,0 +1,133 @@
+package gin
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	mock_importer "github.com/GeovaneCavalcante/tree-genealogical/importer/mock"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const gedcomInput = "0 @I1@ INDI\n1 NAME John /Smith/\n1 SEX M\n"
+
+type ImportHandlersTestSuite struct {
+	suite.Suite
+	ImportService *mock_importer.MockUseCase
+	Router        *gin.Engine
+	BaseUrl       string
+	Summary       *entity.ImportSummary
+}
+
+func (suite *ImportHandlersTestSuite) SetupTest() {
+	ctrl := gomock.NewController(suite.T())
+	suite.ImportService = mock_importer.NewMockUseCase(ctrl)
+	suite.Router = gin.Default()
+	suite.BaseUrl = "/api/v1/import/gedcom"
+
+	MakeImportHandlers(suite.Router.Group("/api/v1/import"), suite.ImportService)
+
+	suite.Summary = &entity.ImportSummary{
+		Persons: entity.ImportCounts{Created: 1},
+		Issues:  []entity.ImportIssue{{Line: 3, Record: "@F1@", Message: "unknown individual @I9@"}},
+	}
+}
+
+func (suite *ImportHandlersTestSuite) TestImportGedcom() {
+	expectedResponse := `{"persons":{"created":1,"skipped":0,"invalid":0},"relationships":{"created":0,"skipped":0,"invalid":0},"errors":[{"line":3,"record":"@F1@","message":"unknown individual @I9@"}]}`
+
+	suite.Run("should import the request body", func() {
+		suite.ImportService.EXPECT().ImportGedcom(gomock.Any(), gomock.Any(), &entity.ImportOptions{}).DoAndReturn(func(_ interface{}, r io.Reader, _ *entity.ImportOptions) (*entity.ImportSummary, error) {
+			data, _ := io.ReadAll(r)
+			assert.Equal(suite.T(), gedcomInput, string(data))
+			return suite.Summary, nil
+		})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, strings.NewReader(gedcomInput))
+		req.Header.Set("Content-Type", "text/plain")
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), expectedResponse, w.Body.String())
+	})
+
+	suite.Run("should import a multipart file", func() {
+		suite.ImportService.EXPECT().ImportGedcom(gomock.Any(), gomock.Any(), &entity.ImportOptions{}).DoAndReturn(func(_ interface{}, r io.Reader, _ *entity.ImportOptions) (*entity.ImportSummary, error) {
+			data, _ := io.ReadAll(r)
+			assert.Equal(suite.T(), gedcomInput, string(data))
+			return suite.Summary, nil
+		})
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, _ := writer.CreateFormFile("file", "family.ged")
+		part.Write([]byte(gedcomInput))
+		writer.Close()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), expectedResponse, w.Body.String())
+	})
+
+	suite.Run("should merge persons by name when requested", func() {
+		suite.ImportService.EXPECT().ImportGedcom(gomock.Any(), gomock.Any(), &entity.ImportOptions{MergeByName: true}).Return(suite.Summary, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl+"?merge=true", strings.NewReader(gedcomInput))
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+	})
+
+	suite.Run("should return error when merge is invalid", func() {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl+"?merge=maybe", strings.NewReader(gedcomInput))
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "merge should be true or false")
+	})
+
+	suite.Run("should return error when the file is empty", func() {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, strings.NewReader("  \n"))
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "gedcom file is empty")
+	})
+
+	suite.Run("should return error when the multipart has no file", func() {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		writer.WriteField("name", "family")
+		writer.Close()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("should return error when the import fails", func() {
+		suite.ImportService.EXPECT().ImportGedcom(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, strings.NewReader(gedcomInput))
+		suite.Router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+		assertProblem(suite.T(), w, "error")
+	})
+}
@@ -0,0 +1,77 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/chart"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/negotiate"
+	"gopkg.in/yaml.v2"
+)
+
+// Representações das respostas, na ordem de preferência quando o Accept empata ou está vazio.
+var responseNegotiator = negotiate.NewNegotiator(
+	&negotiate.Encoder{
+		MediaTypes:  []string{"application/json"},
+		ContentType: "application/json; charset=utf-8",
+		Encode:      json.Marshal,
+	},
+	&negotiate.Encoder{
+		MediaTypes:  []string{"application/xml", "text/xml"},
+		ContentType: "application/xml; charset=utf-8",
+		Encode:      xml.Marshal,
+	},
+	&negotiate.Encoder{
+		MediaTypes:  []string{"application/x-yaml", "application/yaml", "text/yaml", "text/x-yaml"},
+		ContentType: "application/x-yaml",
+		Encode:      yaml.Marshal,
+	},
+	graphEncoder("text/vnd.graphviz", chart.DOT),
+	graphEncoder("image/svg+xml", chart.SVG),
+	&negotiate.Encoder{
+		MediaTypes:  []string{"text/plain"},
+		ContentType: "text/plain; charset=utf-8",
+		Supports: func(data interface{}) bool {
+			_, ok := data.(textResponse)
+			return ok
+		},
+		Encode: func(data interface{}) ([]byte, error) {
+			return []byte(data.(textResponse).Text()), nil
+		},
+	},
+)
+
+// Representações dos erros da RFC 7807. Sem representação aceita, o erro é enviado em JSON.
+var problemNegotiator = negotiate.NewNegotiator(
+	&negotiate.Encoder{
+		MediaTypes:  []string{problemJSON, "application/json"},
+		ContentType: problemJSON,
+		Encode:      json.Marshal,
+	},
+	&negotiate.Encoder{
+		MediaTypes:  []string{problemXML, "application/xml", "text/xml"},
+		ContentType: problemXML + "; charset=utf-8",
+		Encode:      xml.Marshal,
+	},
+)
+
+// Gráfico da resposta em DOT ou SVG, para as respostas que têm gráfico.
+func graphEncoder(mediaType string, render func(io.Writer, *chart.Graph) error) *negotiate.Encoder {
+	return &negotiate.Encoder{
+		MediaTypes:  []string{mediaType},
+		ContentType: mediaType + "; charset=utf-8",
+		Supports: func(data interface{}) bool {
+			g, ok := data.(graphResponse)
+			return ok && g.Graph() != nil
+		},
+		Encode: func(data interface{}) ([]byte, error) {
+			var buf bytes.Buffer
+			if err := render(&buf, data.(graphResponse).Graph()); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		},
+	}
+}
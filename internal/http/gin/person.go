@@ -1,10 +1,11 @@
 package gin
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
-	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
 	"github.com/GeovaneCavalcante/tree-genealogical/person"
 	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
@@ -18,8 +19,8 @@ import (
 // @Produce json,xml
 // @Param person body presenter.PersonRequest true "Person"
 // @Success 201 {object} presenter.PersonResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /person [post]
 func createPersonHandler(s person.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -27,7 +28,7 @@ func createPersonHandler(s person.UseCase) gin.HandlerFunc {
 		var p presenter.PersonRequest
 		if err := bindData(c, &p); err != nil {
 			logger.Error("[Handler] Create person error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -35,7 +36,7 @@ func createPersonHandler(s person.UseCase) gin.HandlerFunc {
 
 		if err := p.Validate(); err != nil {
 			logger.Error("[Handler] Create person error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondInvalidRequest(c, err)
 			return
 		}
 
@@ -43,7 +44,7 @@ func createPersonHandler(s person.UseCase) gin.HandlerFunc {
 
 		if err := s.Create(c, pp); err != nil {
 			logger.Error("[Handler] Create person error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -55,34 +56,41 @@ func createPersonHandler(s person.UseCase) gin.HandlerFunc {
 }
 
 // @Summary List persons
-// @Description List persons
+// @Description List persons page by page, filtered by name, gender, parent or child
 // @Tags person
 // @Accept json,xml
 // @Produce json,xml
-// @Param name query string false "Filter by person's lasted name (no implemeted)"
-// @Success 200 {array} presenter.PersonResponse
-// @Failure 500 {object} errorResponse
+// @Param name query string false "Filter by part of the person's name, ignoring case"
+// @Param gender query string false "Filter by gender" Enums(F, M)
+// @Param parentId query string false "List the children of this parent"
+// @Param childId query string false "List the parents of this child"
+// @Param sort query string false "Sort field, registration order when omitted" Enums(name)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param offset query int false "Number of persons to skip"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} presenter.PersonsPageResponse
+// @Failure 400 {object} presenter.ProblemResponse "Invalid filter"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /person [get]
 func listPersonHandler(s person.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("[Handler] List person started")
 
-		filters := map[string]interface{}{}
-
-		persons, err := s.List(c, filters)
+		filter, err := personFilterQuery(c)
 		if err != nil {
-			logger.Error("[Handler] List person error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			logger.Error("[Handler] List person error: invalid filter", err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		if len(persons) == 0 {
-			logger.Info("[Handler] List person not found")
-			respondAccept(c, http.StatusOK, []entity.Person{})
+		persons, total, err := s.List(c, filter)
+		if err != nil {
+			logger.Error("[Handler] List person error: ", err)
+			respondError(c, err)
 			return
 		}
 
-		pp := presenter.NewPersonsResponse(persons)
+		pp := presenter.NewPersonsPageResponse(persons, filter.Page, total)
 
 		logger.Info("[Handler] List person finished")
 		respondAccept(c, http.StatusOK, pp)
@@ -96,8 +104,8 @@ func listPersonHandler(s person.UseCase) gin.HandlerFunc {
 // @Produce json,xml
 // @Param id path string true "Person ID"
 // @Success 200 {object} presenter.PersonResponse
-// @Failure 404 {object} errorResponse "Person not found"
-// @Failure 500 {object} errorResponse
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /person/{id} [get]
 func getPersonHandler(s person.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -107,20 +115,20 @@ func getPersonHandler(s person.UseCase) gin.HandlerFunc {
 
 		if IsEmpty(personID) {
 			logger.Info("[Handler] Get person not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "person not found"})
+			respondNotFound(c, "person not found")
 			return
 		}
 
 		p, err := s.Get(c, personID)
 		if err != nil {
 			logger.Error("[Handler] Get person error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
 		if p == nil {
 			logger.Info("[Handler] Get person not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "person not found"})
+			respondNotFound(c, "person not found")
 			return
 		}
 
@@ -139,9 +147,9 @@ func getPersonHandler(s person.UseCase) gin.HandlerFunc {
 // @Param id path string true "Person ID"
 // @Param person body presenter.PersonRequest true "Person"
 // @Success 200 {object} presenter.PersonResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 404 {object} errorResponse "Person not found"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /person/{id} [put]
 func updatePersonHandler(s person.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -150,20 +158,20 @@ func updatePersonHandler(s person.UseCase) gin.HandlerFunc {
 
 		if IsEmpty(personID) {
 			logger.Info("[Handler] Update person not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "person not found"})
+			respondNotFound(c, "person not found")
 			return
 		}
 
 		var p presenter.PersonRequest
 		if err := bindData(c, &p); err != nil {
 			logger.Error("[Handler] Update person error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		if err := p.Validate(); err != nil {
 			logger.Error("[Handler] Update person error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondInvalidRequest(c, err)
 			return
 		}
 
@@ -171,7 +179,7 @@ func updatePersonHandler(s person.UseCase) gin.HandlerFunc {
 
 		if err := s.Update(c, personID, pp); err != nil {
 			logger.Error("[Handler] Update person error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -183,14 +191,17 @@ func updatePersonHandler(s person.UseCase) gin.HandlerFunc {
 }
 
 // @Summary Delete a person
-// @Description Delete a person
+// @Description Delete a person. A person with relationships is only deleted with cascade=true, which also deletes the relationships
 // @Tags person
 // @Accept json,xml
 // @Produce json,xml
 // @Param id path string true "Person ID"
+// @Param cascade query bool false "Also delete the person's relationships"
 // @Success 204
-// @Failure 404 {object} errorResponse "Person not found"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Invalid cascade"
+// @Failure 404 {object} presenter.ProblemResponse "Person not found"
+// @Failure 409 {object} presenter.ProblemResponse "Person has relationships"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /person/{id} [delete]
 func deletePersonHandler(s person.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -198,15 +209,26 @@ func deletePersonHandler(s person.UseCase) gin.HandlerFunc {
 
 		if IsEmpty(personID) {
 			logger.Info("[Handler] Delete person not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "person not found"})
+			respondNotFound(c, "person not found")
 			return
 		}
 
 		logger.Info("[Handler] Delete person started")
 
-		if err := s.Delete(c, personID); err != nil {
+		cascade, err := strconv.ParseBool(c.DefaultQuery("cascade", "false"))
+		if err != nil {
+			logger.Error("[Handler] Delete person error: invalid cascade", err)
+			respondProblemDetail(c, http.StatusBadRequest, "cascade should be true or false")
+			return
+		}
+
+		if err := s.Delete(c, personID, cascade); err != nil {
 			logger.Error("[Handler] Delete person error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			if errors.Is(err, person.ErrHasRelationships) {
+				respondProblem(c, presenter.NewProblemResponse(http.StatusConflict, presenter.ProblemTypeConflict, "person has relationships, delete with cascade=true to remove them"))
+				return
+			}
+			respondError(c, err)
 			return
 		}
 
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
 	mock_person "github.com/GeovaneCavalcante/tree-genealogical/person/mock"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -74,7 +76,7 @@ func (suite *PersonHandlersTestSuite) TestCreate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"error creating person\"}", w.Body.String())
+		assertProblem(suite.T(), w, "error creating person")
 	})
 
 	suite.Run("should return error when creating a person with invalid data", func() {
@@ -86,7 +88,22 @@ func (suite *PersonHandlersTestSuite) TestCreate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"invalid character 'i' looking for beginning of value\"}", w.Body.String())
+		assertProblem(suite.T(), w, "invalid character 'i' looking for beginning of value")
+	})
+
+	suite.Run("should send the problem headers to the client when the body has no Content-Type", func() {
+		server := httptest.NewServer(suite.Router)
+		defer server.Close()
+
+		resp, err := http.Post(server.URL+suite.BaseUrl, "", bytes.NewBufferString("invalid data"))
+		suite.Require().NoError(err)
+		defer resp.Body.Close()
+
+		var problem presenter.ProblemResponse
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&problem))
+		assert.Equal(suite.T(), http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(suite.T(), "application/problem+json", resp.Header.Get("Content-Type"))
+		assert.Equal(suite.T(), http.StatusBadRequest, problem.Status)
 	})
 
 	suite.Run("should return error when creating a person with invalid person", func() {
@@ -101,15 +118,16 @@ func (suite *PersonHandlersTestSuite) TestCreate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"Key: 'PersonRequest.Name' Error:Field validation for 'Name' failed on the 'required' tag\"}", w.Body.String())
+		problem := assertProblem(suite.T(), w, "the request has invalid fields")
+		assert.Equal(suite.T(), []*presenter.ViolationResponse{{Field: "name", Code: "required", Message: "is required"}}, problem.Violations)
 	})
 
 }
 
 func (suite *PersonHandlersTestSuite) TestList() {
 	suite.Run("should return success when listing persons", func() {
-		expectedResponse := "[{\"id\":\"\",\"name\":\"John\",\"gender\":\"M\"}]"
-		suite.PersonService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Person{suite.Person}, nil)
+		expectedResponse := "{\"pagination\":{\"total\":1,\"offset\":0,\"limit\":20},\"persons\":[{\"id\":\"\",\"name\":\"John\",\"gender\":\"M\"}]}"
+		suite.PersonService.EXPECT().List(gomock.Any(), &entity.PersonFilter{Page: entity.Page{Limit: DefaultLimit}}).Return([]*entity.Person{suite.Person}, 1, nil)
 
 		req, err := http.NewRequest("GET", suite.BaseUrl, nil)
 
@@ -120,8 +138,48 @@ func (suite *PersonHandlersTestSuite) TestList() {
 		assert.Equal(suite.T(), expectedResponse, w.Body.String())
 	})
 
+	suite.Run("should pass the query filters to the service", func() {
+		filter := &entity.PersonFilter{
+			Name:     "jo",
+			Gender:   "M",
+			ParentID: "2",
+			ChildID:  "4",
+			Sort:     entity.SortName,
+			Order:    entity.OrderDesc,
+			Page:     entity.Page{Offset: 10, Limit: 5},
+		}
+		suite.PersonService.EXPECT().List(gomock.Any(), filter).Return([]*entity.Person{suite.Person}, 11, nil)
+
+		req, err := http.NewRequest("GET", suite.BaseUrl+"?name=jo&gender=M&parentId=2&childId=4&sort=name&order=desc&offset=10&limit=5", nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"pagination\":{\"total\":11,\"offset\":10,\"limit\":5}")
+	})
+
+	suite.Run("should return bad request when the filter is invalid", func() {
+		for query, message := range map[string]string{
+			"limit=0":     "limit should be between 1 and 100",
+			"limit=101":   "limit should be between 1 and 100",
+			"offset=-1":   "offset should be zero or greater",
+			"order=up":    "order should be asc or desc",
+			"sort=gender": "sort should be name",
+			"gender=X":    "gender should be F or M",
+		} {
+			req, err := http.NewRequest("GET", suite.BaseUrl+"?"+query, nil)
+
+			w := httptest.NewRecorder()
+			assert.Nil(suite.T(), err)
+			suite.Router.ServeHTTP(w, req)
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+			assertProblem(suite.T(), w, message)
+		}
+	})
+
 	suite.Run("should return error when listing persons", func() {
-		suite.PersonService.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("error listing persons"))
+		suite.PersonService.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, errors.New("error listing persons"))
 
 		req, err := http.NewRequest("GET", suite.BaseUrl, nil)
 
@@ -129,12 +187,12 @@ func (suite *PersonHandlersTestSuite) TestList() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"error listing persons\"}", w.Body.String())
+		assertProblem(suite.T(), w, "error listing persons")
 	})
 
 	suite.Run("should return when listing persons empity", func() {
-		expectedResponse := "[]"
-		suite.PersonService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, nil)
+		expectedResponse := "{\"pagination\":{\"total\":0,\"offset\":0,\"limit\":20},\"persons\":[]}"
+		suite.PersonService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Person{}, 0, nil)
 
 		req, err := http.NewRequest("GET", suite.BaseUrl, nil)
 
@@ -170,7 +228,19 @@ func (suite *PersonHandlersTestSuite) TestGet() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"error getting person\"}", w.Body.String())
+		assertProblem(suite.T(), w, "error getting person")
+	})
+
+	suite.Run("should return not found when the service does not find the person", func() {
+		suite.PersonService.EXPECT().Get(gomock.Any(), "1").Return(nil, fmt.Errorf("get person error: %w", person.ErrNotFound))
+
+		req, err := http.NewRequest("GET", suite.BaseUrl+"1", nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "get person error: person not found")
 	})
 
 	suite.Run("should return error when getting a person not found", func() {
@@ -182,7 +252,7 @@ func (suite *PersonHandlersTestSuite) TestGet() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"person not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "person not found")
 	})
 
 	suite.Run("should return error when getting a person with invalid id", func() {
@@ -192,7 +262,7 @@ func (suite *PersonHandlersTestSuite) TestGet() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"person not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "person not found")
 	})
 }
 
@@ -223,7 +293,20 @@ func (suite *PersonHandlersTestSuite) TestUpdate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"error updating person\"}", w.Body.String())
+		assertProblem(suite.T(), w, "error updating person")
+	})
+
+	suite.Run("should return not found when updating a missing person", func() {
+		suite.PersonService.EXPECT().Update(gomock.Any(), "1", suite.Person).Return(fmt.Errorf("update person error: %w", person.ErrNotFound))
+		body, _ := json.Marshal(suite.PersonInput)
+
+		req, err := http.NewRequest("PUT", suite.BaseUrl+"1", bytes.NewBuffer(body))
+
+		assert.Nil(suite.T(), err)
+		w := httptest.NewRecorder()
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "update person error: person not found")
 	})
 
 	suite.Run("should return error when updating a person with invalid data", func() {
@@ -235,7 +318,7 @@ func (suite *PersonHandlersTestSuite) TestUpdate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"invalid character 'i' looking for beginning of value\"}", w.Body.String())
+		assertProblem(suite.T(), w, "invalid character 'i' looking for beginning of value")
 	})
 
 	suite.Run("should return error when updating a person with invalid person", func() {
@@ -250,7 +333,8 @@ func (suite *PersonHandlersTestSuite) TestUpdate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"Key: 'PersonRequest.Name' Error:Field validation for 'Name' failed on the 'required' tag\"}", w.Body.String())
+		problem := assertProblem(suite.T(), w, "the request has invalid fields")
+		assert.Equal(suite.T(), []*presenter.ViolationResponse{{Field: "name", Code: "required", Message: "is required"}}, problem.Violations)
 	})
 
 	suite.Run("should return error when updating a person with invalid id", func() {
@@ -260,13 +344,13 @@ func (suite *PersonHandlersTestSuite) TestUpdate() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"person not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "person not found")
 	})
 }
 
 func (suite *PersonHandlersTestSuite) TestDelete() {
 	suite.Run("should return success when deleting a person", func() {
-		suite.PersonService.EXPECT().Delete(gomock.Any(), "1").Return(nil)
+		suite.PersonService.EXPECT().Delete(gomock.Any(), "1", false).Return(nil)
 
 		req, err := http.NewRequest("DELETE", suite.BaseUrl+"1", nil)
 
@@ -277,7 +361,7 @@ func (suite *PersonHandlersTestSuite) TestDelete() {
 	})
 
 	suite.Run("should return error when deleting a person", func() {
-		suite.PersonService.EXPECT().Delete(gomock.Any(), "1").Return(errors.New("error deleting person"))
+		suite.PersonService.EXPECT().Delete(gomock.Any(), "1", false).Return(errors.New("error deleting person"))
 
 		req, err := http.NewRequest("DELETE", suite.BaseUrl+"1", nil)
 
@@ -285,7 +369,39 @@ func (suite *PersonHandlersTestSuite) TestDelete() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"error deleting person\"}", w.Body.String())
+		assertProblem(suite.T(), w, "error deleting person")
+	})
+
+	suite.Run("should delete the relationships with cascade", func() {
+		suite.PersonService.EXPECT().Delete(gomock.Any(), "1", true).Return(nil)
+
+		req, err := http.NewRequest("DELETE", suite.BaseUrl+"1?cascade=true", nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	})
+
+	suite.Run("should return conflict when the person has relationships", func() {
+		suite.PersonService.EXPECT().Delete(gomock.Any(), "1", false).Return(fmt.Errorf("delete person error: %w", person.ErrHasRelationships))
+
+		req, err := http.NewRequest("DELETE", suite.BaseUrl+"1", nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+		assertProblem(suite.T(), w, "person has relationships, delete with cascade=true to remove them")
+	})
+
+	suite.Run("should return bad request when cascade is invalid", func() {
+		req, err := http.NewRequest("DELETE", suite.BaseUrl+"1?cascade=maybe", nil)
+
+		w := httptest.NewRecorder()
+		assert.Nil(suite.T(), err)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
 	})
 
 	suite.Run("should return error when deleting a person with invalid id", func() {
@@ -295,6 +411,6 @@ func (suite *PersonHandlersTestSuite) TestDelete() {
 		assert.Nil(suite.T(), err)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"person not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "person not found")
 	})
 }
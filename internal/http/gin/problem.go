@@ -0,0 +1,72 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/gin-gonic/gin"
+)
+
+// Tipos de mídia dos erros da RFC 7807.
+const (
+	problemJSON = "application/problem+json"
+	problemXML  = "application/problem+xml"
+)
+
+// Responde o problema em JSON ou XML pelo Accept. Nos demais formatos, inclusive YAML, DOT,
+// SVG e texto, que não têm representação na RFC 7807, o problema é enviado em JSON.
+func respondProblem(c *gin.Context, problem *presenter.ProblemResponse) {
+	problem.Instance = c.Request.URL.Path
+	c.Header("Vary", "Accept")
+	encoder, ok := problemNegotiator.Negotiate(c.GetHeader("Accept"), problem)
+	if !ok {
+		encoder, _ = problemNegotiator.Negotiate("", problem)
+	}
+	body, err := encoder.Encode(problem)
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Data(problem.Status, encoder.ContentType, body)
+}
+
+// Problema sem tipo próprio, descrito pelo status HTTP e pelo detalhe.
+func respondProblemDetail(c *gin.Context, status int, detail string) {
+	respondProblem(c, presenter.NewProblemResponse(status, presenter.ProblemTypeBlank, detail))
+}
+
+func respondNotFound(c *gin.Context, detail string) {
+	respondProblem(c, presenter.NewProblemResponse(http.StatusNotFound, presenter.ProblemTypeNotFound, detail))
+}
+
+// Responde 400 com as violações da validação do corpo da requisição.
+func respondInvalidRequest(c *gin.Context, err error) {
+	respondProblem(c, presenter.NewValidationProblemResponse(err))
+}
+
+// Responde o erro dos serviços pelo tipo do domínio: 404 para não encontrado, 409 para conflito,
+// 422 para validação e 500 para as demais falhas. Nomes ambíguos e violações da árvore levam os detalhes.
+func respondError(c *gin.Context, err error) {
+	var ambiguousErr *familytree.AmbiguousNameError
+	var validationErr *relationship.ValidationError
+	switch {
+	case errors.As(err, &ambiguousErr):
+		respondProblem(c, presenter.NewAmbiguousNameProblemResponse(ambiguousErr))
+	case errors.As(err, &validationErr):
+		respondProblem(c, presenter.NewRelationshipValidationProblemResponse(validationErr))
+	case errors.Is(err, familytree.ErrInvalidDepth):
+		respondProblemDetail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, entity.ErrNotFound):
+		respondNotFound(c, err.Error())
+	case errors.Is(err, entity.ErrConflict):
+		respondProblem(c, presenter.NewProblemResponse(http.StatusConflict, presenter.ProblemTypeConflict, err.Error()))
+	case errors.Is(err, entity.ErrValidation):
+		respondProblem(c, presenter.NewProblemResponse(http.StatusUnprocessableEntity, presenter.ProblemTypeValidation, err.Error()))
+	default:
+		respondProblemDetail(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,96 @@
+package gin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// Verifica a resposta RFC 7807 em JSON com o status da resposta e o detalhe informado.
+func assertProblem(t *testing.T, w *httptest.ResponseRecorder, detail string) *presenter.ProblemResponse {
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	var problem presenter.ProblemResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, w.Code, problem.Status)
+	assert.Equal(t, detail, problem.Detail)
+	return &problem
+}
+
+type ProblemHandlersTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ProblemHandlersTestSuite) respond(accept string, respond func(c *gin.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("GET", "/api/v1/person/1", nil)
+	c.Request.Header.Set("Accept", accept)
+	respond(c)
+	return w
+}
+
+func (suite *ProblemHandlersTestSuite) TestRespondProblem() {
+	notFound := func(c *gin.Context) { respondNotFound(c, "person not found") }
+
+	suite.Run("should render problem+json by default", func() {
+		for _, accept := range []string{"", "application/json", "application/x-yaml", "text/plain", "image/svg+xml", "application/xml;q=0.5, application/json"} {
+			w := suite.respond(accept, notFound)
+			assert.Equal(suite.T(), http.StatusNotFound, w.Code, accept)
+			assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"), accept)
+			assert.Equal(suite.T(), `{"type":"/problems/not-found","title":"Resource not found","status":404,"detail":"person not found","instance":"/api/v1/person/1"}`, w.Body.String(), accept)
+		}
+	})
+
+	suite.Run("should render problem+xml when the client accepts XML", func() {
+		for _, accept := range []string{"application/xml", "text/xml", "application/problem+xml", "application/json;q=0.1, application/xml", "text/*"} {
+			w := suite.respond(accept, notFound)
+			assert.Equal(suite.T(), http.StatusNotFound, w.Code, accept)
+			assert.Equal(suite.T(), "application/problem+xml; charset=utf-8", w.Header().Get("Content-Type"), accept)
+			assert.Equal(suite.T(), `<problem xmlns="urn:ietf:rfc:7807"><type>/problems/not-found</type><title>Resource not found</title><status>404</status><detail>person not found</detail><instance>/api/v1/person/1</instance></problem>`, w.Body.String(), accept)
+		}
+	})
+
+	suite.Run("should render the violations in XML", func() {
+		w := suite.respond("application/xml", func(c *gin.Context) {
+			respondInvalidRequest(c, (&presenter.PersonRequest{Gender: "M"}).Validate())
+		})
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), `<violations><violation><field>name</field><code>required</code><message>is required</message></violation></violations>`)
+	})
+}
+
+func (suite *ProblemHandlersTestSuite) TestRespondError() {
+	tests := []struct {
+		err         error
+		status      int
+		problemType string
+	}{
+		{fmt.Errorf("get person error: %w", person.ErrNotFound), http.StatusNotFound, presenter.ProblemTypeNotFound},
+		{fmt.Errorf("delete person error: %w", person.ErrHasRelationships), http.StatusConflict, presenter.ProblemTypeConflict},
+		{&familytree.AmbiguousNameError{Name: "John"}, http.StatusConflict, presenter.ProblemTypeAmbiguousName},
+		{fmt.Errorf("create relationship error: %w", relationship.ErrUnknownPerson), http.StatusUnprocessableEntity, presenter.ProblemTypeValidation},
+		{&relationship.ValidationError{}, http.StatusUnprocessableEntity, presenter.ProblemTypeInconsistentTree},
+		{familytree.ErrInvalidDepth, http.StatusBadRequest, presenter.ProblemTypeBlank},
+		{errors.New("database error"), http.StatusInternalServerError, presenter.ProblemTypeBlank},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.err.Error(), func() {
+			w := suite.respond("application/json", func(c *gin.Context) { respondError(c, tt.err) })
+			assert.Equal(suite.T(), tt.status, w.Code)
+			problem := assertProblem(suite.T(), w, tt.err.Error())
+			assert.Equal(suite.T(), tt.problemType, problem.Type)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package gin
 import (
 	"net/http"
 
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
 	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
 	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
@@ -16,8 +17,9 @@ import (
 // @Produce json,xml
 // @Param relationship body presenter.PaternityRelationshipRequest true "Relationship"
 // @Success 201 {object} presenter.PaternityRelationshipResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 422 {object} presenter.ProblemResponse "Inconsistent tree"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /relationship [post]
 func createRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -25,13 +27,13 @@ func createRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 		var r presenter.PaternityRelationshipRequest
 		if err := bindData(c, &r); err != nil {
 			logger.Error("[Handler] Create relationship error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		if err := r.Validate(); err != nil {
 			logger.Error("[Handler] Create relationship error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondInvalidRequest(c, err)
 			return
 		}
 
@@ -39,7 +41,7 @@ func createRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 
 		if err := s.Create(c, rs); err != nil {
 			logger.Error("[Handler] Create relationship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -52,34 +54,41 @@ func createRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 }
 
 // @Summary List relationships
-// @Description List relationships
+// @Description List parent relationships page by page, filtered by parent or child
 // @Tags relationship
 // @Accept json,xml
 // @Produce json,xml
-// @Success 200 {array} presenter.PaternityRelationshipResponse
-// @Failure 500 {object} errorResponse
+// @Param parentId query string false "List the relationships of this parent"
+// @Param childId query string false "List the relationships of this child"
+// @Param personId query string false "List the relationships of this person, as parent or child"
+// @Param order query string false "Registration order" Enums(asc, desc)
+// @Param offset query int false "Number of relationships to skip"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} presenter.PaternityRelationshipsPageResponse
+// @Failure 400 {object} presenter.ProblemResponse "Invalid filter"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /relationship [get]
 func listRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("[Handler] List relationship started")
-		filters := map[string]interface{}{}
 
-		relationships, err := s.List(c, filters)
+		filter, err := relationshipFilterQuery(c, entity.RelationshipKindParent)
 		if err != nil {
-			logger.Error("[Handler] List relationship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			logger.Error("[Handler] List relationship error: invalid filter", err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		if len(relationships) == 0 {
-			logger.Info("[Handler] List relationship not found")
-			respondAccept(c, http.StatusOK, []presenter.PaternityRelationshipResponse{})
+		relationships, total, err := s.List(c, filter)
+		if err != nil {
+			logger.Error("[Handler] List relationship error: ", err)
+			respondError(c, err)
 			return
 		}
 
 		logger.Info("[Handler] List relationship finished")
 
-		rP := presenter.NewPaternityRelationshipsResponse(relationships)
+		rP := presenter.NewPaternityRelationshipsPageResponse(relationships, filter.Page, total)
 
 		respondAccept(c, http.StatusOK, rP)
 	}
@@ -92,8 +101,8 @@ func listRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 // @Produce json,xml
 // @Param id path string true "Relationship ID"
 // @Success 200 {object} presenter.PaternityRelationshipResponse
-// @Failure 404 {object} errorResponse "Relationship not found"
-// @Failure 500 {object} errorResponse
+// @Failure 404 {object} presenter.ProblemResponse "Relationship not found"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /relationship/{id} [get]
 func getRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -102,20 +111,20 @@ func getRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 
 		if IsEmpty(relationshipID) {
 			logger.Info("[Handler] Get relationship not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "relationship not found"})
+			respondNotFound(c, "relationship not found")
 			return
 		}
 
 		r, err := s.Get(c, relationshipID)
 		if err != nil {
 			logger.Error("[Handler] Get relationship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
-		if r == nil {
+		if !r.IsParent() {
 			logger.Info("[Handler] Get relationship not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "relationship not found"})
+			respondNotFound(c, "relationship not found")
 			return
 		}
 
@@ -135,9 +144,10 @@ func getRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 // @Param id path string true "Relationship ID"
 // @Param relationship body presenter.PaternityRelationshipRequest true "Relationship"
 // @Success 200 {object} presenter.PaternityRelationshipResponse
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 404 {object} errorResponse "Relationship not found"
-// @Failure 500 {object} errorResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Relationship not found"
+// @Failure 422 {object} presenter.ProblemResponse "Inconsistent tree"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /relationship/{id} [put]
 func updateRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -146,20 +156,24 @@ func updateRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 
 		if IsEmpty(relationshipID) {
 			logger.Info("[Handler] Update relationship not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "relationship not found"})
+			respondNotFound(c, "relationship not found")
 			return
 		}
 
 		var r presenter.PaternityRelationshipRequest
 		if err := bindData(c, &r); err != nil {
 			logger.Error("[Handler] Update relationship error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		if err := r.Validate(); err != nil {
 			logger.Error("[Handler] Update relationship error: ", err)
-			respondAccept(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+			respondInvalidRequest(c, err)
+			return
+		}
+
+		if _, ok := findRelationshipByKind(c, s, entity.RelationshipKindParent); !ok {
 			return
 		}
 
@@ -167,7 +181,7 @@ func updateRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 
 		if err := s.Update(c, relationshipID, rs); err != nil {
 			logger.Error("[Handler] Update relationship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -186,23 +200,20 @@ func updateRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 // @Produce json,xml
 // @Param id path string true "Relationship ID"
 // @Success 204
-// @Failure 404 {object} errorResponse "Relationship not found"
-// @Failure 500 {object} errorResponse
+// @Failure 404 {object} presenter.ProblemResponse "Relationship not found"
+// @Failure 500 {object} presenter.ProblemResponse
 // @Router /relationship/{id} [delete]
 func deleteRelationshipHandler(s relationship.UseCase) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("[Handler] Delete relationship started")
-		relationshipID := c.Param("id")
 
-		if IsEmpty(relationshipID) {
-			logger.Info("[Handler] Delete relationship not found")
-			respondAccept(c, http.StatusNotFound, gin.H{"error": "relationship not found"})
+		if _, ok := findRelationshipByKind(c, s, entity.RelationshipKindParent); !ok {
 			return
 		}
 
-		if err := s.Delete(c, relationshipID); err != nil {
+		if err := s.Delete(c, c.Param("id")); err != nil {
 			logger.Error("[Handler] Delete relationship error: ", err)
-			respondAccept(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -217,4 +228,7 @@ func MakeRelationshipHandlers(r *gin.RouterGroup, s relationship.UseCase) {
 	r.GET("/:id", getRelationshipHandler(s))
 	r.PUT("/:id", updateRelationshipHandler(s))
 	r.DELETE("/:id", deleteRelationshipHandler(s))
+
+	MakeUnionHandlers(r.Group("/spouse"), s, entity.RelationshipKindSpouse)
+	MakeUnionHandlers(r.Group("/partner"), s, entity.RelationshipKindPartner)
 }
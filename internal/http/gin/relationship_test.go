@@ -9,6 +9,7 @@ import (
 
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
 	mock_relationship "github.com/GeovaneCavalcante/tree-genealogical/relationship/mock"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -50,7 +51,7 @@ func (suite *RelationshipHandlersTestSuite) SetupTest() {
 
 func (suite *RelationshipHandlersTestSuite) TestCreate() {
 	suite.Run("should return success when creating a relationship", func() {
-		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\"}", suite.RelationshipInput.Parent, suite.RelationshipInput.Child)
+		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\",\"parentage\":\"biological\"}", suite.RelationshipInput.Parent, suite.RelationshipInput.Child)
 		suite.RelationshipService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 		body, _ := json.Marshal(suite.RelationshipInput)
 		w := httptest.NewRecorder()
@@ -67,7 +68,32 @@ func (suite *RelationshipHandlersTestSuite) TestCreate() {
 		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"create relationship error: error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "create relationship error: error")
+	})
+
+	suite.Run("should return unprocessable entity when a person does not exist", func() {
+		suite.RelationshipService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("create relationship error: %w", relationship.ErrUnknownPerson))
+		body, _ := json.Marshal(suite.RelationshipInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+		assertProblem(suite.T(), w, "create relationship error: relationship person does not exist")
+	})
+
+	suite.Run("should return unprocessable entity when the relationship is inconsistent", func() {
+		violations := &relationship.ValidationError{Violations: []relationship.Violation{
+			{Field: "parent", Code: relationship.ViolationCycle, Message: "the parent is a descendant of the child"},
+		}}
+		suite.RelationshipService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("create relationship error: %w", violations))
+		body, _ := json.Marshal(suite.RelationshipInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+		problem := assertProblem(suite.T(), w, "invalid relationship: the parent is a descendant of the child")
+		assert.Equal(suite.T(), presenter.ProblemTypeInconsistentTree, problem.Type)
+		assert.Equal(suite.T(), []*presenter.ViolationResponse{{Field: "parent", Code: "cycle", Message: "the parent is a descendant of the child"}}, problem.Violations)
 	})
 
 	suite.Run("should return error when creating a relationship with invalid data", func() {
@@ -77,7 +103,11 @@ func (suite *RelationshipHandlersTestSuite) TestCreate() {
 		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"Key: 'PaternityRelationshipRequest.Parent' Error:Field validation for 'Parent' failed on the 'required' tag\\nKey: 'PaternityRelationshipRequest.Child' Error:Field validation for 'Child' failed on the 'required' tag\"}", w.Body.String())
+		problem := assertProblem(suite.T(), w, "the request has invalid fields")
+		assert.Equal(suite.T(), []*presenter.ViolationResponse{
+			{Field: "parent", Code: "required", Message: "is required"},
+			{Field: "child", Code: "required", Message: "is required"},
+		}, problem.Violations)
 	})
 
 	suite.Run("should return error when creating a relationship with invalid json", func() {
@@ -85,14 +115,15 @@ func (suite *RelationshipHandlersTestSuite) TestCreate() {
 		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer([]byte("invalid")))
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"invalid character 'i' looking for beginning of value\"}", w.Body.String())
+		assertProblem(suite.T(), w, "invalid character 'i' looking for beginning of value")
 	})
 }
 
 func (suite *RelationshipHandlersTestSuite) TestList() {
 	suite.Run("should return success when listing relationships", func() {
-		expectedResponse := fmt.Sprintf("[{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\"}]", suite.Relationship.SecundePersonID, suite.Relationship.MainPersonID)
-		suite.RelationshipService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Relationship{suite.Relationship}, nil)
+		expectedResponse := fmt.Sprintf("{\"pagination\":{\"total\":1,\"offset\":0,\"limit\":20},\"relationships\":[{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\",\"parentage\":\"biological\"}]}", suite.Relationship.SecundePersonID, suite.Relationship.MainPersonID)
+		filter := &entity.RelationshipFilter{Kind: entity.RelationshipKindParent, Page: entity.Page{Limit: DefaultLimit}}
+		suite.RelationshipService.EXPECT().List(gomock.Any(), filter).Return([]*entity.Relationship{suite.Relationship}, 1, nil)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
 		suite.Router.ServeHTTP(w, req)
@@ -100,28 +131,52 @@ func (suite *RelationshipHandlersTestSuite) TestList() {
 		assert.Equal(suite.T(), expectedResponse, w.Body.String())
 	})
 
+	suite.Run("should pass the query filters to the service", func() {
+		filter := &entity.RelationshipFilter{
+			Kind:     entity.RelationshipKindParent,
+			ParentID: "2",
+			ChildID:  "1",
+			Order:    entity.OrderDesc,
+			Page:     entity.Page{Offset: 1, Limit: 1},
+		}
+		suite.RelationshipService.EXPECT().List(gomock.Any(), filter).Return([]*entity.Relationship{}, 1, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl+"?parentId=2&childId=1&order=desc&offset=1&limit=1", nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"pagination\":{\"total\":1,\"offset\":1,\"limit\":1},\"relationships\":[]}", w.Body.String())
+	})
+
+	suite.Run("should return bad request when the limit is invalid", func() {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl+"?limit=abc", nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assertProblem(suite.T(), w, "limit should be between 1 and 100")
+	})
+
 	suite.Run("should return error when listing relationships", func() {
-		suite.RelationshipService.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("list relationship error: error"))
+		suite.RelationshipService.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, fmt.Errorf("list relationship error: error"))
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"list relationship error: error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "list relationship error: error")
 	})
 
 	suite.Run("should return success when listing relationships with no data", func() {
-		suite.RelationshipService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Relationship{}, nil)
+		suite.RelationshipService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Relationship{}, 0, nil)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusOK, w.Code)
-		assert.Equal(suite.T(), "[]", w.Body.String())
+		assert.Equal(suite.T(), "{\"pagination\":{\"total\":0,\"offset\":0,\"limit\":20},\"relationships\":[]}", w.Body.String())
 	})
 }
 
 func (suite *RelationshipHandlersTestSuite) TestGet() {
 	suite.Run("should return success when getting a relationship", func() {
-		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\"}", suite.Relationship.SecundePersonID, suite.Relationship.MainPersonID)
+		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\",\"parentage\":\"biological\"}", suite.Relationship.SecundePersonID, suite.Relationship.MainPersonID)
 		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", suite.BaseUrl+uuid.New().String(), nil)
@@ -136,16 +191,16 @@ func (suite *RelationshipHandlersTestSuite) TestGet() {
 		req, _ := http.NewRequest("GET", suite.BaseUrl+uuid.New().String(), nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"get relationship error: error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "get relationship error: error")
 	})
 
 	suite.Run("should return error when getting a relationship empty", func() {
-		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, relationship.ErrNotFound)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", suite.BaseUrl+uuid.New().String(), nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"relationship not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "relationship not found")
 	})
 
 	suite.Run("should return error when getting a relationship with invalid id", func() {
@@ -153,14 +208,15 @@ func (suite *RelationshipHandlersTestSuite) TestGet() {
 		req, _ := http.NewRequest("GET", suite.BaseUrl+" ", nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"relationship not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "relationship not found")
 	})
 
 }
 
 func (suite *RelationshipHandlersTestSuite) TestUpdate() {
 	suite.Run("should return success when updating a relationship", func() {
-		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\"}", suite.RelationshipInput.Parent, suite.RelationshipInput.Child)
+		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"parent\":\"%s\",\"child\":\"%s\",\"parentage\":\"biological\"}", suite.RelationshipInput.Parent, suite.RelationshipInput.Child)
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
 		suite.RelationshipService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 		body, _ := json.Marshal(suite.RelationshipInput)
 		w := httptest.NewRecorder()
@@ -171,13 +227,14 @@ func (suite *RelationshipHandlersTestSuite) TestUpdate() {
 	})
 
 	suite.Run("should return error when updating a relationship", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
 		suite.RelationshipService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("update relationship error: error"))
 		body, _ := json.Marshal(suite.RelationshipInput)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("PUT", suite.BaseUrl+uuid.New().String(), bytes.NewBuffer(body))
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"update relationship error: error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "update relationship error: error")
 	})
 
 	suite.Run("should return error when updating a relationship with invalid data", func() {
@@ -187,7 +244,11 @@ func (suite *RelationshipHandlersTestSuite) TestUpdate() {
 		req, _ := http.NewRequest("PUT", suite.BaseUrl+uuid.New().String(), bytes.NewBuffer(body))
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"Key: 'PaternityRelationshipRequest.Parent' Error:Field validation for 'Parent' failed on the 'required' tag\\nKey: 'PaternityRelationshipRequest.Child' Error:Field validation for 'Child' failed on the 'required' tag\"}", w.Body.String())
+		problem := assertProblem(suite.T(), w, "the request has invalid fields")
+		assert.Equal(suite.T(), []*presenter.ViolationResponse{
+			{Field: "parent", Code: "required", Message: "is required"},
+			{Field: "child", Code: "required", Message: "is required"},
+		}, problem.Violations)
 	})
 
 	suite.Run("should return error when updating a relationship with invalid json", func() {
@@ -195,7 +256,28 @@ func (suite *RelationshipHandlersTestSuite) TestUpdate() {
 		req, _ := http.NewRequest("PUT", suite.BaseUrl+uuid.New().String(), bytes.NewBuffer([]byte("invalid")))
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"invalid character 'i' looking for beginning of value\"}", w.Body.String())
+		assertProblem(suite.T(), w, "invalid character 'i' looking for beginning of value")
+	})
+
+	suite.Run("should return not found when updating a missing relationship", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("get relationship error: %w", relationship.ErrNotFound))
+		body, _ := json.Marshal(suite.RelationshipInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", suite.BaseUrl+uuid.New().String(), bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "get relationship error: relationship not found")
+	})
+
+	suite.Run("should not update a union on the paternity route", func() {
+		union := &entity.Relationship{Kind: entity.RelationshipKindSpouse, MainPersonID: uuid.New().String(), SecundePersonID: uuid.New().String()}
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(union, nil)
+		body, _ := json.Marshal(suite.RelationshipInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", suite.BaseUrl+uuid.New().String(), bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "relationship not found")
 	})
 
 	suite.Run("should return error when updating a relationship with invalid id", func() {
@@ -203,12 +285,13 @@ func (suite *RelationshipHandlersTestSuite) TestUpdate() {
 		req, _ := http.NewRequest("PUT", suite.BaseUrl+" ", nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"relationship not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "relationship not found")
 	})
 }
 
 func (suite *RelationshipHandlersTestSuite) TestDelete() {
 	suite.Run("should return success when deleting a relationship", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
 		suite.RelationshipService.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("DELETE", suite.BaseUrl+uuid.New().String(), nil)
@@ -218,12 +301,33 @@ func (suite *RelationshipHandlersTestSuite) TestDelete() {
 	})
 
 	suite.Run("should return error when deleting a relationship", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
 		suite.RelationshipService.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("delete relationship error: error"))
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("DELETE", suite.BaseUrl+uuid.New().String(), nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"delete relationship error: error\"}", w.Body.String())
+		assertProblem(suite.T(), w, "delete relationship error: error")
+	})
+
+	suite.Run("should return not found when deleting a missing relationship", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
+		suite.RelationshipService.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("delete relationship error: %w", relationship.ErrNotFound))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", suite.BaseUrl+uuid.New().String(), nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "delete relationship error: relationship not found")
+	})
+
+	suite.Run("should not delete a union on the paternity route", func() {
+		union := &entity.Relationship{Kind: entity.RelationshipKindPartner, MainPersonID: uuid.New().String(), SecundePersonID: uuid.New().String()}
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(union, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", suite.BaseUrl+uuid.New().String(), nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "relationship not found")
 	})
 
 	suite.Run("should return error when deleting a relationship with invalid id", func() {
@@ -231,6 +335,6 @@ func (suite *RelationshipHandlersTestSuite) TestDelete() {
 		req, _ := http.NewRequest("DELETE", suite.BaseUrl+" ", nil)
 		suite.Router.ServeHTTP(w, req)
 		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-		assert.Equal(suite.T(), "{\"error\":\"relationship not found\"}", w.Body.String())
+		assertProblem(suite.T(), w, "relationship not found")
 	})
 }
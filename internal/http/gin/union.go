@@ -0,0 +1,233 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a union
+// @Description Create a spouse or partner relationship. For spouses, start is the marriage and end the divorce.
+// @Tags relationship
+// @Accept json,xml
+// @Produce json,xml
+// @Param relationship body presenter.UnionRelationshipRequest true "Union"
+// @Success 201 {object} presenter.UnionRelationshipResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 422 {object} presenter.ProblemResponse "Inconsistent tree"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /relationship/spouse [post]
+// @Router /relationship/partner [post]
+func createUnionHandler(s relationship.UseCase, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] Create %s started", kind))
+		var r presenter.UnionRelationshipRequest
+		if err := bindData(c, &r); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Create %s error: ", kind), err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := r.Validate(); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Create %s error: ", kind), err)
+			respondInvalidRequest(c, err)
+			return
+		}
+
+		rs := r.ToRelationship(kind)
+
+		if err := s.Create(c, rs); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Create %s error: ", kind), err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] Create %s finished", kind))
+
+		respondAccept(c, http.StatusCreated, presenter.NewUnionRelationshipResponse(rs))
+	}
+}
+
+// @Summary List unions
+// @Description List spouse or partner relationships page by page, optionally of a single person
+// @Tags relationship
+// @Accept json,xml
+// @Produce json,xml
+// @Param personId query string false "List the unions of this person"
+// @Param order query string false "Registration order" Enums(asc, desc)
+// @Param offset query int false "Number of unions to skip"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} presenter.UnionRelationshipsPageResponse
+// @Failure 400 {object} presenter.ProblemResponse "Invalid filter"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /relationship/spouse [get]
+// @Router /relationship/partner [get]
+func listUnionHandler(s relationship.UseCase, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] List %s started", kind))
+
+		filter, err := relationshipFilterQuery(c, kind)
+		if err != nil {
+			logger.Error(fmt.Sprintf("[Handler] List %s error: invalid filter", kind), err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		relationships, total, err := s.List(c, filter)
+		if err != nil {
+			logger.Error(fmt.Sprintf("[Handler] List %s error: ", kind), err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] List %s finished", kind))
+
+		respondAccept(c, http.StatusOK, presenter.NewUnionRelationshipsPageResponse(relationships, filter.Page, total))
+	}
+}
+
+// @Summary Get a union
+// @Description Get a spouse or partner relationship
+// @Tags relationship
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Relationship ID"
+// @Success 200 {object} presenter.UnionRelationshipResponse
+// @Failure 404 {object} presenter.ProblemResponse "Relationship not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /relationship/spouse/{id} [get]
+// @Router /relationship/partner/{id} [get]
+func getUnionHandler(s relationship.UseCase, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] Get %s started", kind))
+
+		r, ok := findRelationshipByKind(c, s, kind)
+		if !ok {
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] Get %s finished", kind))
+
+		respondAccept(c, http.StatusOK, presenter.NewUnionRelationshipResponse(r))
+	}
+}
+
+// @Summary Update a union
+// @Description Update a spouse or partner relationship, e.g. to register a divorce
+// @Tags relationship
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Relationship ID"
+// @Param relationship body presenter.UnionRelationshipRequest true "Union"
+// @Success 200 {object} presenter.UnionRelationshipResponse
+// @Failure 400 {object} presenter.ProblemResponse "Bad Request"
+// @Failure 404 {object} presenter.ProblemResponse "Relationship not found"
+// @Failure 422 {object} presenter.ProblemResponse "Inconsistent tree"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /relationship/spouse/{id} [put]
+// @Router /relationship/partner/{id} [put]
+func updateUnionHandler(s relationship.UseCase, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] Update %s started", kind))
+
+		var r presenter.UnionRelationshipRequest
+		if err := bindData(c, &r); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Update %s error: ", kind), err)
+			respondProblemDetail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := r.Validate(); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Update %s error: ", kind), err)
+			respondInvalidRequest(c, err)
+			return
+		}
+
+		if _, ok := findRelationshipByKind(c, s, kind); !ok {
+			return
+		}
+
+		relationshipID := c.Param("id")
+		rs := r.ToRelationship(kind)
+
+		if err := s.Update(c, relationshipID, rs); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Update %s error: ", kind), err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] Update %s finished", kind))
+
+		rs.ID = relationshipID
+		respondAccept(c, http.StatusOK, presenter.NewUnionRelationshipResponse(rs))
+	}
+}
+
+// @Summary Delete a union
+// @Description Delete a spouse or partner relationship
+// @Tags relationship
+// @Accept json,xml
+// @Produce json,xml
+// @Param id path string true "Relationship ID"
+// @Success 204
+// @Failure 404 {object} presenter.ProblemResponse "Relationship not found"
+// @Failure 500 {object} presenter.ProblemResponse
+// @Router /relationship/spouse/{id} [delete]
+// @Router /relationship/partner/{id} [delete]
+func deleteUnionHandler(s relationship.UseCase, kind string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(fmt.Sprintf("[Handler] Delete %s started", kind))
+
+		if _, ok := findRelationshipByKind(c, s, kind); !ok {
+			return
+		}
+
+		if err := s.Delete(c, c.Param("id")); err != nil {
+			logger.Error(fmt.Sprintf("[Handler] Delete %s error: ", kind), err)
+			respondError(c, err)
+			return
+		}
+
+		logger.Info(fmt.Sprintf("[Handler] Delete %s finished", kind))
+		respondAccept(c, http.StatusNoContent, nil)
+	}
+}
+
+// Busca o relacionamento do parâmetro id e responde 404 quando ele não existe ou é de outro tipo.
+func findRelationshipByKind(c *gin.Context, s relationship.UseCase, kind string) (*entity.Relationship, bool) {
+	relationshipID := c.Param("id")
+
+	if IsEmpty(relationshipID) {
+		logger.Info(fmt.Sprintf("[Handler] %s not found", kind))
+		respondNotFound(c, "relationship not found")
+		return nil, false
+	}
+
+	r, err := s.Get(c, relationshipID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Handler] Get %s error: ", kind), err)
+		respondError(c, err)
+		return nil, false
+	}
+
+	if r.KindOrDefault() != kind {
+		logger.Info(fmt.Sprintf("[Handler] %s not found", kind))
+		respondNotFound(c, "relationship not found")
+		return nil, false
+	}
+
+	return r, true
+}
+
+func MakeUnionHandlers(r *gin.RouterGroup, s relationship.UseCase, kind string) {
+	r.POST("", createUnionHandler(s, kind))
+	r.GET("", listUnionHandler(s, kind))
+	r.GET("/:id", getUnionHandler(s, kind))
+	r.PUT("/:id", updateUnionHandler(s, kind))
+	r.DELETE("/:id", deleteUnionHandler(s, kind))
+}
@@ -0,0 +1,197 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/http/presenter"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	mock_relationship "github.com/GeovaneCavalcante/tree-genealogical/relationship/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type UnionHandlersTestSuite struct {
+	suite.Suite
+	RelationshipService *mock_relationship.MockUseCase
+	Router              *gin.Engine
+	UnionInput          *presenter.UnionRelationshipRequest
+	Relationship        *entity.Relationship
+	BaseUrl             string
+}
+
+func (suite *UnionHandlersTestSuite) SetupTest() {
+	ctrl := gomock.NewController(suite.T())
+	suite.RelationshipService = mock_relationship.NewMockUseCase(ctrl)
+	suite.Router = gin.Default()
+	suite.BaseUrl = "/api/v1/relationship/spouse"
+
+	MakeRelationshipHandlers(suite.Router.Group("/api/v1/relationship"), suite.RelationshipService)
+
+	suite.UnionInput = &presenter.UnionRelationshipRequest{
+		FirstPerson:  uuid.New().String(),
+		SecondPerson: uuid.New().String(),
+		Start:        &presenter.RelationshipEvent{Date: "1990-05-12", Place: "Recife"},
+	}
+
+	suite.Relationship = &entity.Relationship{
+		ID:              uuid.New().String(),
+		Kind:            entity.RelationshipKindSpouse,
+		MainPersonID:    uuid.New().String(),
+		SecundePersonID: uuid.New().String(),
+	}
+}
+
+func (suite *UnionHandlersTestSuite) TestCreate() {
+	suite.Run("should return success when creating a spouse", func() {
+		expectedResponse := fmt.Sprintf("{\"id\":\"\",\"kind\":\"spouse\",\"firstPerson\":\"%s\",\"secondPerson\":\"%s\",\"start\":{\"date\":\"1990-05-12\",\"place\":\"Recife\"}}", suite.UnionInput.FirstPerson, suite.UnionInput.SecondPerson)
+		suite.RelationshipService.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, r *entity.Relationship) error {
+			assert.Equal(suite.T(), entity.RelationshipKindSpouse, r.Kind)
+			return nil
+		})
+		body, _ := json.Marshal(suite.UnionInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+		assert.Equal(suite.T(), expectedResponse, w.Body.String())
+	})
+
+	suite.Run("should create a partner on the partner route", func() {
+		suite.RelationshipService.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, r *entity.Relationship) error {
+			assert.Equal(suite.T(), entity.RelationshipKindPartner, r.Kind)
+			return nil
+		})
+		body, _ := json.Marshal(suite.UnionInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/relationship/partner", bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	})
+
+	suite.Run("should return error when both persons are the same", func() {
+		body, _ := json.Marshal(&presenter.UnionRelationshipRequest{FirstPerson: "1", SecondPerson: "1"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("should return error when creating a spouse", func() {
+		suite.RelationshipService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("create relationship error: error"))
+		body, _ := json.Marshal(suite.UnionInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", suite.BaseUrl, bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+		assertProblem(suite.T(), w, "create relationship error: error")
+	})
+}
+
+func (suite *UnionHandlersTestSuite) TestList() {
+	suite.Run("should filter the list by kind", func() {
+		filter := &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse, Page: entity.Page{Limit: DefaultLimit}}
+		suite.RelationshipService.EXPECT().List(gomock.Any(), filter).Return([]*entity.Relationship{suite.Relationship}, 1, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), suite.Relationship.ID)
+	})
+
+	suite.Run("should filter the list by person", func() {
+		filter := &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse, PersonID: "1", Page: entity.Page{Limit: DefaultLimit}}
+		suite.RelationshipService.EXPECT().List(gomock.Any(), filter).Return([]*entity.Relationship{suite.Relationship}, 1, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl+"?personId=1", nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+	})
+
+	suite.Run("should return empty list", func() {
+		suite.RelationshipService.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "{\"pagination\":{\"total\":0,\"offset\":0,\"limit\":20},\"relationships\":[]}", w.Body.String())
+	})
+}
+
+func (suite *UnionHandlersTestSuite) TestGet() {
+	suite.Run("should return success when getting a spouse", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", suite.BaseUrl+"/"+suite.Relationship.ID, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"kind\":\"spouse\"")
+	})
+
+	suite.Run("should return not found when the relationship is of another kind", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/relationship/partner/"+suite.Relationship.ID, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+		assertProblem(suite.T(), w, "relationship not found")
+	})
+
+	suite.Run("should not return a spouse on the paternity route", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(suite.Relationship, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/relationship/"+suite.Relationship.ID, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+}
+
+func (suite *UnionHandlersTestSuite) TestUpdate() {
+	suite.Run("should register a divorce", func() {
+		input := *suite.UnionInput
+		input.End = &presenter.RelationshipEvent{Date: "2001-03-02"}
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
+		suite.RelationshipService.EXPECT().Update(gomock.Any(), suite.Relationship.ID, gomock.Any()).Return(nil)
+		body, _ := json.Marshal(input)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", suite.BaseUrl+"/"+suite.Relationship.ID, bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "\"end\":{\"date\":\"2001-03-02\"}")
+	})
+
+	suite.Run("should return not found when the spouse does not exist", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, relationship.ErrNotFound)
+		body, _ := json.Marshal(suite.UnionInput)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", suite.BaseUrl+"/"+uuid.New().String(), bytes.NewBuffer(body))
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+}
+
+func (suite *UnionHandlersTestSuite) TestDelete() {
+	suite.Run("should return success when deleting a spouse", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
+		suite.RelationshipService.EXPECT().Delete(gomock.Any(), suite.Relationship.ID).Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", suite.BaseUrl+"/"+suite.Relationship.ID, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	})
+
+	suite.Run("should return error when get fails", func() {
+		suite.RelationshipService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("get relationship error: error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", suite.BaseUrl+"/"+suite.Relationship.ID, nil)
+		suite.Router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+type CommonAncestorResponse struct {
+	ID               string `json:"id" xml:"id"`
+	Name             string `json:"name" xml:"name"`
+	FirstGeneration  int    `json:"firstGeneration" xml:"firstGeneration"`
+	SecondGeneration int    `json:"secondGeneration" xml:"secondGeneration"`
+	MostRecent       bool   `json:"mostRecent" xml:"mostRecent"`
+}
+
+// Ancestrais compartilhados. Sem ancestral em comum, related é false e a lista é vazia.
+type CommonAncestorsResponse struct {
+	Related   bool                      `json:"related" xml:"related"`
+	Ancestors []*CommonAncestorResponse `json:"ancestors" xml:"ancestors>ancestor"`
+}
+
+func NewCommonAncestorsResponse(ancestors []*entity.CommonAncestor) *CommonAncestorsResponse {
+	response := &CommonAncestorsResponse{
+		Related:   len(ancestors) > 0,
+		Ancestors: make([]*CommonAncestorResponse, 0, len(ancestors)),
+	}
+	for _, a := range ancestors {
+		response.Ancestors = append(response.Ancestors, &CommonAncestorResponse{
+			ID:               a.Person.ID,
+			Name:             a.Person.Name,
+			FirstGeneration:  a.FirstGeneration,
+			SecondGeneration: a.SecondGeneration,
+			MostRecent:       a.MostRecent,
+		})
+	}
+	return response
+}
@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type AncestorPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *AncestorPresenerTestSuite) TestNewCommonAncestorsResponse() {
+	suite.Run("When the persons share ancestors", func() {
+		ancestors := []*entity.CommonAncestor{
+			{Person: &entity.Person{ID: "1", Name: "Martin"}, FirstGeneration: 2, SecondGeneration: 1, MostRecent: true},
+			{Person: &entity.Person{ID: "2", Name: "Oscar"}, FirstGeneration: 3, SecondGeneration: 2},
+		}
+
+		response := NewCommonAncestorsResponse(ancestors)
+		suite.True(response.Related)
+		suite.Equal([]*CommonAncestorResponse{
+			{ID: "1", Name: "Martin", FirstGeneration: 2, SecondGeneration: 1, MostRecent: true},
+			{ID: "2", Name: "Oscar", FirstGeneration: 3, SecondGeneration: 2},
+		}, response.Ancestors)
+	})
+
+	suite.Run("When the persons are unrelated", func() {
+		response := NewCommonAncestorsResponse(nil)
+		suite.False(response.Related)
+		suite.NotNil(response.Ancestors)
+		suite.Empty(response.Ancestors)
+	})
+}
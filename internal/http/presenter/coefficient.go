@@ -0,0 +1,21 @@
+package presenter
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+type CoefficientsResponse struct {
+	// Coeficiente de parentesco de Wright (r).
+	Relationship float64 `json:"relationship" xml:"relationship"`
+	Kinship      float64 `json:"kinship" xml:"kinship"`
+	// Coeficientes de endogamia (F) da primeira e da segunda pessoa.
+	FirstInbreeding  float64 `json:"firstInbreeding" xml:"firstInbreeding"`
+	SecondInbreeding float64 `json:"secondInbreeding" xml:"secondInbreeding"`
+}
+
+func NewCoefficientsResponse(coefficients *entity.Coefficients) *CoefficientsResponse {
+	return &CoefficientsResponse{
+		Relationship:     coefficients.Relationship,
+		Kinship:          coefficients.Kinship,
+		FirstInbreeding:  coefficients.FirstInbreeding,
+		SecondInbreeding: coefficients.SecondInbreeding,
+	}
+}
@@ -0,0 +1,17 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type CoefficientPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CoefficientPresenerTestSuite) TestNewCoefficientsResponse() {
+	suite.Run("When the coefficients are calculated", func() {
+		response := NewCoefficientsResponse(&entity.Coefficients{Relationship: 0.6, Kinship: 0.375, FirstInbreeding: 0.25, SecondInbreeding: 0.25})
+		suite.Equal(&CoefficientsResponse{Relationship: 0.6, Kinship: 0.375, FirstInbreeding: 0.25, SecondInbreeding: 0.25}, response)
+	})
+}
@@ -0,0 +1,80 @@
+package presenter
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/dates"
+	"github.com/go-playground/validator/v10"
+)
+
+type EventResponse struct {
+	ID          string `json:"id" xml:"id"`
+	Type        string `json:"type" xml:"type"`
+	Date        string `json:"date,omitempty" xml:"date,omitempty"`
+	Place       string `json:"place,omitempty" xml:"place,omitempty"`
+	Description string `json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// Evento da vida de uma pessoa. A data pode ser parcial (1890, 1890-05), aproximada (ABT 1890),
+// um limite (BEF 1900) ou um período (BET 1900 AND 1905), em ISO ou no formato do GEDCOM.
+type EventRequest struct {
+	Type        string `json:"type" xml:"type" validate:"required,oneof=birth baptism marriage death burial residence custom"`
+	Date        string `json:"date,omitempty" xml:"date,omitempty" validate:"omitempty,partialdate"`
+	Place       string `json:"place,omitempty" xml:"place,omitempty"`
+	Description string `json:"description,omitempty" xml:"description,omitempty" validate:"required_if=Type custom"`
+}
+
+func NewEventResponse(event *entity.Event) *EventResponse {
+	return &EventResponse{
+		ID:          event.ID,
+		Type:        event.Type,
+		Date:        event.Date,
+		Place:       event.Place,
+		Description: event.Description,
+	}
+}
+
+func NewEventsResponse(events []*entity.Event) []*EventResponse {
+	response := []*EventResponse{}
+	for _, e := range events {
+		response = append(response, NewEventResponse(e))
+	}
+	return response
+}
+
+// Converte o evento, gravando a data no formato da API (ex.: 12 MAY 1990 vira 1990-05-12).
+func (e *EventRequest) ToEvent() *entity.Event {
+	date := e.Date
+	if d, err := dates.Parse(e.Date); err == nil {
+		date = d.String()
+	}
+	return &entity.Event{
+		Type:        e.Type,
+		Date:        date,
+		Place:       e.Place,
+		Description: e.Description,
+	}
+}
+
+func (e *EventRequest) Validate() error {
+	return newValidator().Struct(e)
+}
+
+// Validador com as regras próprias da API, como a partialdate para datas genealógicas.
+// Os campos dos erros usam os nomes do JSON.
+func newValidator() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	_ = validate.RegisterValidation("partialdate", func(fl validator.FieldLevel) bool {
+		return dates.Valid(fl.Field().String())
+	})
+	return validate
+}
@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *EventPresenerTestSuite) TestToEvent() {
+	suite.Run("When the date is in the GEDCOM format", func() {
+		request := &EventRequest{Type: entity.EventBirth, Date: "abt 12 may 1890", Place: "Recife"}
+		event := request.ToEvent()
+		suite.Equal(entity.EventBirth, event.Type)
+		suite.Equal("ABT 1890-05-12", event.Date)
+		suite.Equal("Recife", event.Place)
+	})
+
+	suite.Run("When the date is empty", func() {
+		request := &EventRequest{Type: entity.EventDeath}
+		suite.Empty(request.ToEvent().Date)
+	})
+}
+
+func (suite *EventPresenerTestSuite) TestValidate() {
+	suite.Run("When the event is valid", func() {
+		request := &EventRequest{Type: entity.EventBirth, Date: "BET 1900 AND 1905"}
+		suite.Nil(request.Validate())
+	})
+
+	suite.Run("When the date is invalid", func() {
+		request := &EventRequest{Type: entity.EventBirth, Date: "sometime"}
+		suite.NotNil(request.Validate())
+	})
+
+	suite.Run("When the type is unknown", func() {
+		request := &EventRequest{Type: "graduation"}
+		suite.NotNil(request.Validate())
+	})
+
+	suite.Run("When a custom event has no description", func() {
+		request := &EventRequest{Type: entity.EventCustom, Date: "1910"}
+		suite.NotNil(request.Validate())
+	})
+
+	suite.Run("When a person has an invalid event", func() {
+		request := &PersonRequest{Name: "Martin", Gender: "M", Events: []*EventRequest{{Type: entity.EventBirth, Date: "1890-13"}}}
+		suite.NotNil(request.Validate())
+	})
+}
+
+func (suite *EventPresenerTestSuite) TestNewPersonResponse() {
+	suite.Run("When the person has events", func() {
+		person := &entity.Person{ID: "1", Name: "Martin", Gender: "M", Events: []*entity.Event{
+			{ID: "10", Type: entity.EventBirth, Date: "ABT 1890"},
+		}}
+		response := NewPersonResponse(person)
+		suite.Len(response.Events, 1)
+		suite.Equal("ABT 1890", response.Events[0].Date)
+	})
+
+	suite.Run("When the person has no events", func() {
+		suite.Nil(NewPersonResponse(&entity.Person{ID: "1"}).Events)
+	})
+}
@@ -1,9 +1,17 @@
 package presenter
 
-import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/chart"
+)
+
+// Tipo do parente que é a própria pessoa consultada.
+const rootRelation = "Root"
 
 type FamilyTreeResponse struct {
 	Members []*Member `json:"members" xml:"members"`
+	// Gráfico usado nas respostas DOT e SVG, não é serializado.
+	graph *chart.Graph
 }
 
 type DetermineRelationResponse struct {
@@ -11,12 +19,24 @@ type DetermineRelationResponse struct {
 }
 
 type KinshipDistanceResponse struct {
-	Distance int `json:"distance" xml:"distance"`
+	Distance int  `json:"distance" xml:"distance"`
+	Related  bool `json:"related" xml:"related"`
+	// Caminho legível, ex.: Bruce → (child of) Phoebe → (child of) Martin.
+	Explanation string                 `json:"explanation,omitempty" xml:"explanation,omitempty"`
+	Path        []*KinshipStepResponse `json:"path" xml:"path>step"`
+}
+
+// Pessoa do caminho e a sua ligação (child_of ou parent_of) com a próxima pessoa.
+type KinshipStepResponse struct {
+	ID       string `json:"id" xml:"id"`
+	Name     string `json:"name" xml:"name"`
+	Relation string `json:"relation,omitempty" xml:"relation,omitempty"`
 }
 
 type Member struct {
 	Name             string          `json:"name" xml:"name"`
 	TypeRelationship string          `json:"typeRelationship" xml:"typeRelationship"`
+	InLaw            bool            `json:"inLaw,omitempty" xml:"inLaw,omitempty"`
 	Relationships    []*Relationship `json:"relationships" xml:"relationships"`
 }
 
@@ -24,10 +44,24 @@ type Relationship struct {
 	Name string `json:"parent" xml:"parent"`
 }
 
-func NewKinshipDistanceResponse(distance int) *KinshipDistanceResponse {
-	return &KinshipDistanceResponse{
-		Distance: distance,
+func NewKinshipDistanceResponse(path *entity.KinshipPath) *KinshipDistanceResponse {
+	response := &KinshipDistanceResponse{
+		Distance:    path.Distance(),
+		Related:     path != nil,
+		Explanation: path.String(),
+		Path:        []*KinshipStepResponse{},
+	}
+	if path == nil {
+		return response
 	}
+	for _, step := range path.Steps {
+		response.Path = append(response.Path, &KinshipStepResponse{
+			ID:       step.Person.ID,
+			Name:     step.Person.Name,
+			Relation: step.Relation,
+		})
+	}
+	return response
 }
 
 func NewDetermineRelationResponse(relationship string) *DetermineRelationResponse {
@@ -47,6 +81,7 @@ func NewFamilyTreeResponse(relatives []*entity.Relative) *FamilyTreeResponse {
 		member := &Member{
 			Name:             relative.Person.Name,
 			TypeRelationship: relative.Type,
+			InLaw:            relative.InLaw,
 			Relationships:    make([]*Relationship, 0, len(relative.Person.Relationships)),
 		}
 
@@ -64,5 +99,63 @@ func NewFamilyTreeResponse(relatives []*entity.Relative) *FamilyTreeResponse {
 
 	return &FamilyTreeResponse{
 		Members: members,
+		graph:   newFamilyTreeGraph(relatives),
+	}
+}
+
+// Gráfico da árvore, com as pessoas ligadas aos pais e aos cônjuges.
+func (f *FamilyTreeResponse) Graph() *chart.Graph {
+	return f.graph
+}
+
+// Restringe o gráfico aos ancestrais (pedigree) ou descendentes da pessoa consultada.
+func (f *FamilyTreeResponse) UseChart(kind chart.Kind) {
+	f.graph = f.graph.Chart(kind)
+}
+
+func newFamilyTreeGraph(relatives []*entity.Relative) *chart.Graph {
+	graph := &chart.Graph{}
+	included := map[string]bool{}
+	for _, relative := range relatives {
+		if relative.Person == nil || included[relative.Person.ID] {
+			continue
+		}
+		included[relative.Person.ID] = true
+		if relative.Type == rootRelation && graph.Root == "" {
+			graph.Root = relative.Person.ID
+		}
+		graph.Nodes = append(graph.Nodes, chart.Node{
+			ID:       relative.Person.ID,
+			Name:     relative.Person.Name,
+			Relation: relative.Type,
+			Gender:   relative.Person.Gender,
+		})
+	}
+
+	unions := map[string]bool{}
+	for _, node := range graph.Nodes {
+		person := findRelativePerson(relatives, node.ID)
+		for _, rel := range person.Relationships {
+			if rel.IsParent() && included[rel.SecundePersonID] {
+				graph.Edges = append(graph.Edges, chart.Edge{From: rel.SecundePersonID, To: person.ID, Kind: chart.EdgeParent})
+			}
+		}
+		for _, union := range person.Unions {
+			if unions[union.ID] || !included[union.MainPersonID] || !included[union.SecundePersonID] {
+				continue
+			}
+			unions[union.ID] = true
+			graph.Edges = append(graph.Edges, chart.Edge{From: union.MainPersonID, To: union.SecundePersonID, Kind: chart.EdgeUnion})
+		}
+	}
+	return graph
+}
+
+func findRelativePerson(relatives []*entity.Relative, id string) *entity.Person {
+	for _, relative := range relatives {
+		if relative.Person != nil && relative.Person.ID == id {
+			return relative.Person
+		}
 	}
+	return nil
 }
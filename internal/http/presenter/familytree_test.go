@@ -2,6 +2,7 @@ package presenter
 
 import (
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/chart"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -15,9 +16,25 @@ func (suite *FamilyTreePresenerTestSuite) SetupTest() {
 
 func (suite *FamilyTreePresenerTestSuite) TestNewKinshipDistanceResponse() {
 	suite.Run("When distance is not empty", func() {
-		distance := 1
-		response := NewKinshipDistanceResponse(distance)
-		assert.Equal(suite.T(), distance, response.Distance)
+		path := &entity.KinshipPath{Steps: []*entity.KinshipStep{
+			{Person: &entity.Person{ID: "1", Name: "Bruce"}, Relation: entity.KinshipChildOf},
+			{Person: &entity.Person{ID: "2", Name: "Phoebe"}},
+		}}
+		response := NewKinshipDistanceResponse(path)
+		assert.Equal(suite.T(), 1, response.Distance)
+		assert.True(suite.T(), response.Related)
+		assert.Equal(suite.T(), "Bruce → (child of) Phoebe", response.Explanation)
+		assert.Equal(suite.T(), []*KinshipStepResponse{
+			{ID: "1", Name: "Bruce", Relation: entity.KinshipChildOf},
+			{ID: "2", Name: "Phoebe"},
+		}, response.Path)
+	})
+
+	suite.Run("When the persons are not related", func() {
+		response := NewKinshipDistanceResponse(nil)
+		assert.Zero(suite.T(), response.Distance)
+		assert.False(suite.T(), response.Related)
+		assert.Empty(suite.T(), response.Path)
 	})
 }
 
@@ -65,6 +82,18 @@ func (suite *FamilyTreePresenerTestSuite) TestNewFamilyTreeResponse() {
 		assert.Equal(suite.T(), "name", response.Members[0].Relationships[0].Name)
 	})
 
+	suite.Run("When relative is an in-law", func() {
+		relatives := []*entity.Relative{
+			{
+				Person: &entity.Person{Name: "Grace"},
+				Type:   "MotherInLaw",
+				InLaw:  true,
+			},
+		}
+		response := NewFamilyTreeResponse(relatives)
+		suite.True(response.Members[0].InLaw)
+	})
+
 	suite.Run("When relatives is empty", func() {
 		relatives := []*entity.Relative{
 			{
@@ -76,3 +105,43 @@ func (suite *FamilyTreePresenerTestSuite) TestNewFamilyTreeResponse() {
 		suite.Len(response.Members, 0)
 	})
 }
+
+func (suite *FamilyTreePresenerTestSuite) TestFamilyTreeGraph() {
+	marriage := &entity.Relationship{ID: "u1", Kind: entity.RelationshipKindSpouse, MainPersonID: "1", SecundePersonID: "4"}
+	relatives := []*entity.Relative{
+		{Type: "Root", Person: &entity.Person{ID: "1", Name: "Paul", Gender: "M", Unions: []*entity.Relationship{marriage},
+			Relationships: []*entity.Relationship{
+				{MainPersonID: "1", SecundePersonID: "2"},
+				{MainPersonID: "1", SecundePersonID: "9"},
+			}}},
+		{Type: "Father", Level: 1, Person: &entity.Person{ID: "2", Name: "John", Gender: "M"}},
+		{Type: "Daughter", Level: 1, Person: &entity.Person{ID: "3", Name: "Lucy", Gender: "F",
+			Relationships: []*entity.Relationship{{MainPersonID: "3", SecundePersonID: "1"}}}},
+		{Type: "Wife", Level: 1, Person: &entity.Person{ID: "4", Name: "Anne", Gender: "F", Unions: []*entity.Relationship{marriage}}},
+	}
+
+	suite.Run("should link persons to their parents and spouses", func() {
+		response := NewFamilyTreeResponse(relatives)
+		suite.Equal(&chart.Graph{
+			Root: "1",
+			Nodes: []chart.Node{
+				{ID: "1", Name: "Paul", Relation: "Root", Gender: "M"},
+				{ID: "2", Name: "John", Relation: "Father", Gender: "M"},
+				{ID: "3", Name: "Lucy", Relation: "Daughter", Gender: "F"},
+				{ID: "4", Name: "Anne", Relation: "Wife", Gender: "F"},
+			},
+			Edges: []chart.Edge{
+				{From: "2", To: "1", Kind: chart.EdgeParent},
+				{From: "1", To: "4", Kind: chart.EdgeUnion},
+				{From: "1", To: "3", Kind: chart.EdgeParent},
+			},
+		}, response.Graph())
+	})
+
+	suite.Run("should restrict the graph to a pedigree chart", func() {
+		response := NewFamilyTreeResponse(relatives)
+		response.UseChart(chart.KindPedigree)
+		suite.Len(response.Graph().Nodes, 2)
+		suite.Equal([]chart.Edge{{From: "2", To: "1", Kind: chart.EdgeParent}}, response.Graph().Edges)
+	})
+}
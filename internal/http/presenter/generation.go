@@ -0,0 +1,28 @@
+package presenter
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+type GenerationResponse struct {
+	Level   int               `json:"level" xml:"level"`
+	Persons []*PersonResponse `json:"persons" xml:"persons>person"`
+}
+
+// Gerações de ancestrais ou descendentes, começando pela própria pessoa no nível 0.
+type GenerationsResponse struct {
+	Depth       int                   `json:"depth" xml:"depth"`
+	Generations []*GenerationResponse `json:"generations" xml:"generations>generation"`
+}
+
+func NewGenerationsResponse(depth int, generations []*entity.Generation) *GenerationsResponse {
+	response := &GenerationsResponse{
+		Depth:       depth,
+		Generations: make([]*GenerationResponse, 0, len(generations)),
+	}
+	for _, g := range generations {
+		response.Generations = append(response.Generations, &GenerationResponse{
+			Level:   g.Level,
+			Persons: NewPersonsResponse(g.Persons),
+		})
+	}
+	return response
+}
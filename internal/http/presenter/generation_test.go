@@ -0,0 +1,31 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerationPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *GenerationPresenerTestSuite) TestNewGenerationsResponse() {
+	suite.Run("When there are generations", func() {
+		generations := []*entity.Generation{
+			{Level: 0, Persons: []*entity.Person{{ID: "1", Name: "Bruce", Gender: "M"}}},
+			{Level: 1, Persons: []*entity.Person{{ID: "2", Name: "Phoebe", Gender: "F"}}},
+		}
+
+		response := NewGenerationsResponse(3, generations)
+		suite.Equal(3, response.Depth)
+		suite.Len(response.Generations, 2)
+		suite.Equal(1, response.Generations[1].Level)
+		suite.Equal(&PersonResponse{ID: "2", Name: "Phoebe", Gender: "F"}, response.Generations[1].Persons[0])
+	})
+
+	suite.Run("When there are no generations", func() {
+		response := NewGenerationsResponse(1, nil)
+		suite.NotNil(response.Generations)
+		suite.Empty(response.Generations)
+	})
+}
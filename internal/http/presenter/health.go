@@ -0,0 +1,63 @@
+package presenter
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+type EntityRefResponse struct {
+	Type string `json:"type" xml:"type"`
+	ID   string `json:"id" xml:"id"`
+}
+
+type HealthIssueResponse struct {
+	Code     string              `json:"code" xml:"code"`
+	Severity string              `json:"severity" xml:"severity"`
+	Message  string              `json:"message" xml:"message"`
+	Entity   EntityRefResponse   `json:"entity" xml:"entity"`
+	Related  []EntityRefResponse `json:"related,omitempty" xml:"related>entity,omitempty"`
+}
+
+// Quantidade de problemas por gravidade.
+type HealthSummaryResponse struct {
+	Errors   int `json:"errors" xml:"errors"`
+	Warnings int `json:"warnings" xml:"warnings"`
+	Infos    int `json:"infos" xml:"infos"`
+}
+
+type HealthReportResponse struct {
+	Persons       int                   `json:"persons" xml:"persons"`
+	Relationships int                   `json:"relationships" xml:"relationships"`
+	Components    int                   `json:"components" xml:"components"`
+	Summary       HealthSummaryResponse `json:"summary" xml:"summary"`
+	Issues        []HealthIssueResponse `json:"issues" xml:"issues>issue"`
+}
+
+func NewHealthReportResponse(report *entity.HealthReport) *HealthReportResponse {
+	response := &HealthReportResponse{
+		Persons:       report.Persons,
+		Relationships: report.Relationships,
+		Components:    report.Components,
+		Issues:        make([]HealthIssueResponse, 0, len(report.Issues)),
+	}
+	for _, issue := range report.Issues {
+		switch issue.Severity {
+		case entity.SeverityError:
+			response.Summary.Errors++
+		case entity.SeverityWarning:
+			response.Summary.Warnings++
+		default:
+			response.Summary.Infos++
+		}
+
+		var related []EntityRefResponse
+		for _, r := range issue.Related {
+			related = append(related, EntityRefResponse(r))
+		}
+		response.Issues = append(response.Issues, HealthIssueResponse{
+			Code:     issue.Code,
+			Severity: issue.Severity,
+			Message:  issue.Message,
+			Entity:   EntityRefResponse(issue.Entity),
+			Related:  related,
+		})
+	}
+	return response
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *HealthPresenerTestSuite) TestNewHealthReportResponse() {
+	suite.Run("When the report has issues", func() {
+		report := &entity.HealthReport{Persons: 2, Relationships: 1, Components: 2, Issues: []entity.HealthIssue{
+			{Code: entity.HealthDanglingID, Severity: entity.SeverityError, Message: "dangling",
+				Entity: entity.EntityRef{Type: entity.EntityRelationship, ID: "r1"}, Related: []entity.EntityRef{{Type: entity.EntityPerson, ID: "1"}}},
+			{Code: entity.HealthMissingGender, Severity: entity.SeverityWarning, Entity: entity.EntityRef{Type: entity.EntityPerson, ID: "2"}},
+			{Code: entity.HealthDisconnectedComponent, Severity: entity.SeverityInfo, Entity: entity.EntityRef{Type: entity.EntityPerson, ID: "2"}},
+		}}
+
+		response := NewHealthReportResponse(report)
+		suite.Equal(HealthSummaryResponse{Errors: 1, Warnings: 1, Infos: 1}, response.Summary)
+		suite.Len(response.Issues, 3)
+		suite.Equal(EntityRefResponse{Type: "relationship", ID: "r1"}, response.Issues[0].Entity)
+		suite.Equal([]EntityRefResponse{{Type: "person", ID: "1"}}, response.Issues[0].Related)
+		suite.Nil(response.Issues[1].Related)
+	})
+
+	suite.Run("When the report has no issues", func() {
+		response := NewHealthReportResponse(&entity.HealthReport{})
+		suite.NotNil(response.Issues)
+		suite.Empty(response.Issues)
+	})
+}
@@ -0,0 +1,45 @@
+package presenter
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+type ImportCountsResponse struct {
+	Created int `json:"created" xml:"created"`
+	Skipped int `json:"skipped" xml:"skipped"`
+	Invalid int `json:"invalid" xml:"invalid"`
+}
+
+type ImportIssueResponse struct {
+	Line    int    `json:"line" xml:"line"`
+	Record  string `json:"record,omitempty" xml:"record,omitempty"`
+	Message string `json:"message" xml:"message"`
+}
+
+type ImportSummaryResponse struct {
+	Persons       ImportCountsResponse  `json:"persons" xml:"persons"`
+	Relationships ImportCountsResponse  `json:"relationships" xml:"relationships"`
+	Errors        []ImportIssueResponse `json:"errors" xml:"errors"`
+}
+
+func NewImportSummaryResponse(summary *entity.ImportSummary) *ImportSummaryResponse {
+	response := &ImportSummaryResponse{
+		Persons:       newImportCountsResponse(summary.Persons),
+		Relationships: newImportCountsResponse(summary.Relationships),
+		Errors:        make([]ImportIssueResponse, 0, len(summary.Issues)),
+	}
+	for _, issue := range summary.Issues {
+		response.Errors = append(response.Errors, ImportIssueResponse{
+			Line:    issue.Line,
+			Record:  issue.Record,
+			Message: issue.Message,
+		})
+	}
+	return response
+}
+
+func newImportCountsResponse(counts entity.ImportCounts) ImportCountsResponse {
+	return ImportCountsResponse{
+		Created: counts.Created,
+		Skipped: counts.Skipped,
+		Invalid: counts.Invalid,
+	}
+}
@@ -0,0 +1,29 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImportPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ImportPresenerTestSuite) TestNewImportSummaryResponse() {
+	suite.Run("When summary has issues", func() {
+		response := NewImportSummaryResponse(&entity.ImportSummary{
+			Persons:       entity.ImportCounts{Created: 2, Skipped: 1},
+			Relationships: entity.ImportCounts{Created: 3, Invalid: 1},
+			Issues:        []entity.ImportIssue{{Line: 4, Record: "@I1@", Message: "invalid sex"}},
+		})
+		suite.Equal(ImportCountsResponse{Created: 2, Skipped: 1}, response.Persons)
+		suite.Equal(ImportCountsResponse{Created: 3, Invalid: 1}, response.Relationships)
+		suite.Equal([]ImportIssueResponse{{Line: 4, Record: "@I1@", Message: "invalid sex"}}, response.Errors)
+	})
+
+	suite.Run("When summary has no issues", func() {
+		response := NewImportSummaryResponse(&entity.ImportSummary{})
+		suite.NotNil(response.Errors)
+		suite.Empty(response.Errors)
+	})
+}
@@ -0,0 +1,79 @@
+package presenter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+var numberingTitles = map[string]string{
+	entity.NumberingAhnentafel: "Ahnentafel",
+	entity.NumberingDAboville:  "d'Aboville",
+}
+
+type NumberedPersonResponse struct {
+	Number     string `json:"number" xml:"number"`
+	Generation int    `json:"generation" xml:"generation"`
+	ID         string `json:"id" xml:"id"`
+	Name       string `json:"name" xml:"name"`
+	Gender     string `json:"gender" xml:"gender"`
+	Birth      string `json:"birth,omitempty" xml:"birth,omitempty"`
+	Death      string `json:"death,omitempty" xml:"death,omitempty"`
+}
+
+// Relatório numerado de ancestrais (Ahnentafel) ou descendentes (d'Aboville).
+type NumberingResponse struct {
+	Numbering string                    `json:"numbering" xml:"numbering"`
+	Depth     int                       `json:"depth" xml:"depth"`
+	Persons   []*NumberedPersonResponse `json:"persons" xml:"persons>person"`
+}
+
+func NewNumberingResponse(numbering string, depth int, persons []*entity.NumberedPerson) *NumberingResponse {
+	response := &NumberingResponse{
+		Numbering: numbering,
+		Depth:     depth,
+		Persons:   make([]*NumberedPersonResponse, 0, len(persons)),
+	}
+	for _, p := range persons {
+		response.Persons = append(response.Persons, &NumberedPersonResponse{
+			Number:     p.Number,
+			Generation: p.Generation,
+			ID:         p.Person.ID,
+			Name:       p.Person.Name,
+			Gender:     p.Person.Gender,
+			Birth:      eventDate(p.Person, entity.EventBirth),
+			Death:      eventDate(p.Person, entity.EventDeath),
+		})
+	}
+	return response
+}
+
+// Relatório para impressão, com uma pessoa por linha recuada pela geração.
+func (n *NumberingResponse) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s report\n\n", numberingTitles[n.Numbering])
+	for _, p := range n.Persons {
+		b.WriteString(strings.Repeat("  ", p.Generation))
+		fmt.Fprintf(&b, "%s. %s", p.Number, p.Name)
+		var lifespan []string
+		if p.Birth != "" {
+			lifespan = append(lifespan, "b. "+p.Birth)
+		}
+		if p.Death != "" {
+			lifespan = append(lifespan, "d. "+p.Death)
+		}
+		if len(lifespan) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(lifespan, ", "))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func eventDate(person *entity.Person, eventType string) string {
+	if e := person.Event(eventType); e != nil {
+		return e.Date
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type NumberingPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberingPresenerTestSuite) TestNewNumberingResponse() {
+	persons := []*entity.NumberedPerson{
+		{Number: "1", Person: &entity.Person{ID: "1", Name: "John", Gender: "M", Events: []*entity.Event{{Type: entity.EventBirth, Date: "1990-05-12"}}}},
+		{Number: "2", Generation: 1, Person: &entity.Person{ID: "2", Name: "Robert", Gender: "M", Events: []*entity.Event{
+			{Type: entity.EventBirth, Date: "ABT 1960"},
+			{Type: entity.EventDeath, Date: "2020"},
+		}}},
+		{Number: "3", Generation: 1, Person: &entity.Person{ID: "3", Name: "Maria", Gender: "F"}},
+	}
+
+	suite.Run("When there are numbered persons", func() {
+		response := NewNumberingResponse(entity.NumberingAhnentafel, 2, persons)
+		suite.Equal("ahnentafel", response.Numbering)
+		suite.Equal(2, response.Depth)
+		suite.Equal(&NumberedPersonResponse{Number: "2", Generation: 1, ID: "2", Name: "Robert", Gender: "M", Birth: "ABT 1960", Death: "2020"}, response.Persons[1])
+	})
+
+	suite.Run("When the report is rendered as text", func() {
+		text := NewNumberingResponse(entity.NumberingAhnentafel, 2, persons).Text()
+		suite.Equal("Ahnentafel report\n\n1. John (b. 1990-05-12)\n  2. Robert (b. ABT 1960, d. 2020)\n  3. Maria\n", text)
+	})
+
+	suite.Run("When there are no persons", func() {
+		response := NewNumberingResponse(entity.NumberingDAboville, 1, nil)
+		suite.Empty(response.Persons)
+		suite.Equal("d'Aboville report\n\n", response.Text())
+	})
+}
@@ -0,0 +1,18 @@
+package presenter
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+// Metadados da paginação das listagens. Total considera o filtro e ignora a paginação.
+type PaginationResponse struct {
+	Total  int `json:"total" xml:"total"`
+	Offset int `json:"offset" xml:"offset"`
+	Limit  int `json:"limit" xml:"limit"`
+}
+
+func NewPaginationResponse(page entity.Page, total int) *PaginationResponse {
+	return &PaginationResponse{
+		Total:  total,
+		Offset: page.Offset,
+		Limit:  page.Limit,
+	}
+}
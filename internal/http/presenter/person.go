@@ -2,18 +2,20 @@ package presenter
 
 import (
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
-	"github.com/go-playground/validator/v10"
 )
 
 type PersonResponse struct {
-	ID     string `json:"id" xml:"id"`
-	Name   string `json:"name" xml:"name"`
-	Gender string `json:"gender" xml:"gender"`
+	ID     string           `json:"id" xml:"id"`
+	Name   string           `json:"name" xml:"name"`
+	Gender string           `json:"gender" xml:"gender"`
+	Events []*EventResponse `json:"events,omitempty" xml:"events>event,omitempty"`
 }
 
 type PersonRequest struct {
 	Name   string `json:"name" xml:"name" validate:"required"`
 	Gender string `json:"gender" xml:"gender" validate:"required,oneof=F M"`
+	// Eventos da vida da pessoa. Na atualização, substituem os eventos atuais quando informados.
+	Events []*EventRequest `json:"events,omitempty" xml:"events>event,omitempty" validate:"omitempty,dive"`
 }
 
 func NewPersonResponse(person *entity.Person) *PersonResponse {
@@ -21,6 +23,7 @@ func NewPersonResponse(person *entity.Person) *PersonResponse {
 		ID:     person.ID,
 		Name:   person.Name,
 		Gender: person.Gender,
+		Events: newEventsResponse(person.Events),
 	}
 }
 
@@ -32,6 +35,23 @@ func NewPersonsResponse(persons []*entity.Person) []*PersonResponse {
 	return response
 }
 
+// Página da listagem de pessoas.
+type PersonsPageResponse struct {
+	Pagination *PaginationResponse `json:"pagination" xml:"pagination"`
+	Persons    []*PersonResponse   `json:"persons" xml:"persons>person"`
+}
+
+func NewPersonsPageResponse(persons []*entity.Person, page entity.Page, total int) *PersonsPageResponse {
+	response := &PersonsPageResponse{
+		Pagination: NewPaginationResponse(page, total),
+		Persons:    NewPersonsResponse(persons),
+	}
+	if response.Persons == nil {
+		response.Persons = []*PersonResponse{}
+	}
+	return response
+}
+
 func NewPersonRequest(person *entity.Person) *PersonRequest {
 	return &PersonRequest{
 		Name:   person.Name,
@@ -40,13 +60,27 @@ func NewPersonRequest(person *entity.Person) *PersonRequest {
 }
 
 func (p *PersonRequest) ToPerson() *entity.Person {
-	return &entity.Person{
+	person := &entity.Person{
 		Name:   p.Name,
 		Gender: p.Gender,
 	}
+	if p.Events != nil {
+		person.Events = []*entity.Event{}
+		for _, e := range p.Events {
+			person.Events = append(person.Events, e.ToEvent())
+		}
+	}
+	return person
 }
 
 func (p *PersonRequest) Validate() error {
-	validate := validator.New(validator.WithRequiredStructEnabled())
-	return validate.Struct(p)
+	return newValidator().Struct(p)
+}
+
+// Eventos da resposta de pessoa, omitidos quando a pessoa não possui.
+func newEventsResponse(events []*entity.Event) []*EventResponse {
+	if len(events) == 0 {
+		return nil
+	}
+	return NewEventsResponse(events)
 }
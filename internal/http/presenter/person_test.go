@@ -42,6 +42,20 @@ func (suite *PersonPresenerTestSuite) TestNewPersonsResponse() {
 	})
 }
 
+func (suite *PersonPresenerTestSuite) TestNewPersonsPageResponse() {
+	suite.Run("When page has persons", func() {
+		response := NewPersonsPageResponse([]*entity.Person{suite.Person}, entity.Page{Offset: 5, Limit: 1}, 8)
+		assert.Equal(suite.T(), &PaginationResponse{Total: 8, Offset: 5, Limit: 1}, response.Pagination)
+		assert.Equal(suite.T(), suite.Person.ID, response.Persons[0].ID)
+	})
+
+	suite.Run("When page is empty", func() {
+		response := NewPersonsPageResponse(nil, entity.Page{Limit: 20}, 0)
+		assert.NotNil(suite.T(), response.Persons)
+		assert.Empty(suite.T(), response.Persons)
+	})
+}
+
 func (suite *PersonPresenerTestSuite) TestNewPersonRequest() {
 	suite.Run("When person is not empty", func() {
 		response := NewPersonRequest(suite.Person)
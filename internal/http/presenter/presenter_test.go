@@ -10,4 +10,13 @@ func TestSuite(t *testing.T) {
 	suite.Run(t, new(FamilyTreePresenerTestSuite))
 	suite.Run(t, new(PersonPresenerTestSuite))
 	suite.Run(t, new(RelationshipPresenerTestSuite))
+	suite.Run(t, new(UnionPresenerTestSuite))
+	suite.Run(t, new(ImportPresenerTestSuite))
+	suite.Run(t, new(EventPresenerTestSuite))
+	suite.Run(t, new(HealthPresenerTestSuite))
+	suite.Run(t, new(AncestorPresenerTestSuite))
+	suite.Run(t, new(CoefficientPresenerTestSuite))
+	suite.Run(t, new(GenerationPresenerTestSuite))
+	suite.Run(t, new(NumberingPresenerTestSuite))
+	suite.Run(t, new(ProblemPresenerTestSuite))
 }
@@ -0,0 +1,157 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/go-playground/validator/v10"
+)
+
+// Tipos dos problemas da API. about:blank indica que o status HTTP já descreve o problema.
+const (
+	ProblemTypeBlank            = "about:blank"
+	ProblemTypeValidation       = "/problems/validation"
+	ProblemTypeNotFound         = "/problems/not-found"
+	ProblemTypeConflict         = "/problems/conflict"
+	ProblemTypeAmbiguousName    = "/problems/ambiguous-name"
+	ProblemTypeInconsistentTree = "/problems/inconsistent-tree"
+)
+
+var problemTitles = map[string]string{
+	ProblemTypeValidation:       "Invalid request",
+	ProblemTypeNotFound:         "Resource not found",
+	ProblemTypeConflict:         "Conflict with the current state",
+	ProblemTypeAmbiguousName:    "Name matches more than one person",
+	ProblemTypeInconsistentTree: "Inconsistent family tree",
+}
+
+// Erro no formato problem details da RFC 7807. Violations, Name e Candidates são extensões
+// preenchidas nas validações e nos nomes ambíguos.
+type ProblemResponse struct {
+	Type       string               `json:"type" xml:"type"`
+	Title      string               `json:"title" xml:"title"`
+	Status     int                  `json:"status" xml:"status"`
+	Detail     string               `json:"detail,omitempty" xml:"detail,omitempty"`
+	Instance   string               `json:"instance,omitempty" xml:"instance,omitempty"`
+	Violations []*ViolationResponse `json:"violations,omitempty" xml:"-"`
+	Name       string               `json:"name,omitempty" xml:"name,omitempty"`
+	Candidates []*PersonResponse    `json:"candidates,omitempty" xml:"-"`
+}
+
+// Listas do XML. O encoding/xml não omite as tags pai (violations>violation) quando a lista está vazia.
+type violationsXML struct {
+	Items []*ViolationResponse `xml:"violation"`
+}
+
+type candidatesXML struct {
+	Items []*PersonResponse `xml:"candidate"`
+}
+
+// Elemento problem no namespace da RFC 7807.
+func (p *ProblemResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Space: "urn:ietf:rfc:7807", Local: "problem"}
+	type problem ProblemResponse
+	out := struct {
+		*problem
+		Violations *violationsXML `xml:"violations,omitempty"`
+		Candidates *candidatesXML `xml:"candidates,omitempty"`
+	}{problem: (*problem)(p)}
+	if len(p.Violations) > 0 {
+		out.Violations = &violationsXML{Items: p.Violations}
+	}
+	if len(p.Candidates) > 0 {
+		out.Candidates = &candidatesXML{Items: p.Candidates}
+	}
+	return e.EncodeElement(out, start)
+}
+
+// Campo ou regra violada pela requisição.
+type ViolationResponse struct {
+	Field   string `json:"field" xml:"field"`
+	Code    string `json:"code" xml:"code"`
+	Message string `json:"message" xml:"message"`
+}
+
+// Problema do tipo informado. O título é o do tipo ou, para about:blank, o do status HTTP.
+func NewProblemResponse(status int, problemType, detail string) *ProblemResponse {
+	title, ok := problemTitles[problemType]
+	if !ok {
+		title = http.StatusText(status)
+	}
+	return &ProblemResponse{
+		Type:   problemType,
+		Title:  title,
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// Problema 400 da validação do corpo da requisição, com uma violação por campo inválido.
+func NewValidationProblemResponse(err error) *ProblemResponse {
+	problem := NewProblemResponse(http.StatusBadRequest, ProblemTypeValidation, "the request has invalid fields")
+
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		problem.Detail = err.Error()
+		return problem
+	}
+	for _, fe := range fieldErrors {
+		problem.Violations = append(problem.Violations, &ViolationResponse{
+			Field:   fieldPath(fe),
+			Code:    fe.Tag(),
+			Message: violationMessage(fe),
+		})
+	}
+	return problem
+}
+
+// Problema 409 com os candidatos de um nome ambíguo; a consulta deve ser refeita pelas rotas por ID.
+func NewAmbiguousNameProblemResponse(err *familytree.AmbiguousNameError) *ProblemResponse {
+	problem := NewProblemResponse(http.StatusConflict, ProblemTypeAmbiguousName, err.Error())
+	problem.Name = err.Name
+	problem.Candidates = NewPersonsResponse(err.Candidates)
+	return problem
+}
+
+// Problema 422 com as regras de consistência da árvore violadas pelo relacionamento.
+func NewRelationshipValidationProblemResponse(err *relationship.ValidationError) *ProblemResponse {
+	problem := NewProblemResponse(http.StatusUnprocessableEntity, ProblemTypeInconsistentTree, err.Error())
+	problem.Violations = []*ViolationResponse{}
+	for _, v := range err.Violations {
+		problem.Violations = append(problem.Violations, &ViolationResponse{
+			Field:   v.Field,
+			Code:    v.Code,
+			Message: v.Message,
+		})
+	}
+	return problem
+}
+
+// Caminho do campo pelos nomes do JSON, sem a struct da requisição (ex.: events[0].date).
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func violationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required", "required_if":
+		return "is required"
+	case "oneof":
+		return "should be one of: " + strings.Join(strings.Fields(fe.Param()), ", ")
+	case "nefield":
+		return "should be different from " + strings.ToLower(fe.Param()[:1]) + fe.Param()[1:]
+	case "partialdate":
+		return "should be a date such as 1890, 1890-05-12, ABT 1890 or BET 1900 AND 1905"
+	default:
+		return fmt.Sprintf("failed on the %s rule", fe.Tag())
+	}
+}
@@ -0,0 +1,103 @@
+package presenter
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/familytree"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProblemPresenerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ProblemPresenerTestSuite) TestNewProblemResponse() {
+	suite.Run("should use the status text as title for about:blank", func() {
+		problem := NewProblemResponse(http.StatusInternalServerError, ProblemTypeBlank, "database error")
+		suite.Equal("about:blank", problem.Type)
+		suite.Equal("Internal Server Error", problem.Title)
+		suite.Equal(http.StatusInternalServerError, problem.Status)
+		suite.Equal("database error", problem.Detail)
+	})
+
+	suite.Run("should use the title of the problem type", func() {
+		problem := NewProblemResponse(http.StatusNotFound, ProblemTypeNotFound, "person not found")
+		suite.Equal("Resource not found", problem.Title)
+	})
+
+	suite.Run("should render the RFC 7807 members", func() {
+		problem := NewProblemResponse(http.StatusNotFound, ProblemTypeNotFound, "person not found")
+		problem.Instance = "/api/v1/person/1"
+
+		body, err := json.Marshal(problem)
+		suite.Require().NoError(err)
+		suite.Equal(`{"type":"/problems/not-found","title":"Resource not found","status":404,"detail":"person not found","instance":"/api/v1/person/1"}`, string(body))
+
+		body, err = xml.Marshal(problem)
+		suite.Require().NoError(err)
+		suite.Equal(`<problem xmlns="urn:ietf:rfc:7807"><type>/problems/not-found</type><title>Resource not found</title><status>404</status><detail>person not found</detail><instance>/api/v1/person/1</instance></problem>`, string(body))
+	})
+}
+
+func (suite *ProblemPresenerTestSuite) TestNewValidationProblemResponse() {
+	suite.Run("should return one violation per invalid field with the JSON names", func() {
+		request := &PersonRequest{Gender: "X", Events: []*EventRequest{{Type: entity.EventBirth, Date: "someday"}}}
+		problem := NewValidationProblemResponse(request.Validate())
+		suite.Equal(http.StatusBadRequest, problem.Status)
+		suite.Equal(ProblemTypeValidation, problem.Type)
+		suite.Require().Len(problem.Violations, 3)
+		suite.Equal(&ViolationResponse{Field: "name", Code: "required", Message: "is required"}, problem.Violations[0])
+		suite.Equal(&ViolationResponse{Field: "gender", Code: "oneof", Message: "should be one of: F, M"}, problem.Violations[1])
+		suite.Equal("events[0].date", problem.Violations[2].Field)
+		suite.Equal("partialdate", problem.Violations[2].Code)
+	})
+
+	suite.Run("should name the other field of nefield", func() {
+		request := &UnionRelationshipRequest{FirstPerson: "1", SecondPerson: "1"}
+		problem := NewValidationProblemResponse(request.Validate())
+		suite.Require().Len(problem.Violations, 1)
+		suite.Equal(&ViolationResponse{Field: "secondPerson", Code: "nefield", Message: "should be different from firstPerson"}, problem.Violations[0])
+	})
+
+	suite.Run("should keep the message of other errors as detail", func() {
+		problem := NewValidationProblemResponse(errors.New("invalid character"))
+		suite.Equal("invalid character", problem.Detail)
+		suite.Empty(problem.Violations)
+	})
+}
+
+func (suite *ProblemPresenerTestSuite) TestNewAmbiguousNameProblemResponse() {
+	suite.Run("When name matches more than one person", func() {
+		err := &familytree.AmbiguousNameError{
+			Name: "john",
+			Candidates: []*entity.Person{
+				{ID: "1", Name: "John", Gender: "M"},
+				{ID: "2", Name: "john", Gender: "M"},
+			},
+		}
+		problem := NewAmbiguousNameProblemResponse(err)
+		suite.Equal(http.StatusConflict, problem.Status)
+		suite.Equal(ProblemTypeAmbiguousName, problem.Type)
+		suite.Equal("john", problem.Name)
+		suite.Len(problem.Candidates, 2)
+		suite.Equal("1", problem.Candidates[0].ID)
+		suite.Equal("2", problem.Candidates[1].ID)
+	})
+}
+
+func (suite *ProblemPresenerTestSuite) TestNewRelationshipValidationProblemResponse() {
+	suite.Run("should return the violations of the tree", func() {
+		err := &relationship.ValidationError{Violations: []relationship.Violation{
+			{Field: "parent", Code: relationship.ViolationCycle, Message: "the parent is a descendant of the child"},
+		}}
+		problem := NewRelationshipValidationProblemResponse(err)
+		suite.Equal(http.StatusUnprocessableEntity, problem.Status)
+		suite.Equal(ProblemTypeInconsistentTree, problem.Type)
+		suite.Equal([]*ViolationResponse{{Field: "parent", Code: "cycle", Message: "the parent is a descendant of the child"}}, problem.Violations)
+	})
+}
@@ -2,25 +2,28 @@ package presenter
 
 import (
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
-	"github.com/go-playground/validator/v10"
 )
 
 type PaternityRelationshipResponse struct {
-	ID     string `json:"id" xml:"id"`
-	Parent string `json:"parent" xml:"parent"`
-	Child  string `json:"child" xml:"child"`
+	ID        string `json:"id" xml:"id"`
+	Parent    string `json:"parent" xml:"parent"`
+	Child     string `json:"child" xml:"child"`
+	Parentage string `json:"parentage" xml:"parentage"`
 }
 
 type PaternityRelationshipRequest struct {
 	Parent string `json:"parent" xml:"parent" validate:"required"`
 	Child  string `json:"child" xml:"child" validate:"required"`
+	// Tipo de paternidade, biológica quando não informado.
+	Parentage string `json:"parentage,omitempty" xml:"parentage,omitempty" validate:"omitempty,oneof=biological adoptive foster surrogate donor"`
 }
 
 func NewPaternityRelationshipResponse(relationship *entity.Relationship) *PaternityRelationshipResponse {
 	return &PaternityRelationshipResponse{
-		ID:     relationship.ID,
-		Parent: relationship.SecundePersonID,
-		Child:  relationship.MainPersonID,
+		ID:        relationship.ID,
+		Parent:    relationship.SecundePersonID,
+		Child:     relationship.MainPersonID,
+		Parentage: relationship.ParentageOrDefault(),
 	}
 }
 
@@ -32,6 +35,23 @@ func NewPaternityRelationshipsResponse(relationships []*entity.Relationship) []*
 	return response
 }
 
+// Página da listagem de relacionamentos de paternidade.
+type PaternityRelationshipsPageResponse struct {
+	Pagination    *PaginationResponse              `json:"pagination" xml:"pagination"`
+	Relationships []*PaternityRelationshipResponse `json:"relationships" xml:"relationships>relationship"`
+}
+
+func NewPaternityRelationshipsPageResponse(relationships []*entity.Relationship, page entity.Page, total int) *PaternityRelationshipsPageResponse {
+	response := &PaternityRelationshipsPageResponse{
+		Pagination:    NewPaginationResponse(page, total),
+		Relationships: NewPaternityRelationshipsResponse(relationships),
+	}
+	if response.Relationships == nil {
+		response.Relationships = []*PaternityRelationshipResponse{}
+	}
+	return response
+}
+
 func (p *PaternityRelationshipRequest) NewPaternityRelationshipRequest() *entity.Relationship {
 	return &entity.Relationship{
 		MainPersonID:    p.Child,
@@ -41,12 +61,13 @@ func (p *PaternityRelationshipRequest) NewPaternityRelationshipRequest() *entity
 
 func (p *PaternityRelationshipRequest) ToRelationship() *entity.Relationship {
 	return &entity.Relationship{
+		Kind:            entity.RelationshipKindParent,
 		MainPersonID:    p.Child,
 		SecundePersonID: p.Parent,
+		Parentage:       p.Parentage,
 	}
 }
 
 func (p *PaternityRelationshipRequest) Validate() error {
-	validate := validator.New(validator.WithRequiredStructEnabled())
-	return validate.Struct(p)
+	return newValidator().Struct(p)
 }
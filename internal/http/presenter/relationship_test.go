@@ -63,9 +63,27 @@ func (suite *RelationshipPresenerTestSuite) TestToRelationship() {
 	})
 }
 
+func (suite *RelationshipPresenerTestSuite) TestParentage() {
+	suite.Run("When parentage is not informed", func() {
+		suite.Equal(entity.ParentageBiological, NewPaternityRelationshipResponse(suite.Pr.ToRelationship()).Parentage)
+	})
+
+	suite.Run("When parentage is informed", func() {
+		pr := *suite.Pr
+		pr.Parentage = entity.ParentageAdoptive
+		suite.Equal(entity.ParentageAdoptive, pr.ToRelationship().Parentage)
+	})
+}
+
 func (suite *RelationshipPresenerTestSuite) TestValidate() {
 	suite.Run("When relationship is not empty", func() {
 		err := suite.Pr.Validate()
 		suite.Nil(err)
 	})
+
+	suite.Run("When parentage is invalid", func() {
+		pr := *suite.Pr
+		pr.Parentage = "godparent"
+		suite.Error(pr.Validate())
+	})
 }
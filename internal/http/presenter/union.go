@@ -0,0 +1,97 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Evento de início ou fim de uma união. Para casamentos, start é o casamento e end o divórcio.
+type RelationshipEvent struct {
+	Date  string `json:"date" xml:"date" validate:"required"`
+	Place string `json:"place,omitempty" xml:"place,omitempty"`
+}
+
+type UnionRelationshipResponse struct {
+	ID           string             `json:"id" xml:"id"`
+	Kind         string             `json:"kind" xml:"kind"`
+	FirstPerson  string             `json:"firstPerson" xml:"firstPerson"`
+	SecondPerson string             `json:"secondPerson" xml:"secondPerson"`
+	Start        *RelationshipEvent `json:"start,omitempty" xml:"start,omitempty"`
+	End          *RelationshipEvent `json:"end,omitempty" xml:"end,omitempty"`
+}
+
+type UnionRelationshipRequest struct {
+	FirstPerson  string             `json:"firstPerson" xml:"firstPerson" validate:"required"`
+	SecondPerson string             `json:"secondPerson" xml:"secondPerson" validate:"required,nefield=FirstPerson"`
+	Start        *RelationshipEvent `json:"start,omitempty" xml:"start,omitempty"`
+	End          *RelationshipEvent `json:"end,omitempty" xml:"end,omitempty"`
+}
+
+func NewUnionRelationshipResponse(relationship *entity.Relationship) *UnionRelationshipResponse {
+	return &UnionRelationshipResponse{
+		ID:           relationship.ID,
+		Kind:         relationship.KindOrDefault(),
+		FirstPerson:  relationship.MainPersonID,
+		SecondPerson: relationship.SecundePersonID,
+		Start:        newRelationshipEvent(relationship.Start),
+		End:          newRelationshipEvent(relationship.End),
+	}
+}
+
+func NewUnionRelationshipsResponse(relationships []*entity.Relationship) []*UnionRelationshipResponse {
+	var response []*UnionRelationshipResponse
+	for _, r := range relationships {
+		response = append(response, NewUnionRelationshipResponse(r))
+	}
+	return response
+}
+
+// Página da listagem de casamentos ou uniões.
+type UnionRelationshipsPageResponse struct {
+	Pagination    *PaginationResponse          `json:"pagination" xml:"pagination"`
+	Relationships []*UnionRelationshipResponse `json:"relationships" xml:"relationships>relationship"`
+}
+
+func NewUnionRelationshipsPageResponse(relationships []*entity.Relationship, page entity.Page, total int) *UnionRelationshipsPageResponse {
+	response := &UnionRelationshipsPageResponse{
+		Pagination:    NewPaginationResponse(page, total),
+		Relationships: NewUnionRelationshipsResponse(relationships),
+	}
+	if response.Relationships == nil {
+		response.Relationships = []*UnionRelationshipResponse{}
+	}
+	return response
+}
+
+func (u *UnionRelationshipRequest) ToRelationship(kind string) *entity.Relationship {
+	return &entity.Relationship{
+		Kind:            kind,
+		MainPersonID:    u.FirstPerson,
+		SecundePersonID: u.SecondPerson,
+		Start:           u.Start.toEvent(),
+		End:             u.End.toEvent(),
+	}
+}
+
+func (u *UnionRelationshipRequest) Validate() error {
+	return newValidator().Struct(u)
+}
+
+func newRelationshipEvent(event *entity.RelationshipEvent) *RelationshipEvent {
+	if event == nil {
+		return nil
+	}
+	return &RelationshipEvent{
+		Date:  event.Date,
+		Place: event.Place,
+	}
+}
+
+func (e *RelationshipEvent) toEvent() *entity.RelationshipEvent {
+	if e == nil {
+		return nil
+	}
+	return &entity.RelationshipEvent{
+		Date:  e.Date,
+		Place: e.Place,
+	}
+}
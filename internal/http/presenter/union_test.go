@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnionPresenerTestSuite struct {
+	suite.Suite
+	Relationship *entity.Relationship
+	Ur           *UnionRelationshipRequest
+}
+
+func (suite *UnionPresenerTestSuite) SetupTest() {
+	suite.Relationship = &entity.Relationship{
+		Kind:            entity.RelationshipKindSpouse,
+		MainPersonID:    "123",
+		SecundePersonID: "456",
+		Start:           &entity.RelationshipEvent{Date: "1990-05-12", Place: "Recife"},
+	}
+
+	suite.Ur = &UnionRelationshipRequest{
+		FirstPerson:  "123",
+		SecondPerson: "456",
+		Start:        &RelationshipEvent{Date: "1990-05-12", Place: "Recife"},
+	}
+}
+
+func (suite *UnionPresenerTestSuite) TestNewUnionRelationshipResponse() {
+	suite.Run("When relationship has a start event", func() {
+		response := NewUnionRelationshipResponse(suite.Relationship)
+		suite.Equal(entity.RelationshipKindSpouse, response.Kind)
+		suite.Equal(suite.Relationship.MainPersonID, response.FirstPerson)
+		suite.Equal(suite.Relationship.SecundePersonID, response.SecondPerson)
+		suite.Equal(&RelationshipEvent{Date: "1990-05-12", Place: "Recife"}, response.Start)
+		suite.Nil(response.End)
+	})
+}
+
+func (suite *UnionPresenerTestSuite) TestNewUnionRelationshipsResponse() {
+	suite.Run("When relationships is not empty", func() {
+		response := NewUnionRelationshipsResponse([]*entity.Relationship{suite.Relationship})
+		suite.Len(response, 1)
+		suite.Equal(suite.Relationship.MainPersonID, response[0].FirstPerson)
+	})
+}
+
+func (suite *UnionPresenerTestSuite) TestToRelationship() {
+	suite.Run("When request has a start event", func() {
+		relationship := suite.Ur.ToRelationship(entity.RelationshipKindPartner)
+		suite.Equal(entity.RelationshipKindPartner, relationship.Kind)
+		suite.Equal(suite.Ur.FirstPerson, relationship.MainPersonID)
+		suite.Equal(suite.Ur.SecondPerson, relationship.SecundePersonID)
+		suite.Equal(&entity.RelationshipEvent{Date: "1990-05-12", Place: "Recife"}, relationship.Start)
+		suite.Nil(relationship.End)
+	})
+}
+
+func (suite *UnionPresenerTestSuite) TestValidate() {
+	suite.Run("When request is valid", func() {
+		suite.Nil(suite.Ur.Validate())
+	})
+
+	suite.Run("When both persons are the same", func() {
+		ur := &UnionRelationshipRequest{FirstPerson: "123", SecondPerson: "123"}
+		suite.Error(ur.Validate())
+	})
+
+	suite.Run("When event has no date", func() {
+		ur := &UnionRelationshipRequest{FirstPerson: "123", SecondPerson: "456", End: &RelationshipEvent{Place: "Recife"}}
+		suite.Error(ur.Validate())
+	})
+}
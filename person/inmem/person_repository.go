@@ -7,6 +7,7 @@ import (
 
 	"github.com/GeovaneCavalcante/tree-genealogical/database"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	personDomain "github.com/GeovaneCavalcante/tree-genealogical/person"
 	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -25,7 +26,8 @@ func (r *PersonRepository) Create(ctx context.Context, person *entity.Person) er
 	logger.Info("[Repository] Create person started")
 	person.ID = uuid.New().String()
 	person.Relationships = []*entity.Relationship{}
-	r.InmenDB.Persons = append(r.InmenDB.Persons, *person)
+	r.InmenDB.AddPerson(withoutEvents(person))
+	r.addEvents(person)
 	logger.Info("[Repository] Create person finished")
 	return nil
 }
@@ -33,42 +35,41 @@ func (r *PersonRepository) Create(ctx context.Context, person *entity.Person) er
 func (r *PersonRepository) Get(ctx context.Context, personID string) (*entity.Person, error) {
 	logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s", personID))
 
-	p := findByID(r.InmenDB.Persons, personID)
-	if p == nil {
+	p, ok := r.InmenDB.FindPerson(personID)
+	if !ok {
 		logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s not found", personID))
-		return nil, fmt.Errorf("person not found")
+		return nil, personDomain.ErrNotFound
 	}
-	logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s not found", personID))
-	return p, nil
+	attachEvents(&p, r.InmenDB.Events())
+	logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s finished", personID))
+	return &p, nil
 }
 
 func (r *PersonRepository) GetByName(ctx context.Context, name string) (*entity.Person, error) {
 	logger.Info(fmt.Sprintf("[Repository] Get person by name: %s", name))
-	for _, p := range r.InmenDB.Persons {
+	persons, relationships := r.InmenDB.PersonsAndRelationships()
+	for _, p := range persons {
 		if strings.EqualFold(p.Name, name) {
 			person := p
-			for _, rr := range r.InmenDB.Relationships {
-				if rr.MainPersonID == person.ID {
-					rr.MainPerson = &person
-					rr.SecundePerson = findByID(r.InmenDB.Persons, rr.SecundePersonID)
-					relationship := rr
-					person.Relationships = append(person.Relationships, &relationship)
-				}
-			}
+			attachRelationships(&person, relationships, persons)
+			attachEvents(&person, r.InmenDB.Events())
 			return &person, nil
 		}
 	}
 	logger.Info(fmt.Sprintf("[Repository] Get person by name: %s not found", name))
-	return nil, nil
+	return nil, personDomain.ErrNotFound
 }
 
-func (r *PersonRepository) List(ctx context.Context, filters map[string]interface{}) ([]*entity.Person, error) {
+func (r *PersonRepository) List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
 	logger.Info("[Repository] List person started")
 
-	var persons []*entity.Person
+	stored, relationships := r.InmenDB.PersonsAndRelationships()
+	events := r.InmenDB.Events()
 
-	for _, p := range r.InmenDB.Persons {
+	var persons []*entity.Person
+	for _, p := range filterPersons(stored, relationships, filter) {
 		person := p
+		attachEvents(&person, events)
 		persons = append(persons, &person)
 	}
 
@@ -76,51 +77,149 @@ func (r *PersonRepository) List(ctx context.Context, filters map[string]interfac
 	return persons, nil
 }
 
-func (r *PersonRepository) ListWithRelationships(ctx context.Context, filters map[string]interface{}) ([]*entity.Person, error) {
+func (r *PersonRepository) ListWithRelationships(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
 	logger.Info("[Repository] List person with relationships started")
 
+	stored, relationships := r.InmenDB.PersonsAndRelationships()
+	events := r.InmenDB.Events()
+
 	var persons []*entity.Person
-	for _, p := range r.InmenDB.Persons {
+	for _, p := range filterPersons(stored, relationships, filter) {
 		person := p
-		for _, rr := range r.InmenDB.Relationships {
-			if rr.MainPersonID == person.ID {
-				rr.MainPerson = &person
-				rr.SecundePerson = findByID(r.InmenDB.Persons, rr.SecundePersonID)
-				relationship := rr
-				person.Relationships = append(person.Relationships, &relationship)
-			}
-		}
+		attachRelationships(&person, relationships, stored)
+		attachEvents(&person, events)
 		persons = append(persons, &person)
 	}
 	return persons, nil
 
 }
 
+func (r *PersonRepository) Count(ctx context.Context, filter *entity.PersonFilter) (int, error) {
+	stored, relationships := r.InmenDB.PersonsAndRelationships()
+	if filter != nil {
+		unpaged := *filter
+		unpaged.Page = entity.Page{}
+		filter = &unpaged
+	}
+	return len(filterPersons(stored, relationships, filter)), nil
+}
+
 func (r *PersonRepository) Update(ctx context.Context, personID string, person *entity.Person) error {
 	logger.Info(fmt.Sprintf("[Repository] Update person started by personID: %s", personID))
-	for i, p := range r.InmenDB.Persons {
-		if p.ID == personID {
-			person.ID = p.ID
-			r.InmenDB.Persons[i] = *person
-			return nil
-		}
+	person.ID = personID
+	if !r.InmenDB.UpdatePerson(personID, withoutEvents(person)) {
+		logger.Info(fmt.Sprintf("[Repository] Update person by personID: %s not found", personID))
+		return personDomain.ErrNotFound
+	}
+
+	// Eventos nil mantêm os eventos atuais; uma lista (mesmo vazia) os substitui.
+	if person.Events != nil {
+		r.InmenDB.DeletePersonEvents(personID)
+		r.addEvents(person)
 	}
-	logger.Info(fmt.Sprintf("[Repository] Update person by personID: %s not found", personID))
 	return nil
 }
 
-func (r *PersonRepository) Delete(ctx context.Context, personID string) error {
+func (r *PersonRepository) Delete(ctx context.Context, personID string, cascade bool) error {
 	logger.Info(fmt.Sprintf("[Repository] Delete person started by personID: %s", personID))
-	for i, p := range r.InmenDB.Persons {
-		if p.ID == personID {
-			r.InmenDB.Persons = append(r.InmenDB.Persons[:i], r.InmenDB.Persons[i+1:]...)
-			return nil
-		}
+	found, linked := r.InmenDB.DeletePersonWithRelationships(personID, cascade)
+	if !found {
+		logger.Info(fmt.Sprintf("[Repository] Delete person by personID: %s not found", personID))
+		return personDomain.ErrNotFound
+	}
+	if linked && !cascade {
+		logger.Info(fmt.Sprintf("[Repository] Delete person by personID: %s has relationships", personID))
+		return personDomain.ErrHasRelationships
 	}
-	logger.Info(fmt.Sprintf("[Repository] Delete person by personID: %s not found", personID))
 	return nil
 }
 
+// Grava os eventos da pessoa com novos IDs.
+func (r *PersonRepository) addEvents(person *entity.Person) {
+	for _, e := range person.Events {
+		e.ID = uuid.New().String()
+		e.PersonID = person.ID
+		r.InmenDB.AddEvent(*e)
+	}
+}
+
+// Os eventos ficam em uma coleção própria do banco, a pessoa é gravada sem eles.
+func withoutEvents(person *entity.Person) entity.Person {
+	p := *person
+	p.Events = nil
+	return p
+}
+
+// Vincula à pessoa os eventos gravados para ela.
+func attachEvents(person *entity.Person, events []entity.Event) {
+	person.Events = nil
+	for _, e := range events {
+		if e.PersonID == person.ID {
+			event := e
+			person.Events = append(person.Events, &event)
+		}
+	}
+}
+
+// Aplica o filtro, a ordenação e a paginação sobre as pessoas armazenadas.
+func filterPersons(stored []entity.Person, relationships []entity.Relationship, filter *entity.PersonFilter) []entity.Person {
+	if filter == nil {
+		return stored
+	}
+
+	var persons []*entity.Person
+	for i := range stored {
+		person := &stored[i]
+		if filter.Match(person) && matchRelatives(person.ID, relationships, filter) {
+			persons = append(persons, person)
+		}
+	}
+	filter.SortPersons(persons)
+
+	var filtered []entity.Person
+	for _, p := range entity.Paginate(persons, filter.Page) {
+		filtered = append(filtered, *p)
+	}
+	return filtered
+}
+
+// Verifica os filtros por pai/mãe e filho nos relacionamentos de paternidade.
+func matchRelatives(personID string, relationships []entity.Relationship, filter *entity.PersonFilter) bool {
+	if filter.ParentID != "" && !hasParentRelationship(relationships, personID, filter.ParentID) {
+		return false
+	}
+	return filter.ChildID == "" || hasParentRelationship(relationships, filter.ChildID, personID)
+}
+
+func hasParentRelationship(relationships []entity.Relationship, childID, parentID string) bool {
+	for _, r := range relationships {
+		if r.IsParent() && r.MainPersonID == childID && r.SecundePersonID == parentID {
+			return true
+		}
+	}
+	return false
+}
+
+// Vincula à pessoa os relacionamentos de paternidade em que ela é o filho e as uniões de que participa.
+func attachRelationships(person *entity.Person, relationships []entity.Relationship, persons []entity.Person) {
+	person.Relationships = nil
+	person.Unions = nil
+	for _, rr := range relationships {
+		switch {
+		case rr.IsParent() && rr.MainPersonID == person.ID:
+			rr.MainPerson = person
+			rr.SecundePerson = findByID(persons, rr.SecundePersonID)
+			relationship := rr
+			person.Relationships = append(person.Relationships, &relationship)
+		case rr.IsUnion() && rr.OtherPersonID(person.ID) != "":
+			rr.MainPerson = findByID(persons, rr.MainPersonID)
+			rr.SecundePerson = findByID(persons, rr.SecundePersonID)
+			relationship := rr
+			person.Unions = append(person.Unions, &relationship)
+		}
+	}
+}
+
 func findByID(persons []entity.Person, id string) *entity.Person {
 	for _, p := range persons {
 		if p.ID == id {
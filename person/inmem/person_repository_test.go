@@ -0,0 +1,190 @@
+package inmem
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/stretchr/testify/suite"
+)
+
+type PersonRepositoryTestSuite struct {
+	suite.Suite
+	Repo *PersonRepository
+}
+
+func (suite *PersonRepositoryTestSuite) SetupTest() {
+	suite.Repo = NewPersonRepository(database.NewEmpty())
+}
+
+func (suite *PersonRepositoryTestSuite) TestGetByName() {
+	ctx := context.Background()
+	martin := &entity.Person{Name: "Martin", Gender: "M"}
+	phoebe := &entity.Person{Name: "Phoebe", Gender: "F"}
+	suite.Require().NoError(suite.Repo.Create(ctx, martin))
+	suite.Require().NoError(suite.Repo.Create(ctx, phoebe))
+	database.NewRelationshipAndLoadDb(suite.Repo.InmenDB, phoebe.ID, martin.ID)
+
+	suite.Run("should find the person ignoring case with relationships", func() {
+		found, err := suite.Repo.GetByName(ctx, "PHOEBE")
+		suite.Nil(err)
+		suite.Equal(phoebe.ID, found.ID)
+		suite.Len(found.Relationships, 1)
+		suite.Equal("Martin", found.Relationships[0].SecundePerson.Name)
+	})
+
+	suite.Run("should return not found when the name does not exist", func() {
+		found, err := suite.Repo.GetByName(ctx, "Bruce")
+		suite.ErrorIs(err, person.ErrNotFound)
+		suite.Nil(found)
+	})
+
+	suite.Run("should return not found for a missing ID", func() {
+		_, err := suite.Repo.Get(ctx, "unknown")
+		suite.ErrorIs(err, person.ErrNotFound)
+		suite.ErrorIs(suite.Repo.Update(ctx, "unknown", &entity.Person{Name: "Bruce"}), person.ErrNotFound)
+		suite.ErrorIs(suite.Repo.Delete(ctx, "unknown", false), person.ErrNotFound)
+	})
+
+	suite.Run("should not accumulate relationships across calls", func() {
+		_, _ = suite.Repo.ListWithRelationships(ctx, nil)
+		persons, err := suite.Repo.ListWithRelationships(ctx, nil)
+		suite.Nil(err)
+		suite.Len(persons[1].Relationships, 1)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestEvents() {
+	ctx := context.Background()
+	martin := &entity.Person{Name: "Martin", Gender: "M", Events: []*entity.Event{
+		{Type: entity.EventBirth, Date: "ABT 1890", Place: "Recife"},
+	}}
+	suite.Require().NoError(suite.Repo.Create(ctx, martin))
+
+	suite.Run("should store the events of the person", func() {
+		found, err := suite.Repo.Get(ctx, martin.ID)
+		suite.Nil(err)
+		suite.Len(found.Events, 1)
+		suite.NotEmpty(found.Events[0].ID)
+		suite.Equal(martin.ID, found.Events[0].PersonID)
+		suite.Equal("ABT 1890", found.Event(entity.EventBirth).Date)
+	})
+
+	suite.Run("should keep the events when the update has none", func() {
+		suite.Nil(suite.Repo.Update(ctx, martin.ID, &entity.Person{Name: "Martin", Gender: "M"}))
+		found, _ := suite.Repo.GetByName(ctx, "Martin")
+		suite.Len(found.Events, 1)
+	})
+
+	suite.Run("should replace the events on update", func() {
+		suite.Nil(suite.Repo.Update(ctx, martin.ID, &entity.Person{Name: "Martin", Gender: "M", Events: []*entity.Event{
+			{Type: entity.EventDeath, Date: "1950-03"},
+		}}))
+		persons, _ := suite.Repo.ListWithRelationships(ctx, nil)
+		suite.Len(persons[0].Events, 1)
+		suite.Equal(entity.EventDeath, persons[0].Events[0].Type)
+	})
+
+	suite.Run("should delete the events with the person", func() {
+		suite.Nil(suite.Repo.Delete(ctx, martin.ID, false))
+		suite.Empty(suite.Repo.InmenDB.Events())
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestDeleteWithRelationships() {
+	ctx := context.Background()
+	martin := &entity.Person{Name: "Martin", Gender: "M"}
+	phoebe := &entity.Person{Name: "Phoebe", Gender: "F"}
+	suite.Require().NoError(suite.Repo.Create(ctx, martin))
+	suite.Require().NoError(suite.Repo.Create(ctx, phoebe))
+	database.NewRelationshipAndLoadDb(suite.Repo.InmenDB, phoebe.ID, martin.ID)
+
+	suite.Run("should refuse to delete a person with relationships", func() {
+		suite.ErrorIs(suite.Repo.Delete(ctx, martin.ID, false), person.ErrHasRelationships)
+		suite.Len(suite.Repo.InmenDB.Persons(), 2)
+	})
+
+	suite.Run("should delete the relationships on cascade", func() {
+		suite.Nil(suite.Repo.Delete(ctx, martin.ID, true))
+		suite.Len(suite.Repo.InmenDB.Persons(), 1)
+		suite.Empty(suite.Repo.InmenDB.Relationships())
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestListFilter() {
+	ctx := context.Background()
+	martin := &entity.Person{Name: "Martin", Gender: "M"}
+	anastasia := &entity.Person{Name: "Anastasia", Gender: "F"}
+	phoebe := &entity.Person{Name: "Phoebe", Gender: "F"}
+	for _, p := range []*entity.Person{martin, anastasia, phoebe} {
+		suite.Require().NoError(suite.Repo.Create(ctx, p))
+	}
+	database.NewRelationshipAndLoadDb(suite.Repo.InmenDB, phoebe.ID, martin.ID)
+	database.NewRelationshipAndLoadDb(suite.Repo.InmenDB, phoebe.ID, anastasia.ID)
+
+	suite.Run("should filter by name, gender, parent and child", func() {
+		persons, err := suite.Repo.List(ctx, &entity.PersonFilter{Name: "TA", Gender: "F"})
+		suite.Nil(err)
+		suite.Len(persons, 1)
+		suite.Equal("Anastasia", persons[0].Name)
+
+		children, err := suite.Repo.List(ctx, &entity.PersonFilter{ParentID: martin.ID})
+		suite.Nil(err)
+		suite.Len(children, 1)
+		suite.Equal("Phoebe", children[0].Name)
+
+		parents, err := suite.Repo.ListWithRelationships(ctx, &entity.PersonFilter{ChildID: phoebe.ID})
+		suite.Nil(err)
+		suite.Len(parents, 2)
+	})
+
+	suite.Run("should sort and paginate", func() {
+		filter := &entity.PersonFilter{Sort: entity.SortName, Page: entity.Page{Offset: 1, Limit: 1}}
+		persons, err := suite.Repo.List(ctx, filter)
+		suite.Nil(err)
+		suite.Len(persons, 1)
+		suite.Equal("Martin", persons[0].Name)
+
+		total, err := suite.Repo.Count(ctx, filter)
+		suite.Nil(err)
+		suite.Equal(3, total)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestConcurrentAccess() {
+	ctx := context.Background()
+	suite.Run("should create, list and delete persons from many goroutines", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				p := &entity.Person{Name: fmt.Sprint("Person ", i), Gender: "M"}
+				suite.NoError(suite.Repo.Create(ctx, p))
+				_, err := suite.Repo.List(ctx, nil)
+				suite.NoError(err)
+				_, err = suite.Repo.ListWithRelationships(ctx, nil)
+				suite.NoError(err)
+				_, err = suite.Repo.GetByName(ctx, p.Name)
+				suite.NoError(err)
+				if i%2 == 0 {
+					suite.NoError(suite.Repo.Update(ctx, p.ID, &entity.Person{Name: p.Name, Gender: "F"}))
+					suite.NoError(suite.Repo.Delete(ctx, p.ID, false))
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		persons, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Len(persons, 50)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(PersonRepositoryTestSuite))
+}
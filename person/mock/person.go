@@ -40,6 +40,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockRepository) Count(ctx context.Context, filter *entity.PersonFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx, filter)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, person *entity.Person) error {
 	m.ctrl.T.Helper()
@@ -55,17 +70,17 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, person any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, ID string) error {
+func (m *MockRepository) Delete(ctx context.Context, ID string, cascade bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, ID)
+	ret := m.ctrl.Call(m, "Delete", ctx, ID, cascade)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, ID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, ID, cascade any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, ID, cascade)
 }
 
 // Get mocks base method.
@@ -99,33 +114,33 @@ func (mr *MockRepositoryMockRecorder) GetByName(ctx, name any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockRepository) List(ctx context.Context, filters map[string]any) ([]*entity.Person, error) {
+func (m *MockRepository) List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, filters)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]*entity.Person)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockRepositoryMockRecorder) List(ctx, filters any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filter)
 }
 
 // ListWithRelationships mocks base method.
-func (m *MockRepository) ListWithRelationships(ctx context.Context, filters map[string]any) ([]*entity.Person, error) {
+func (m *MockRepository) ListWithRelationships(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWithRelationships", ctx, filters)
+	ret := m.ctrl.Call(m, "ListWithRelationships", ctx, filter)
 	ret0, _ := ret[0].([]*entity.Person)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListWithRelationships indicates an expected call of ListWithRelationships.
-func (mr *MockRepositoryMockRecorder) ListWithRelationships(ctx, filters any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) ListWithRelationships(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithRelationships", reflect.TypeOf((*MockRepository)(nil).ListWithRelationships), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithRelationships", reflect.TypeOf((*MockRepository)(nil).ListWithRelationships), ctx, filter)
 }
 
 // Update mocks base method.
@@ -180,17 +195,17 @@ func (mr *MockUseCaseMockRecorder) Create(ctx, person any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockUseCase) Delete(ctx context.Context, ID string) error {
+func (m *MockUseCase) Delete(ctx context.Context, ID string, cascade bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, ID)
+	ret := m.ctrl.Call(m, "Delete", ctx, ID, cascade)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUseCaseMockRecorder) Delete(ctx, ID any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Delete(ctx, ID, cascade any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, ID, cascade)
 }
 
 // Get mocks base method.
@@ -209,18 +224,19 @@ func (mr *MockUseCaseMockRecorder) Get(ctx, ID any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockUseCase) List(ctx context.Context, filters map[string]any) ([]*entity.Person, error) {
+func (m *MockUseCase) List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, filters)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]*entity.Person)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
-func (mr *MockUseCaseMockRecorder) List(ctx, filters any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) List(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, filter)
 }
 
 // Update mocks base method.
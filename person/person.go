@@ -6,20 +6,32 @@ import (
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 )
 
+var (
+	// Os repositórios retornam ErrNotFound em Get, GetByName, Update e Delete quando a pessoa não existe.
+	ErrNotFound = entity.NewNotFoundError("person not found")
+	// A pessoa participa de relacionamentos e só pode ser removida em cascata.
+	ErrHasRelationships = entity.NewConflictError("person has relationships")
+)
+
 type Repository interface {
 	Create(ctx context.Context, person *entity.Person) error
 	Get(ctx context.Context, ID string) (*entity.Person, error)
 	GetByName(ctx context.Context, name string) (*entity.Person, error)
-	List(ctx context.Context, filters map[string]interface{}) ([]*entity.Person, error)
-	ListWithRelationships(ctx context.Context, filters map[string]interface{}) ([]*entity.Person, error)
+	List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error)
+	ListWithRelationships(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error)
+	// Total de pessoas que atendem ao filtro, desconsiderando a paginação.
+	Count(ctx context.Context, filter *entity.PersonFilter) (int, error)
 	Update(ctx context.Context, ID string, person *entity.Person) error
-	Delete(ctx context.Context, ID string) error
+	// Com cascade, remove também os relacionamentos da pessoa; sem cascade, retorna
+	// ErrHasRelationships quando eles existem.
+	Delete(ctx context.Context, ID string, cascade bool) error
 }
 
 type UseCase interface {
 	Create(ctx context.Context, person *entity.Person) error
 	Get(ctx context.Context, ID string) (*entity.Person, error)
-	List(ctx context.Context, filters map[string]interface{}) ([]*entity.Person, error)
+	// Pessoas da página solicitada e o total de pessoas que atendem ao filtro.
+	List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, int, error)
 	Update(ctx context.Context, ID string, person *entity.Person) error
-	Delete(ctx context.Context, ID string) error
+	Delete(ctx context.Context, ID string, cascade bool) error
 }
@@ -44,38 +44,39 @@ func (s *Service) Get(ctx context.Context, personID string) (*entity.Person, err
 	return person, nil
 }
 
-func (s *Service) List(ctx context.Context, filters map[string]interface{}) ([]*entity.Person, error) {
+func (s *Service) List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, int, error) {
 	logger.Info("[Service] List person started")
 
-	persons, err := s.repo.List(ctx, filters)
+	persons, err := s.repo.List(ctx, filter)
 	if err != nil {
 		logger.Error("[Service] List person error: ", err)
-		return nil, fmt.Errorf("list person error: %w", err)
+		return nil, 0, fmt.Errorf("list person error: %w", err)
+	}
+
+	total, err := s.repo.Count(ctx, filter)
+	if err != nil {
+		logger.Error("[Service] Count person error: ", err)
+		return nil, 0, fmt.Errorf("count person error: %w", err)
 	}
 
 	if len(persons) == 0 {
 		logger.Info("[Service] List person not found")
-		return nil, nil
+		return nil, total, nil
 	}
 
 	logger.Info("[Service] List person finished")
-	return persons, nil
+	return persons, total, nil
 }
 
 func (s *Service) Update(ctx context.Context, personID string, person *entity.Person) error {
 	logger.Info(fmt.Sprintf("[Service] Update person started by personID: %s", personID))
 
-	p, err := s.Get(ctx, personID)
+	_, err := s.Get(ctx, personID)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] Update person by personID: %s error", personID), err)
 		return fmt.Errorf("update person error: %w", err)
 	}
 
-	if p == nil {
-		logger.Error(fmt.Sprintf("[Service] Update person by personID: %s not found", personID), nil)
-		return fmt.Errorf("update person error: not found")
-	}
-
 	err = s.repo.Update(ctx, personID, person)
 	if err != nil {
 		logger.Error("[Service] Update person error: ", err)
@@ -86,21 +87,16 @@ func (s *Service) Update(ctx context.Context, personID string, person *entity.Pe
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context, personID string) error {
+func (s *Service) Delete(ctx context.Context, personID string, cascade bool) error {
 	logger.Info(fmt.Sprintf("[Service] Delete person started by personID: %s", personID))
 
-	p, err := s.Get(ctx, personID)
+	_, err := s.Get(ctx, personID)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] Delete person by personID: %s error", personID), err)
 		return fmt.Errorf("delete person error: %w", err)
 	}
 
-	if p == nil {
-		logger.Error(fmt.Sprintf("[Service] Delete person by personID: %s not found", personID), nil)
-		return fmt.Errorf("delete person error: not found")
-	}
-
-	err = s.repo.Delete(ctx, personID)
+	err = s.repo.Delete(ctx, personID, cascade)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] Delete person error by personID: %s", personID), err)
 		return fmt.Errorf("delete person error: %w", err)
@@ -70,27 +70,51 @@ func (suite *PersonServiceTestSuite) TestList() {
 	ctx := context.Background()
 	suite.Run("should return success when listing persons", func() {
 		suite.PersonRepoMock.EXPECT().List(gomock.Any(), nil).Return([]*entity.Person{suite.Person}, nil)
+		suite.PersonRepoMock.EXPECT().Count(gomock.Any(), nil).Return(1, nil)
 		service := NewService(suite.PersonRepoMock)
-		persons, err := service.List(ctx, nil)
+		persons, total, err := service.List(ctx, nil)
 		assert.Nil(suite.T(), err)
 		assert.Equal(suite.T(), []*entity.Person{suite.Person}, persons)
+		assert.Equal(suite.T(), 1, total)
+	})
+
+	suite.Run("should pass the filter to the repository", func() {
+		filter := &entity.PersonFilter{Name: "jo", Page: entity.Page{Offset: 1, Limit: 1}}
+		suite.PersonRepoMock.EXPECT().List(gomock.Any(), filter).Return([]*entity.Person{suite.Person}, nil)
+		suite.PersonRepoMock.EXPECT().Count(gomock.Any(), filter).Return(3, nil)
+		service := NewService(suite.PersonRepoMock)
+		persons, total, err := service.List(ctx, filter)
+		assert.Nil(suite.T(), err)
+		assert.Len(suite.T(), persons, 1)
+		assert.Equal(suite.T(), 3, total)
 	})
 
 	suite.Run("should return error when listing persons", func() {
 		suite.PersonRepoMock.EXPECT().List(gomock.Any(), nil).Return(nil, errors.New("database error"))
 		service := NewService(suite.PersonRepoMock)
-		persons, err := service.List(ctx, nil)
+		persons, _, err := service.List(ctx, nil)
 		assert.NotNil(suite.T(), err)
 		assert.Nil(suite.T(), persons)
 		assert.Equal(suite.T(), "list person error: database error", err.Error())
 	})
 
+	suite.Run("should return error when counting persons", func() {
+		suite.PersonRepoMock.EXPECT().List(gomock.Any(), nil).Return([]*entity.Person{suite.Person}, nil)
+		suite.PersonRepoMock.EXPECT().Count(gomock.Any(), nil).Return(0, errors.New("database error"))
+		service := NewService(suite.PersonRepoMock)
+		persons, _, err := service.List(ctx, nil)
+		assert.Nil(suite.T(), persons)
+		assert.Equal(suite.T(), "count person error: database error", err.Error())
+	})
+
 	suite.Run("should return not found when listing persons", func() {
 		suite.PersonRepoMock.EXPECT().List(gomock.Any(), nil).Return(nil, nil)
+		suite.PersonRepoMock.EXPECT().Count(gomock.Any(), nil).Return(0, nil)
 		service := NewService(suite.PersonRepoMock)
-		persons, err := service.List(ctx, nil)
+		persons, total, err := service.List(ctx, nil)
 		assert.Nil(suite.T(), err)
 		assert.Nil(suite.T(), persons)
+		assert.Zero(suite.T(), total)
 	})
 }
 
@@ -122,11 +146,12 @@ func (suite *PersonServiceTestSuite) TestUpdate() {
 	})
 
 	suite.Run("should return not found when getting a person to update", func() {
-		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(nil, nil)
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(nil, ErrNotFound)
 		service := NewService(suite.PersonRepoMock)
 		err := service.Update(ctx, suite.Person.ID, suite.Person)
 		assert.NotNil(suite.T(), err)
-		assert.Equal(suite.T(), "update person error: not found", err.Error())
+		assert.ErrorIs(suite.T(), err, ErrNotFound)
+		assert.Equal(suite.T(), "update person error: get person error: person not found", err.Error())
 	})
 }
 
@@ -134,35 +159,51 @@ func (suite *PersonServiceTestSuite) TestDelete() {
 	ctx := context.Background()
 	suite.Run("should return success when deleting a person", func() {
 		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(suite.Person, nil)
-		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), suite.Person.ID).Return(nil)
+		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), suite.Person.ID, false).Return(nil)
 		service := NewService(suite.PersonRepoMock)
-		err := service.Delete(ctx, suite.Person.ID)
+		err := service.Delete(ctx, suite.Person.ID, false)
 		assert.Nil(suite.T(), err)
 	})
 
 	suite.Run("should return error when deleting a person", func() {
 		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(suite.Person, nil)
-		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), suite.Person.ID).Return(errors.New("database error"))
+		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), suite.Person.ID, false).Return(errors.New("database error"))
 		service := NewService(suite.PersonRepoMock)
-		err := service.Delete(ctx, suite.Person.ID)
+		err := service.Delete(ctx, suite.Person.ID, false)
 		assert.NotNil(suite.T(), err)
 		assert.Equal(suite.T(), "delete person error: database error", err.Error())
 	})
 
+	suite.Run("should keep the error when the person has relationships", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(suite.Person, nil)
+		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), suite.Person.ID, false).Return(ErrHasRelationships)
+		service := NewService(suite.PersonRepoMock)
+		err := service.Delete(ctx, suite.Person.ID, false)
+		assert.ErrorIs(suite.T(), err, ErrHasRelationships)
+	})
+
+	suite.Run("should pass cascade to the repository", func() {
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(suite.Person, nil)
+		suite.PersonRepoMock.EXPECT().Delete(gomock.Any(), suite.Person.ID, true).Return(nil)
+		service := NewService(suite.PersonRepoMock)
+		assert.Nil(suite.T(), service.Delete(ctx, suite.Person.ID, true))
+	})
+
 	suite.Run("should return error when getting a person to delete", func() {
 		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(nil, errors.New("database error"))
 		service := NewService(suite.PersonRepoMock)
-		err := service.Delete(ctx, suite.Person.ID)
+		err := service.Delete(ctx, suite.Person.ID, false)
 		assert.NotNil(suite.T(), err)
 		assert.Equal(suite.T(), "delete person error: get person error: database error", err.Error())
 	})
 
 	suite.Run("should return not found when getting a person to delete", func() {
-		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(nil, nil)
+		suite.PersonRepoMock.EXPECT().Get(gomock.Any(), suite.Person.ID).Return(nil, ErrNotFound)
 		service := NewService(suite.PersonRepoMock)
-		err := service.Delete(ctx, suite.Person.ID)
+		err := service.Delete(ctx, suite.Person.ID, false)
 		assert.NotNil(suite.T(), err)
-		assert.Equal(suite.T(), "delete person error: not found", err.Error())
+		assert.ErrorIs(suite.T(), err, ErrNotFound)
+		assert.Equal(suite.T(), "delete person error: get person error: person not found", err.Error())
 	})
 }
 
@@ -0,0 +1,489 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	personDomain "github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type PersonRepository struct {
+	DB *sql.DB
+}
+
+func NewPersonRepository(db *sql.DB) *PersonRepository {
+	return &PersonRepository{
+		DB: db,
+	}
+}
+
+func (r *PersonRepository) Create(ctx context.Context, person *entity.Person) error {
+	logger.Info("[Repository] Create person started")
+	person.ID = uuid.New().String()
+	person.Relationships = []*entity.Relationship{}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("[Repository] Create person error: ", err)
+		return fmt.Errorf("begin transaction error: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO persons (id, name, gender, level) VALUES (?, ?, ?, ?)",
+		person.ID, person.Name, person.Gender, person.Level,
+	)
+	if err != nil {
+		logger.Error("[Repository] Create person error: ", err)
+		return fmt.Errorf("insert person error: %w", err)
+	}
+
+	if err := insertEvents(ctx, tx, person); err != nil {
+		logger.Error("[Repository] Create person error: ", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("[Repository] Create person error: ", err)
+		return fmt.Errorf("commit transaction error: %w", err)
+	}
+
+	logger.Info("[Repository] Create person finished")
+	return nil
+}
+
+func (r *PersonRepository) Get(ctx context.Context, personID string) (*entity.Person, error) {
+	logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s", personID))
+
+	row := r.DB.QueryRowContext(ctx, "SELECT id, name, gender, level FROM persons WHERE id = ?", personID)
+	p, err := scanPerson(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s not found", personID))
+		return nil, personDomain.ErrNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Get person by personID: %s error", personID), err)
+		return nil, fmt.Errorf("select person error: %w", err)
+	}
+
+	events, err := r.listEvents(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	attachEvents(p, events)
+
+	logger.Info(fmt.Sprintf("[Repository] Get person by personID: %s finished", personID))
+	return p, nil
+}
+
+func (r *PersonRepository) GetByName(ctx context.Context, name string) (*entity.Person, error) {
+	logger.Info(fmt.Sprintf("[Repository] Get person by name: %s", name))
+
+	row := r.DB.QueryRowContext(ctx,
+		"SELECT id, name, gender, level FROM persons WHERE name = ? COLLATE NOCASE ORDER BY rowid LIMIT 1",
+		name,
+	)
+	person, err := scanPerson(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Info(fmt.Sprintf("[Repository] Get person by name: %s not found", name))
+		return nil, personDomain.ErrNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Get person by name: %s error", name), err)
+		return nil, fmt.Errorf("select person error: %w", err)
+	}
+
+	persons, err := r.listPersons(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	relationships, err := r.listRelationships(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachRelationships(person, relationships, persons)
+
+	events, err := r.listEvents(ctx, person.ID)
+	if err != nil {
+		return nil, err
+	}
+	attachEvents(person, events)
+
+	return person, nil
+}
+
+func (r *PersonRepository) List(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
+	logger.Info("[Repository] List person started")
+
+	persons, err := r.listPersons(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.listEvents(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, person := range persons {
+		attachEvents(person, events)
+	}
+
+	logger.Info("[Repository] List person finished")
+	return persons, nil
+}
+
+func (r *PersonRepository) ListWithRelationships(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
+	logger.Info("[Repository] List person with relationships started")
+
+	persons, err := r.listPersons(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Os parentes vinculados podem estar fora do filtro.
+	all := persons
+	if filter != nil {
+		if all, err = r.listPersons(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	relationships, err := r.listRelationships(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.listEvents(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, person := range persons {
+		attachRelationships(person, relationships, all)
+		attachEvents(person, events)
+	}
+
+	return persons, nil
+}
+
+func (r *PersonRepository) Count(ctx context.Context, filter *entity.PersonFilter) (int, error) {
+	where, args := personWhere(filter)
+
+	var total int
+	if err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM persons"+where, args...).Scan(&total); err != nil {
+		logger.Error("[Repository] Count person error: ", err)
+		return 0, fmt.Errorf("count persons error: %w", err)
+	}
+	return total, nil
+}
+
+func (r *PersonRepository) Update(ctx context.Context, personID string, person *entity.Person) error {
+	logger.Info(fmt.Sprintf("[Repository] Update person started by personID: %s", personID))
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update person by personID: %s error", personID), err)
+		return fmt.Errorf("begin transaction error: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE persons SET name = ?, gender = ?, level = ? WHERE id = ?",
+		person.Name, person.Gender, person.Level, personID,
+	)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update person by personID: %s error", personID), err)
+		return fmt.Errorf("update person error: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.Info(fmt.Sprintf("[Repository] Update person by personID: %s not found", personID))
+		return personDomain.ErrNotFound
+	}
+
+	person.ID = personID
+
+	// Eventos nil mantêm os eventos atuais; uma lista (mesmo vazia) os substitui.
+	if person.Events != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM events WHERE person_id = ?", personID); err != nil {
+			logger.Error(fmt.Sprintf("[Repository] Update person by personID: %s error", personID), err)
+			return fmt.Errorf("delete events error: %w", err)
+		}
+		if err := insertEvents(ctx, tx, person); err != nil {
+			logger.Error(fmt.Sprintf("[Repository] Update person by personID: %s error", personID), err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update person by personID: %s error", personID), err)
+		return fmt.Errorf("commit transaction error: %w", err)
+	}
+	return nil
+}
+
+func (r *PersonRepository) Delete(ctx context.Context, personID string, cascade bool) error {
+	logger.Info(fmt.Sprintf("[Repository] Delete person started by personID: %s", personID))
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("begin transaction error: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM persons WHERE id = ?", personID).Scan(&exists)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("select person error: %w", err)
+	}
+
+	if exists == 0 {
+		logger.Info(fmt.Sprintf("[Repository] Delete person by personID: %s not found", personID))
+		return personDomain.ErrNotFound
+	}
+
+	var linked int
+	err = tx.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM relationships WHERE main_person_id = ? OR secunde_person_id = ?",
+		personID, personID,
+	).Scan(&linked)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("count relationships error: %w", err)
+	}
+
+	if linked > 0 && !cascade {
+		logger.Info(fmt.Sprintf("[Repository] Delete person by personID: %s has relationships", personID))
+		return personDomain.ErrHasRelationships
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM persons WHERE id = ?", personID); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("delete person error: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM relationships WHERE main_person_id = ? OR secunde_person_id = ?", personID, personID); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("delete relationships error: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM events WHERE person_id = ?", personID); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("delete events error: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete person by personID: %s error", personID), err)
+		return fmt.Errorf("commit transaction error: %w", err)
+	}
+	return nil
+}
+
+func (r *PersonRepository) listPersons(ctx context.Context, filter *entity.PersonFilter) ([]*entity.Person, error) {
+	where, args := personWhere(filter)
+	query := "SELECT id, name, gender, level FROM persons" + where + personOrderBy(filter)
+	if filter != nil && (filter.Limit > 0 || filter.Offset > 0) {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, database.Limit(filter.Page), filter.Offset)
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("[Repository] List person error: ", err)
+		return nil, fmt.Errorf("select persons error: %w", err)
+	}
+	defer rows.Close()
+
+	var persons []*entity.Person
+	for rows.Next() {
+		p, err := scanPerson(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan person error: %w", err)
+		}
+		persons = append(persons, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select persons error: %w", err)
+	}
+
+	return persons, nil
+}
+
+func (r *PersonRepository) listRelationships(ctx context.Context) ([]entity.Relationship, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+database.RelationshipColumns+" FROM relationships ORDER BY rowid")
+	if err != nil {
+		logger.Error("[Repository] List relationship error: ", err)
+		return nil, fmt.Errorf("select relationships error: %w", err)
+	}
+	defer rows.Close()
+
+	var relationships []entity.Relationship
+	for rows.Next() {
+		rr, err := database.ScanRelationship(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan relationship error: %w", err)
+		}
+		relationships = append(relationships, *rr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select relationships error: %w", err)
+	}
+
+	return relationships, nil
+}
+
+// Lista os eventos da pessoa informada, ou de todas as pessoas quando personID é vazio.
+func (r *PersonRepository) listEvents(ctx context.Context, personID string) ([]*entity.Event, error) {
+	query := "SELECT " + database.PersonEventColumns + " FROM events"
+	args := []any{}
+	if personID != "" {
+		query += " WHERE person_id = ?"
+		args = append(args, personID)
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query+" ORDER BY rowid", args...)
+	if err != nil {
+		logger.Error("[Repository] List event error: ", err)
+		return nil, fmt.Errorf("select events error: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entity.Event
+	for rows.Next() {
+		e, err := database.ScanEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan event error: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select events error: %w", err)
+	}
+
+	return events, nil
+}
+
+// Grava os eventos da pessoa com novos IDs.
+func insertEvents(ctx context.Context, tx *sql.Tx, person *entity.Person) error {
+	for _, e := range person.Events {
+		e.ID = uuid.New().String()
+		e.PersonID = person.ID
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO events ("+database.PersonEventColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+			e.ID, e.PersonID, e.Type, e.Date, e.Place, e.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("insert event error: %w", err)
+		}
+	}
+	return nil
+}
+
+// Vincula à pessoa os eventos gravados para ela.
+func attachEvents(person *entity.Person, events []*entity.Event) {
+	for _, e := range events {
+		if e.PersonID == person.ID {
+			person.Events = append(person.Events, e)
+		}
+	}
+}
+
+// Cláusula WHERE com os filtros da listagem de pessoas.
+func personWhere(filter *entity.PersonFilter) (string, []any) {
+	if filter == nil {
+		return "", nil
+	}
+
+	var conditions []string
+	var args []any
+	if filter.Name != "" {
+		conditions = append(conditions, "name LIKE ? ESCAPE '\\'")
+		args = append(args, "%"+database.EscapeLike(filter.Name)+"%")
+	}
+	if filter.Gender != "" {
+		conditions = append(conditions, "gender = ?")
+		args = append(args, filter.Gender)
+	}
+	if filter.ParentID != "" {
+		conditions = append(conditions, "id IN (SELECT main_person_id FROM relationships WHERE kind = ? AND secunde_person_id = ?)")
+		args = append(args, entity.RelationshipKindParent, filter.ParentID)
+	}
+	if filter.ChildID != "" {
+		conditions = append(conditions, "id IN (SELECT secunde_person_id FROM relationships WHERE kind = ? AND main_person_id = ?)")
+		args = append(args, entity.RelationshipKindParent, filter.ChildID)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func personOrderBy(filter *entity.PersonFilter) string {
+	direction := ""
+	if filter != nil && filter.Order == entity.OrderDesc {
+		direction = " DESC"
+	}
+	if filter != nil && filter.Sort == entity.SortName {
+		return " ORDER BY name COLLATE NOCASE" + direction + ", rowid" + direction
+	}
+	return " ORDER BY rowid" + direction
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPerson(s scanner) (*entity.Person, error) {
+	var p entity.Person
+	if err := s.Scan(&p.ID, &p.Name, &p.Gender, &p.Level); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Vincula à pessoa os relacionamentos de paternidade em que ela é o filho e as uniões de que participa,
+// da mesma forma que o repositório em memória.
+func attachRelationships(person *entity.Person, relationships []entity.Relationship, persons []*entity.Person) {
+	for _, rr := range relationships {
+		switch {
+		case rr.IsParent() && rr.MainPersonID == person.ID:
+			relationship := rr
+			relationship.MainPerson = person
+			relationship.SecundePerson = findByID(persons, rr.SecundePersonID)
+			person.Relationships = append(person.Relationships, &relationship)
+		case rr.IsUnion() && rr.OtherPersonID(person.ID) != "":
+			relationship := rr
+			relationship.MainPerson = findByID(persons, rr.MainPersonID)
+			relationship.SecundePerson = findByID(persons, rr.SecundePersonID)
+			person.Unions = append(person.Unions, &relationship)
+		}
+	}
+}
+
+func findByID(persons []*entity.Person, id string) *entity.Person {
+	for _, p := range persons {
+		if p.ID == id {
+			person := *p
+			person.Relationships = nil
+			person.Unions = nil
+			return &person
+		}
+	}
+	return nil
+}
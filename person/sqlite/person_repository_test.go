@@ -0,0 +1,271 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/person"
+	"github.com/stretchr/testify/suite"
+)
+
+type PersonRepositoryTestSuite struct {
+	suite.Suite
+	DB   *sql.DB
+	Repo *PersonRepository
+}
+
+func (suite *PersonRepositoryTestSuite) SetupTest() {
+	db, err := database.NewSQLite(":memory:")
+	suite.Require().NoError(err)
+	suite.DB = db
+	suite.Repo = NewPersonRepository(db)
+}
+
+func (suite *PersonRepositoryTestSuite) TearDownTest() {
+	suite.DB.Close()
+}
+
+func (suite *PersonRepositoryTestSuite) createPerson(name, gender string) *entity.Person {
+	p := &entity.Person{Name: name, Gender: gender}
+	suite.Require().NoError(suite.Repo.Create(context.Background(), p))
+	return p
+}
+
+func (suite *PersonRepositoryTestSuite) TestCreateAndGet() {
+	ctx := context.Background()
+	suite.Run("should persist the person with a generated ID", func() {
+		p := suite.createPerson("Martin", "M")
+		suite.NotEmpty(p.ID)
+
+		found, err := suite.Repo.Get(ctx, p.ID)
+		suite.Nil(err)
+		suite.Equal("Martin", found.Name)
+		suite.Equal("M", found.Gender)
+	})
+
+	suite.Run("should return error when the person does not exist", func() {
+		found, err := suite.Repo.Get(ctx, "unknown")
+		suite.Nil(found)
+		suite.EqualError(err, "person not found")
+		suite.ErrorIs(err, entity.ErrNotFound)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestGetByName() {
+	ctx := context.Background()
+	martin := suite.createPerson("Martin", "M")
+	phoebe := suite.createPerson("Phoebe", "F")
+	_, err := suite.DB.Exec("INSERT INTO relationships (id, main_person_id, secunde_person_id) VALUES ('r1', ?, ?)", phoebe.ID, martin.ID)
+	suite.Require().NoError(err)
+
+	suite.Run("should find the person ignoring case with relationships", func() {
+		found, err := suite.Repo.GetByName(ctx, "phoebe")
+		suite.Nil(err)
+		suite.Equal(phoebe.ID, found.ID)
+		suite.Len(found.Relationships, 1)
+		suite.Equal(martin.ID, found.Relationships[0].SecundePersonID)
+		suite.Equal("Martin", found.Relationships[0].SecundePerson.Name)
+	})
+
+	suite.Run("should return not found when the name does not exist", func() {
+		found, err := suite.Repo.GetByName(ctx, "Bruce")
+		suite.ErrorIs(err, person.ErrNotFound)
+		suite.Nil(found)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestListWithRelationships() {
+	ctx := context.Background()
+	martin := suite.createPerson("Martin", "M")
+	anastasia := suite.createPerson("Anastasia", "F")
+	phoebe := suite.createPerson("Phoebe", "F")
+	_, err := suite.DB.Exec("INSERT INTO relationships (id, main_person_id, secunde_person_id) VALUES ('r1', ?, ?), ('r2', ?, ?)",
+		phoebe.ID, martin.ID, phoebe.ID, anastasia.ID)
+	suite.Require().NoError(err)
+
+	suite.Run("should return every person with their parent relationships", func() {
+		persons, err := suite.Repo.ListWithRelationships(ctx, nil)
+		suite.Nil(err)
+		suite.Len(persons, 3)
+		suite.Empty(persons[0].Relationships)
+		suite.Len(persons[2].Relationships, 2)
+		suite.Equal(persons[2], persons[2].Relationships[0].MainPerson)
+	})
+
+	suite.Run("should list persons without relationships", func() {
+		persons, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Len(persons, 3)
+		suite.Empty(persons[2].Relationships)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestListFilter() {
+	ctx := context.Background()
+	martin := suite.createPerson("Martin", "M")
+	anastasia := suite.createPerson("Anastasia", "F")
+	phoebe := suite.createPerson("Phoebe", "F")
+	bruce := suite.createPerson("Bruce_", "M")
+	_, err := suite.DB.Exec("INSERT INTO relationships (id, main_person_id, secunde_person_id) VALUES ('r1', ?, ?), ('r2', ?, ?), ('r3', ?, ?)",
+		phoebe.ID, martin.ID, phoebe.ID, anastasia.ID, bruce.ID, phoebe.ID)
+	suite.Require().NoError(err)
+
+	names := func(persons []*entity.Person) []string {
+		var names []string
+		for _, p := range persons {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	suite.Run("should filter by part of the name ignoring case", func() {
+		persons, err := suite.Repo.List(ctx, &entity.PersonFilter{Name: "TA"})
+		suite.Nil(err)
+		suite.Equal([]string{"Anastasia"}, names(persons))
+	})
+
+	suite.Run("should treat like wildcards as literals", func() {
+		persons, err := suite.Repo.List(ctx, &entity.PersonFilter{Name: "_"})
+		suite.Nil(err)
+		suite.Equal([]string{"Bruce_"}, names(persons))
+	})
+
+	suite.Run("should filter by gender", func() {
+		persons, err := suite.Repo.List(ctx, &entity.PersonFilter{Gender: "F"})
+		suite.Nil(err)
+		suite.Equal([]string{"Anastasia", "Phoebe"}, names(persons))
+	})
+
+	suite.Run("should filter by parent and by child", func() {
+		children, err := suite.Repo.List(ctx, &entity.PersonFilter{ParentID: martin.ID})
+		suite.Nil(err)
+		suite.Equal([]string{"Phoebe"}, names(children))
+
+		parents, err := suite.Repo.List(ctx, &entity.PersonFilter{ChildID: phoebe.ID, Sort: entity.SortName})
+		suite.Nil(err)
+		suite.Equal([]string{"Anastasia", "Martin"}, names(parents))
+	})
+
+	suite.Run("should sort and paginate", func() {
+		filter := &entity.PersonFilter{Sort: entity.SortName, Order: entity.OrderDesc, Page: entity.Page{Offset: 1, Limit: 2}}
+		persons, err := suite.Repo.List(ctx, filter)
+		suite.Nil(err)
+		suite.Equal([]string{"Martin", "Bruce_"}, names(persons))
+
+		total, err := suite.Repo.Count(ctx, filter)
+		suite.Nil(err)
+		suite.Equal(4, total)
+	})
+
+	suite.Run("should attach relatives outside the filter", func() {
+		persons, err := suite.Repo.ListWithRelationships(ctx, &entity.PersonFilter{Name: "phoebe"})
+		suite.Nil(err)
+		suite.Len(persons, 1)
+		suite.Len(persons[0].Relationships, 2)
+		suite.Equal("Martin", persons[0].Relationships[0].SecundePerson.Name)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestUpdateAndDelete() {
+	ctx := context.Background()
+	p := suite.createPerson("Martin", "M")
+
+	suite.Run("should update the person", func() {
+		err := suite.Repo.Update(ctx, p.ID, &entity.Person{Name: "Martina", Gender: "F"})
+		suite.Nil(err)
+		found, err := suite.Repo.Get(ctx, p.ID)
+		suite.Nil(err)
+		suite.Equal("Martina", found.Name)
+		suite.Equal("F", found.Gender)
+	})
+
+	suite.Run("should delete the person", func() {
+		suite.Nil(suite.Repo.Delete(ctx, p.ID, false))
+		found, err := suite.Repo.Get(ctx, p.ID)
+		suite.NotNil(err)
+		suite.Nil(found)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestDeleteWithRelationships() {
+	ctx := context.Background()
+	martin := suite.createPerson("Martin", "M")
+	phoebe := suite.createPerson("Phoebe", "F")
+	_, err := suite.DB.Exec("INSERT INTO relationships (id, main_person_id, secunde_person_id) VALUES ('r1', ?, ?)", phoebe.ID, martin.ID)
+	suite.Require().NoError(err)
+
+	suite.Run("should refuse to delete a person with relationships", func() {
+		suite.ErrorIs(suite.Repo.Delete(ctx, martin.ID, false), person.ErrHasRelationships)
+		found, err := suite.Repo.Get(ctx, martin.ID)
+		suite.Nil(err)
+		suite.Equal("Martin", found.Name)
+	})
+
+	suite.Run("should return not found for a missing person with dangling relationships", func() {
+		_, err := suite.DB.Exec("INSERT INTO relationships (id, main_person_id, secunde_person_id) VALUES ('r2', ?, 'missing')", phoebe.ID)
+		suite.Require().NoError(err)
+		suite.ErrorIs(suite.Repo.Delete(ctx, "missing", false), person.ErrNotFound)
+		_, err = suite.DB.Exec("DELETE FROM relationships WHERE id = 'r2'")
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("should delete the relationships on cascade", func() {
+		suite.Nil(suite.Repo.Delete(ctx, martin.ID, true))
+		found, err := suite.Repo.Get(ctx, phoebe.ID)
+		suite.Nil(err)
+		suite.Empty(found.Relationships)
+
+		var total int
+		suite.Require().NoError(suite.DB.QueryRow("SELECT COUNT(*) FROM relationships").Scan(&total))
+		suite.Zero(total)
+	})
+}
+
+func (suite *PersonRepositoryTestSuite) TestEvents() {
+	ctx := context.Background()
+	martin := &entity.Person{Name: "Martin", Gender: "M", Events: []*entity.Event{
+		{Type: entity.EventBirth, Date: "BET 1890 AND 1892", Place: "Recife"},
+		{Type: entity.EventCustom, Date: "1910", Description: "Graduation"},
+	}}
+	suite.Require().NoError(suite.Repo.Create(ctx, martin))
+
+	suite.Run("should store the events of the person", func() {
+		found, err := suite.Repo.Get(ctx, martin.ID)
+		suite.Nil(err)
+		suite.Len(found.Events, 2)
+		suite.Equal(martin.ID, found.Events[0].PersonID)
+		suite.Equal("BET 1890 AND 1892", found.Event(entity.EventBirth).Date)
+		suite.Equal("Graduation", found.Event(entity.EventCustom).Description)
+	})
+
+	suite.Run("should keep the events when the update has none", func() {
+		suite.Nil(suite.Repo.Update(ctx, martin.ID, &entity.Person{Name: "Martin", Gender: "M"}))
+		found, err := suite.Repo.GetByName(ctx, "Martin")
+		suite.Nil(err)
+		suite.Len(found.Events, 2)
+	})
+
+	suite.Run("should replace the events on update", func() {
+		suite.Nil(suite.Repo.Update(ctx, martin.ID, &entity.Person{Name: "Martin", Gender: "M", Events: []*entity.Event{
+			{Type: entity.EventDeath, Date: "1950"},
+		}}))
+		persons, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Len(persons[0].Events, 1)
+		suite.Equal(entity.EventDeath, persons[0].Events[0].Type)
+	})
+
+	suite.Run("should delete the events with the person", func() {
+		suite.Nil(suite.Repo.Delete(ctx, martin.ID, false))
+		events, err := suite.Repo.listEvents(ctx, "")
+		suite.Nil(err)
+		suite.Empty(events)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(PersonRepositoryTestSuite))
+}
@@ -0,0 +1,110 @@
+// Package chart desenha árvores genealógicas em DOT (Graphviz) e em SVG, sem dependências externas.
+package chart
+
+// Tipos de gráfico: família completa, ancestrais (pedigree) ou descendentes da pessoa principal.
+type Kind string
+
+const (
+	KindFamily      Kind = ""
+	KindPedigree    Kind = "pedigree"
+	KindDescendants Kind = "descendants"
+)
+
+func (k Kind) Valid() bool {
+	switch k {
+	case KindFamily, KindPedigree, KindDescendants:
+		return true
+	}
+	return false
+}
+
+// Tipos de ligação entre duas pessoas.
+const (
+	EdgeParent = "parent"
+	EdgeUnion  = "union"
+)
+
+// Pessoa do gráfico. Relation é o parentesco com a pessoa principal (Father, Cousin...).
+type Node struct {
+	ID       string
+	Name     string
+	Relation string
+	Gender   string
+}
+
+// Ligação entre duas pessoas. Em EdgeParent, From é o pai ou a mãe e To é o filho.
+type Edge struct {
+	From string
+	To   string
+	Kind string
+}
+
+type Graph struct {
+	Root  string
+	Nodes []Node
+	Edges []Edge
+}
+
+func (g *Graph) node(id string) *Node {
+	for i := range g.Nodes {
+		if g.Nodes[i].ID == id {
+			return &g.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// Restringe o gráfico aos ancestrais ou descendentes da pessoa principal. Em KindFamily o
+// gráfico é retornado sem alteração.
+func (g *Graph) Chart(kind Kind) *Graph {
+	if g == nil || kind == KindFamily {
+		return g
+	}
+
+	up := kind == KindPedigree
+	included := map[string]bool{g.Root: true}
+	queue := []string{g.Root}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, e := range g.Edges {
+			if e.Kind != EdgeParent {
+				continue
+			}
+			next := ""
+			if up && e.To == id {
+				next = e.From
+			} else if !up && e.From == id {
+				next = e.To
+			}
+			if next != "" && !included[next] {
+				included[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	chart := &Graph{Root: g.Root}
+	for _, n := range g.Nodes {
+		if included[n.ID] {
+			chart.Nodes = append(chart.Nodes, n)
+		}
+	}
+	for _, e := range g.Edges {
+		if e.Kind == EdgeParent && included[e.From] && included[e.To] {
+			chart.Edges = append(chart.Edges, e)
+		}
+	}
+	return chart
+}
+
+// Cor de preenchimento de cada pessoa de acordo com o sexo.
+func fillColor(gender string) string {
+	switch gender {
+	case "M":
+		return "#a6cee3"
+	case "F":
+		return "#fbb4c4"
+	}
+	return "#e0e0e0"
+}
@@ -0,0 +1,62 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChartTestSuite struct {
+	suite.Suite
+	Graph *Graph
+}
+
+// John e Mary são pais de Paul, que é casado com Anne e pai de Lucy.
+func (suite *ChartTestSuite) SetupTest() {
+	suite.Graph = &Graph{
+		Root: "paul",
+		Nodes: []Node{
+			{ID: "lucy", Name: "Lucy", Relation: "Daughter", Gender: "F"},
+			{ID: "paul", Name: "Paul", Relation: "Root", Gender: "M"},
+			{ID: "anne", Name: "Anne", Relation: "Wife", Gender: "F"},
+			{ID: "john", Name: "John", Relation: "Father", Gender: "M"},
+			{ID: "mary", Name: "Mary", Relation: "Mother", Gender: "F"},
+		},
+		Edges: []Edge{
+			{From: "john", To: "paul", Kind: EdgeParent},
+			{From: "mary", To: "paul", Kind: EdgeParent},
+			{From: "paul", To: "lucy", Kind: EdgeParent},
+			{From: "paul", To: "anne", Kind: EdgeUnion},
+		},
+	}
+}
+
+func (suite *ChartTestSuite) TestKindValid() {
+	suite.True(KindFamily.Valid())
+	suite.True(KindPedigree.Valid())
+	suite.True(KindDescendants.Valid())
+	suite.False(Kind("fan").Valid())
+}
+
+func (suite *ChartTestSuite) TestChart() {
+	suite.Run("should keep the whole family", func() {
+		suite.Equal(suite.Graph, suite.Graph.Chart(KindFamily))
+	})
+
+	suite.Run("should keep only the ancestors in a pedigree chart", func() {
+		chart := suite.Graph.Chart(KindPedigree)
+		suite.Equal("paul", chart.Root)
+		suite.Equal([]Node{suite.Graph.Nodes[1], suite.Graph.Nodes[3], suite.Graph.Nodes[4]}, chart.Nodes)
+		suite.Equal(suite.Graph.Edges[:2], chart.Edges)
+	})
+
+	suite.Run("should keep only the descendants in a descendant chart", func() {
+		chart := suite.Graph.Chart(KindDescendants)
+		suite.Equal([]Node{suite.Graph.Nodes[0], suite.Graph.Nodes[1]}, chart.Nodes)
+		suite.Equal([]Edge{suite.Graph.Edges[2]}, chart.Edges)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ChartTestSuite))
+}
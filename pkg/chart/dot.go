@@ -0,0 +1,42 @@
+package chart
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Escreve o gráfico na linguagem DOT do Graphviz. Pais apontam para os filhos e uniões são
+// tracejadas, sem influenciar a hierarquia.
+func DOT(w io.Writer, g *Graph) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph familytree {")
+	fmt.Fprintln(bw, "\trankdir=TB;")
+	fmt.Fprintln(bw, `	node [shape=box, style="rounded,filled", fontname="Helvetica"];`)
+	for _, n := range g.Nodes {
+		attrs := fmt.Sprintf("label=%s, fillcolor=%s", quote(n.Name+"\n"+n.Relation), quote(fillColor(n.Gender)))
+		if n.ID == g.Root {
+			attrs += ", penwidth=2"
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", quote(n.ID), attrs)
+	}
+	for _, e := range g.Edges {
+		switch e.Kind {
+		case EdgeUnion:
+			fmt.Fprintf(bw, "\t%s -> %s [style=dashed, dir=none, constraint=false];\n", quote(e.From), quote(e.To))
+		default:
+			fmt.Fprintf(bw, "\t%s -> %s;\n", quote(e.From), quote(e.To))
+		}
+	}
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// Gera uma string DOT entre aspas, escapando aspas, barras e quebras de linha.
+func quote(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+	return `"` + value + `"`
+}
@@ -0,0 +1,32 @@
+package chart
+
+import "bytes"
+
+func (suite *ChartTestSuite) TestDOT() {
+	suite.Run("should write nodes coloured by gender and parent edges", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(DOT(&buf, suite.Graph.Chart(KindPedigree)))
+		suite.Equal(`digraph familytree {
+	rankdir=TB;
+	node [shape=box, style="rounded,filled", fontname="Helvetica"];
+	"paul" [label="Paul\nRoot", fillcolor="#a6cee3", penwidth=2];
+	"john" [label="John\nFather", fillcolor="#a6cee3"];
+	"mary" [label="Mary\nMother", fillcolor="#fbb4c4"];
+	"john" -> "paul";
+	"mary" -> "paul";
+}
+`, buf.String())
+	})
+
+	suite.Run("should draw unions without affecting the ranks", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(DOT(&buf, suite.Graph))
+		suite.Contains(buf.String(), `"paul" -> "anne" [style=dashed, dir=none, constraint=false];`)
+	})
+
+	suite.Run("should escape quotes", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(DOT(&buf, &Graph{Nodes: []Node{{ID: "1", Name: `Robert "Bob"`, Relation: "Root"}}}))
+		suite.Contains(buf.String(), `"1" [label="Robert \"Bob\"\nRoot", fillcolor="#e0e0e0"];`)
+	})
+}
@@ -0,0 +1,264 @@
+package chart
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+)
+
+// Dimensões do desenho em pixels.
+const (
+	boxWidth      = 160
+	boxHeight     = 48
+	gapX          = 24
+	gapY          = 56
+	margin        = 16
+	maxNameLength = 22
+)
+
+type point struct {
+	x, y int
+}
+
+// Escreve o gráfico em SVG. As pessoas são organizadas em linhas por geração, com os pais acima
+// dos filhos, e a ordem em cada linha aproxima as pessoas dos seus pais e filhos.
+func SVG(w io.Writer, g *Graph) error {
+	rows := layoutRows(g)
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	width := max(columns*(boxWidth+gapX)-gapX, 0) + 2*margin
+	height := max(len(rows)*(boxHeight+gapY)-gapY, 0) + 2*margin
+
+	positions := map[string]point{}
+	for r, row := range rows {
+		offset := (columns - len(row)) * (boxWidth + gapX) / 2
+		for c, id := range row {
+			positions[id] = point{
+				x: margin + offset + c*(boxWidth+gapX),
+				y: margin + r*(boxHeight+gapY),
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif">`+"\n",
+		width, height, width, height)
+
+	for _, e := range g.Edges {
+		from, okFrom := positions[e.From]
+		to, okTo := positions[e.To]
+		if !okFrom || !okTo {
+			continue
+		}
+		writeEdge(bw, e, from, to)
+	}
+
+	for _, n := range g.Nodes {
+		p, ok := positions[n.ID]
+		if !ok {
+			continue
+		}
+		writeNode(bw, n, p, n.ID == g.Root)
+	}
+
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+func writeEdge(w io.Writer, e Edge, from, to point) {
+	if e.Kind == EdgeUnion {
+		if from.x > to.x {
+			from, to = to, from
+		}
+		if from.y == to.y {
+			fmt.Fprintf(w, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#555" stroke-dasharray="4 3"/>`+"\n",
+				from.x+boxWidth, from.y+boxHeight/2, to.x, to.y+boxHeight/2)
+			return
+		}
+		fmt.Fprintf(w, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#555" stroke-dasharray="4 3"/>`+"\n",
+			from.x+boxWidth/2, from.y+boxHeight/2, to.x+boxWidth/2, to.y+boxHeight/2)
+		return
+	}
+
+	x1, y1 := from.x+boxWidth/2, from.y+boxHeight
+	x2, y2 := to.x+boxWidth/2, to.y
+	if y2 <= y1 {
+		fmt.Fprintf(w, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#555"/>`+"\n", x1, y1, x2, y2)
+		return
+	}
+	middle := y2 - gapY/2
+	fmt.Fprintf(w, `<path d="M %d %d V %d H %d V %d" fill="none" stroke="#555"/>`+"\n", x1, y1, middle, x2, y2)
+}
+
+func writeNode(w io.Writer, n Node, p point, root bool) {
+	strokeWidth := 1
+	if root {
+		strokeWidth = 3
+	}
+	fmt.Fprintf(w, `<g><title>%s</title>`, html.EscapeString(n.Name))
+	fmt.Fprintf(w, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="#333" stroke-width="%d"/>`,
+		p.x, p.y, boxWidth, boxHeight, fillColor(n.Gender), strokeWidth)
+	fmt.Fprintf(w, `<text x="%d" y="%d" text-anchor="middle" font-size="13">%s</text>`,
+		p.x+boxWidth/2, p.y+20, html.EscapeString(truncate(n.Name)))
+	fmt.Fprintf(w, `<text x="%d" y="%d" text-anchor="middle" font-size="11" fill="#333">%s</text>`,
+		p.x+boxWidth/2, p.y+38, html.EscapeString(truncate(n.Relation)))
+	fmt.Fprintln(w, "</g>")
+}
+
+func truncate(value string) string {
+	runes := []rune(value)
+	if len(runes) <= maxNameLength {
+		return value
+	}
+	return string(runes[:maxNameLength-1]) + "…"
+}
+
+// Organiza as pessoas em linhas, da geração mais antiga para a mais nova.
+func layoutRows(g *Graph) [][]string {
+	generation := generations(g)
+
+	var levels []int
+	byLevel := map[int][]string{}
+	for _, n := range g.Nodes {
+		level := generation[n.ID]
+		if _, ok := byLevel[level]; !ok {
+			levels = append(levels, level)
+		}
+		byLevel[level] = append(byLevel[level], n.ID)
+	}
+	sort.Ints(levels)
+
+	rows := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		rows = append(rows, byLevel[level])
+	}
+
+	// Algumas passadas alternando de cima para baixo e de baixo para cima já reduzem os cruzamentos.
+	for pass := 0; pass < 4; pass++ {
+		if pass%2 == 0 {
+			for r := 1; r < len(rows); r++ {
+				orderByNeighbors(g, rows[r], rows[r-1])
+			}
+		} else {
+			for r := len(rows) - 2; r >= 0; r-- {
+				orderByNeighbors(g, rows[r], rows[r+1])
+			}
+		}
+	}
+	for _, row := range rows {
+		keepUnionsTogether(g, row)
+	}
+	return rows
+}
+
+// Geração de cada pessoa em relação à principal: pais ficam uma geração acima, filhos uma
+// abaixo e cônjuges na mesma. Pessoas sem ligação com a principal começam na geração 0.
+func generations(g *Graph) map[string]int {
+	generation := map[string]int{}
+	starts := []string{g.Root}
+	for _, n := range g.Nodes {
+		starts = append(starts, n.ID)
+	}
+
+	for _, start := range starts {
+		if _, ok := generation[start]; ok || g.node(start) == nil {
+			continue
+		}
+		generation[start] = 0
+		queue := []string{start}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, e := range g.Edges {
+				next, delta := "", 0
+				switch id {
+				case e.From:
+					next = e.To
+					if e.Kind == EdgeParent {
+						delta = 1
+					}
+				case e.To:
+					next = e.From
+					if e.Kind == EdgeParent {
+						delta = -1
+					}
+				default:
+					continue
+				}
+				if _, ok := generation[next]; ok || g.node(next) == nil {
+					continue
+				}
+				generation[next] = generation[id] + delta
+				queue = append(queue, next)
+			}
+		}
+	}
+	return generation
+}
+
+// Ordena a linha pela posição média dos pais ou filhos na linha vizinha.
+func orderByNeighbors(g *Graph, row, neighbors []string) {
+	index := map[string]int{}
+	for i, id := range neighbors {
+		index[id] = i
+	}
+
+	weight := map[string]float64{}
+	for i, id := range row {
+		sum, count := 0, 0
+		for _, e := range g.Edges {
+			if e.Kind != EdgeParent {
+				continue
+			}
+			other := ""
+			if e.From == id {
+				other = e.To
+			} else if e.To == id {
+				other = e.From
+			}
+			if j, ok := index[other]; ok {
+				sum += j
+				count++
+			}
+		}
+		weight[id] = float64(i)
+		if count > 0 {
+			weight[id] = float64(sum) / float64(count)
+		}
+	}
+
+	sort.SliceStable(row, func(i, j int) bool { return weight[row[i]] < weight[row[j]] })
+}
+
+// Aproxima cada pessoa do cônjuge quando estão na mesma linha, movendo a primeira para a
+// esquerda do cônjuge para não separar o cônjuge dos seus irmãos.
+func keepUnionsTogether(g *Graph, row []string) {
+	moves := 0
+	for i := 0; i < len(row) && moves < len(row)*len(row); i++ {
+		for j := i + 2; j < len(row); j++ {
+			if !united(g, row[i], row[j]) {
+				continue
+			}
+			id := row[i]
+			copy(row[i:j-1], row[i+1:j])
+			row[j-1] = id
+			moves++
+			i--
+			break
+		}
+	}
+}
+
+func united(g *Graph, a, b string) bool {
+	for _, e := range g.Edges {
+		if e.Kind == EdgeUnion && ((e.From == a && e.To == b) || (e.From == b && e.To == a)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+func (suite *ChartTestSuite) TestLayoutRows() {
+	suite.Run("should place each generation in a row", func() {
+		suite.Equal([][]string{{"john", "mary"}, {"paul", "anne"}, {"lucy"}}, layoutRows(suite.Graph))
+	})
+
+	suite.Run("should keep partners and siblings close", func() {
+		g := &Graph{
+			Root: "a",
+			Nodes: []Node{
+				{ID: "a"}, {ID: "x"}, {ID: "b"}, {ID: "p1"}, {ID: "p2"}, {ID: "q1"}, {ID: "q2"},
+			},
+			Edges: []Edge{
+				{From: "q1", To: "x", Kind: EdgeParent},
+				{From: "q2", To: "x", Kind: EdgeParent},
+				{From: "p1", To: "a", Kind: EdgeParent},
+				{From: "p2", To: "a", Kind: EdgeParent},
+				{From: "p1", To: "b", Kind: EdgeParent},
+				{From: "p2", To: "b", Kind: EdgeParent},
+				{From: "a", To: "x", Kind: EdgeUnion},
+			},
+		}
+		rows := layoutRows(g)
+		suite.Require().Len(rows, 2)
+		suite.Equal([]string{"p1", "p2", "q1", "q2"}, rows[0])
+		suite.Equal([]string{"b", "a", "x"}, rows[1])
+	})
+}
+
+func (suite *ChartTestSuite) TestSVG() {
+	suite.Run("should write a valid SVG document", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(SVG(&buf, suite.Graph))
+		svg := buf.String()
+
+		decoder := xml.NewDecoder(strings.NewReader(svg))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			suite.Require().NoError(err)
+		}
+
+		suite.True(strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="376" height="288" viewBox="0 0 376 288"`))
+		suite.Equal(5, strings.Count(svg, "<rect"))
+		suite.Equal(3, strings.Count(svg, "<path"))
+		suite.Contains(svg, `stroke-dasharray="4 3"`)
+		suite.Contains(svg, `<rect x="16" y="120" width="160" height="48" rx="6" fill="#a6cee3" stroke="#333" stroke-width="3"/>`)
+	})
+
+	suite.Run("should escape names", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(SVG(&buf, &Graph{Nodes: []Node{{ID: "1", Name: "Ana <Maria> & Cia"}}}))
+		suite.Contains(buf.String(), "Ana &lt;Maria&gt; &amp; Cia")
+	})
+
+	suite.Run("should write an empty chart", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(SVG(&buf, &Graph{}))
+		suite.Contains(buf.String(), `width="32" height="32"`)
+	})
+}
+
+func (suite *ChartTestSuite) TestTruncate() {
+	suite.Equal("Ana", truncate("Ana"))
+	suite.Equal(strings.Repeat("a", 21)+"…", truncate(strings.Repeat("a", 30)))
+}
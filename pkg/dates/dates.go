@@ -0,0 +1,243 @@
+// Package dates interpreta datas genealógicas: datas parciais (só ano ou ano e mês), aproximadas
+// (ABT, CAL, EST), limites (BEF, AFT) e períodos (BET ... AND ..., FROM ... TO ...), no formato
+// ISO (1990-05-12) ou do GEDCOM (12 MAY 1990).
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Qualificadores no padrão do GEDCOM.
+const (
+	QualifierExact      = ""
+	QualifierAbout      = "ABT"
+	QualifierCalculated = "CAL"
+	QualifierEstimated  = "EST"
+	QualifierBefore     = "BEF"
+	QualifierAfter      = "AFT"
+	QualifierBetween    = "BET"
+	QualifierFrom       = "FROM"
+	QualifierTo         = "TO"
+)
+
+var months = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+var isoDate = regexp.MustCompile(`^(\d{1,4})(?:-(\d{2})(?:-(\d{2}))?)?$`)
+
+var ErrInvalidDate = errors.New("invalid date")
+
+// Data com precisão de ano, mês ou dia. Month e Day são 0 quando desconhecidos.
+type PartialDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// Data genealógica. End só é preenchido nos períodos (BET e FROM com TO).
+type Date struct {
+	Qualifier string
+	Start     PartialDate
+	End       *PartialDate
+}
+
+// Interpreta a data, aceitando as partes no formato ISO ou do GEDCOM.
+func Parse(value string) (Date, error) {
+	tokens := strings.Fields(strings.ToUpper(value))
+	if len(tokens) == 0 {
+		return Date{}, fmt.Errorf("%w: empty", ErrInvalidDate)
+	}
+
+	d := Date{}
+	switch tokens[0] {
+	case QualifierAbout, QualifierCalculated, QualifierEstimated, QualifierBefore, QualifierAfter, QualifierTo:
+		d.Qualifier = tokens[0]
+		tokens = tokens[1:]
+	case QualifierBetween, QualifierFrom:
+		d.Qualifier = tokens[0]
+		tokens = tokens[1:]
+		separator := "AND"
+		if d.Qualifier == QualifierFrom {
+			separator = QualifierTo
+		}
+		if i := indexOf(tokens, separator); i >= 0 {
+			end, err := parsePartial(tokens[i+1:])
+			if err != nil {
+				return Date{}, fmt.Errorf("%w: %q", ErrInvalidDate, value)
+			}
+			d.End = &end
+			tokens = tokens[:i]
+		} else if d.Qualifier == QualifierBetween {
+			return Date{}, fmt.Errorf("%w: %q should be BET date AND date", ErrInvalidDate, value)
+		}
+	}
+
+	start, err := parsePartial(tokens)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: %q", ErrInvalidDate, value)
+	}
+	d.Start = start
+
+	if d.End != nil && d.End.first().Before(d.Start.first()) {
+		return Date{}, fmt.Errorf("%w: %q ends before it starts", ErrInvalidDate, value)
+	}
+	return d, nil
+}
+
+// Verifica se o valor é uma data válida.
+func Valid(value string) bool {
+	_, err := Parse(value)
+	return err == nil
+}
+
+// Data no formato da API, com as partes em ISO (ex.: ABT 1890, BET 1900-05 AND 1905).
+func (d Date) String() string {
+	return d.format(PartialDate.ISO)
+}
+
+// Data no formato do GEDCOM (ex.: ABT 1890, BET MAY 1900 AND 1905).
+func (d Date) GEDCOM() string {
+	return d.format(PartialDate.GEDCOM)
+}
+
+func (d Date) format(part func(PartialDate) string) string {
+	text := part(d.Start)
+	if d.Qualifier != QualifierExact {
+		text = d.Qualifier + " " + text
+	}
+	if d.End != nil {
+		separator := "AND"
+		if d.Qualifier == QualifierFrom {
+			separator = QualifierTo
+		}
+		text += " " + separator + " " + part(*d.End)
+	}
+	return text
+}
+
+// Indica se a data é aproximada (ABT, CAL ou EST).
+func (d Date) Approximate() bool {
+	switch d.Qualifier {
+	case QualifierAbout, QualifierCalculated, QualifierEstimated:
+		return true
+	}
+	return false
+}
+
+// Primeiro dia possível da data. Datas aproximadas e BEF não têm limite inferior confiável.
+func (d Date) Earliest() (time.Time, bool) {
+	switch {
+	case d.Approximate(), d.Qualifier == QualifierBefore, d.Qualifier == QualifierTo:
+		return time.Time{}, false
+	case d.Qualifier == QualifierAfter:
+		return d.Start.last().AddDate(0, 0, 1), true
+	}
+	return d.Start.first(), true
+}
+
+// Último dia possível da data. Datas aproximadas, AFT e FROM sem TO não têm limite superior confiável.
+func (d Date) Latest() (time.Time, bool) {
+	switch {
+	case d.Approximate(), d.Qualifier == QualifierAfter:
+		return time.Time{}, false
+	case d.Qualifier == QualifierBefore:
+		return d.Start.first().AddDate(0, 0, -1), true
+	case d.End != nil:
+		return d.End.last(), true
+	case d.Qualifier == QualifierFrom:
+		return time.Time{}, false
+	}
+	return d.Start.last(), true
+}
+
+// Parte no formato ISO (1990, 1990-05 ou 1990-05-12).
+func (p PartialDate) ISO() string {
+	switch {
+	case p.Day > 0:
+		return fmt.Sprintf("%04d-%02d-%02d", p.Year, p.Month, p.Day)
+	case p.Month > 0:
+		return fmt.Sprintf("%04d-%02d", p.Year, p.Month)
+	}
+	return fmt.Sprintf("%04d", p.Year)
+}
+
+// Parte no formato do GEDCOM (1990, MAY 1990 ou 12 MAY 1990).
+func (p PartialDate) GEDCOM() string {
+	switch {
+	case p.Day > 0:
+		return fmt.Sprintf("%d %s %d", p.Day, months[p.Month-1], p.Year)
+	case p.Month > 0:
+		return fmt.Sprintf("%s %d", months[p.Month-1], p.Year)
+	}
+	return strconv.Itoa(p.Year)
+}
+
+func (p PartialDate) first() time.Time {
+	return time.Date(p.Year, time.Month(max(p.Month, 1)), max(p.Day, 1), 0, 0, 0, 0, time.UTC)
+}
+
+func (p PartialDate) last() time.Time {
+	switch {
+	case p.Day > 0:
+		return p.first()
+	case p.Month > 0:
+		return p.first().AddDate(0, 1, -1)
+	}
+	return p.first().AddDate(1, 0, -1)
+}
+
+func parsePartial(tokens []string) (PartialDate, error) {
+	var p PartialDate
+	switch len(tokens) {
+	case 1:
+		m := isoDate.FindStringSubmatch(tokens[0])
+		if m == nil {
+			return p, ErrInvalidDate
+		}
+		p.Year, _ = strconv.Atoi(m[1])
+		p.Month, _ = strconv.Atoi(m[2])
+		p.Day, _ = strconv.Atoi(m[3])
+		if m[2] != "" && p.Month == 0 || m[3] != "" && p.Day == 0 {
+			return p, ErrInvalidDate
+		}
+	case 2, 3:
+		year, err := strconv.Atoi(tokens[len(tokens)-1])
+		if err != nil {
+			return p, ErrInvalidDate
+		}
+		p.Year = year
+		p.Month = indexOf(months, tokens[len(tokens)-2]) + 1
+		if p.Month == 0 {
+			return p, ErrInvalidDate
+		}
+		if len(tokens) == 3 {
+			if p.Day, err = strconv.Atoi(tokens[0]); err != nil || p.Day == 0 {
+				return p, ErrInvalidDate
+			}
+		}
+	default:
+		return p, ErrInvalidDate
+	}
+
+	if p.Year < 1 || p.Year > 9999 || p.Month > 12 {
+		return p, ErrInvalidDate
+	}
+	// Rejeita dias que não existem no mês, como 31 de abril.
+	if p.Day > 0 && p.first().Day() != p.Day {
+		return p, ErrInvalidDate
+	}
+	return p, nil
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
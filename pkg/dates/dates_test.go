@@ -0,0 +1,89 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DatesTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DatesTestSuite) TestParse() {
+	tests := []struct {
+		value    string
+		expected Date
+		iso      string
+		gedcom   string
+	}{
+		{"1990-05-12", Date{Start: PartialDate{1990, 5, 12}}, "1990-05-12", "12 MAY 1990"},
+		{"12 may 1990", Date{Start: PartialDate{1990, 5, 12}}, "1990-05-12", "12 MAY 1990"},
+		{"1990-05", Date{Start: PartialDate{1990, 5, 0}}, "1990-05", "MAY 1990"},
+		{"1890", Date{Start: PartialDate{1890, 0, 0}}, "1890", "1890"},
+		{"ABT 1890", Date{Qualifier: QualifierAbout, Start: PartialDate{1890, 0, 0}}, "ABT 1890", "ABT 1890"},
+		{"est  JAN 1890", Date{Qualifier: QualifierEstimated, Start: PartialDate{1890, 1, 0}}, "EST 1890-01", "EST JAN 1890"},
+		{"BEF 1900-02-03", Date{Qualifier: QualifierBefore, Start: PartialDate{1900, 2, 3}}, "BEF 1900-02-03", "BEF 3 FEB 1900"},
+		{"BET 1900 AND 1905", Date{Qualifier: QualifierBetween, Start: PartialDate{1900, 0, 0}, End: &PartialDate{1905, 0, 0}}, "BET 1900 AND 1905", "BET 1900 AND 1905"},
+		{"FROM 1900 TO 1905-06", Date{Qualifier: QualifierFrom, Start: PartialDate{1900, 0, 0}, End: &PartialDate{1905, 6, 0}}, "FROM 1900 TO 1905-06", "FROM 1900 TO JUN 1905"},
+		{"FROM 1900", Date{Qualifier: QualifierFrom, Start: PartialDate{1900, 0, 0}}, "FROM 1900", "FROM 1900"},
+		{"TO 1905", Date{Qualifier: QualifierTo, Start: PartialDate{1905, 0, 0}}, "TO 1905", "TO 1905"},
+		{"890", Date{Start: PartialDate{890, 0, 0}}, "0890", "890"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.value, func() {
+			d, err := Parse(tt.value)
+			suite.Require().NoError(err)
+			suite.Equal(tt.expected, d)
+			suite.Equal(tt.iso, d.String())
+			suite.Equal(tt.gedcom, d.GEDCOM())
+		})
+	}
+
+	for _, value := range []string{"", "yesterday", "1990-13", "1990-02-30", "31 APR 1990", "BET 1900", "BET 1905 AND 1900", "ABT", "1990-5-1", "0", "MAI 1990"} {
+		suite.Run("invalid "+value, func() {
+			_, err := Parse(value)
+			suite.ErrorIs(err, ErrInvalidDate)
+			suite.False(Valid(value))
+		})
+	}
+}
+
+func (suite *DatesTestSuite) TestBounds() {
+	day := func(year, month, d int) time.Time { return time.Date(year, time.Month(month), d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		value            string
+		earliest, latest time.Time
+		hasEarliest      bool
+		hasLatest        bool
+	}{
+		{"1990", day(1990, 1, 1), day(1990, 12, 31), true, true},
+		{"1990-02", day(1990, 2, 1), day(1990, 2, 28), true, true},
+		{"1990-02-10", day(1990, 2, 10), day(1990, 2, 10), true, true},
+		{"ABT 1990", time.Time{}, time.Time{}, false, false},
+		{"BEF 1990", time.Time{}, day(1989, 12, 31), false, true},
+		{"AFT 1990", day(1991, 1, 1), time.Time{}, true, false},
+		{"BET 1900 AND 1905", day(1900, 1, 1), day(1905, 12, 31), true, true},
+		{"FROM 1900", day(1900, 1, 1), time.Time{}, true, false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.value, func() {
+			d, err := Parse(tt.value)
+			suite.Require().NoError(err)
+			earliest, ok := d.Earliest()
+			suite.Equal(tt.hasEarliest, ok)
+			suite.Equal(tt.earliest, earliest)
+			latest, ok := d.Latest()
+			suite.Equal(tt.hasLatest, ok)
+			suite.Equal(tt.latest, latest)
+		})
+	}
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(DatesTestSuite))
+}
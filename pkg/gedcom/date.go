@@ -0,0 +1,21 @@
+package gedcom
+
+import "github.com/GeovaneCavalcante/tree-genealogical/pkg/dates"
+
+// Converte uma data da API (1990-05-12, ABT 1890, BET 1900 AND 1905-06) para o formato do GEDCOM
+// (12 MAY 1990, ABT 1890, BET 1900 AND JUN 1905). Valores que não são datas são retornados sem alteração.
+func FormatDate(value string) string {
+	if d, err := dates.Parse(value); err == nil {
+		return d.GEDCOM()
+	}
+	return value
+}
+
+// Converte uma data do GEDCOM para o formato da API, com as partes em ISO.
+// Valores que não são datas são retornados sem alteração.
+func ISODate(value string) string {
+	if d, err := dates.Parse(value); err == nil {
+		return d.String()
+	}
+	return value
+}
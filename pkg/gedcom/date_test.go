@@ -0,0 +1,26 @@
+package gedcom
+
+func (suite *GedcomTestSuite) TestFormatDate() {
+	suite.Equal("12 MAY 1990", FormatDate("1990-05-12"))
+	suite.Equal("5 JAN 1990", FormatDate("1990-01-05"))
+	suite.Equal("MAY 1990", FormatDate("1990-05"))
+	suite.Equal("1990", FormatDate("1990"))
+	suite.Equal("ABT 1890", FormatDate("ABT 1890"))
+	suite.Equal("", FormatDate(""))
+}
+
+func (suite *GedcomTestSuite) TestISODate() {
+	suite.Equal("1990-05-12", ISODate("12 MAY 1990"))
+	suite.Equal("1990-01-05", ISODate("05 JAN 1990"))
+	suite.Equal("1990-05", ISODate("MAY 1990"))
+	suite.Equal("1990", ISODate("1990"))
+	suite.Equal("BET 1900 AND 1905", ISODate("BET 1900 AND 1905"))
+}
+
+func (suite *GedcomTestSuite) TestQualifiedDates() {
+	suite.Equal("ABT 12 MAY 1890", FormatDate("ABT 1890-05-12"))
+	suite.Equal("BET 1900 AND JUN 1905", FormatDate("BET 1900 AND 1905-06"))
+	suite.Equal("ABT 1890-05-12", ISODate("ABT 12 MAY 1890"))
+	suite.Equal("FROM 1900 TO 1905-06", ISODate("FROM 1900 TO JUN 1905"))
+	suite.Equal("INT 1900 (about)", ISODate("INT 1900 (about)"))
+}
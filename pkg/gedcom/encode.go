@@ -0,0 +1,168 @@
+package gedcom
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Tamanho máximo do valor de uma linha antes de continuar com CONC.
+const maxValueLength = 200
+
+// Remetente do arquivo, obrigatório no cabeçalho do GEDCOM 5.5.1.
+const submitterXRef = "@SUBM1@"
+
+// Escreve o documento no formato GEDCOM 5.5.1 (UTF-8, lineage-linked).
+func Encode(w io.Writer, doc *Document) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+
+	e.line(0, "", "HEAD", "")
+	e.line(1, "", "SOUR", "TREE-GENEALOGICAL")
+	e.line(1, "", "SUBM", submitterXRef)
+	e.line(1, "", "GEDC", "")
+	e.line(2, "", "VERS", "5.5.1")
+	e.line(2, "", "FORM", "LINEAGE-LINKED")
+	e.line(1, "", "CHAR", "UTF-8")
+
+	for _, individual := range doc.Individuals {
+		e.individual(individual)
+	}
+	for _, family := range doc.Families {
+		e.family(family)
+	}
+
+	e.line(0, submitterXRef, "SUBM", "")
+	e.line(1, "", "NAME", "TREE-GENEALOGICAL")
+
+	e.line(0, "", "TRLR", "")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) individual(individual *Individual) {
+	e.line(0, individual.XRef, "INDI", "")
+	e.line(1, "", "NAME", personalNameValue(individual.Name))
+	if individual.Sex != "" {
+		e.line(1, "", "SEX", individual.Sex)
+	}
+	e.event(1, "BIRT", "", individual.Birth)
+	e.event(1, "BAPM", "", individual.Baptism)
+	e.event(1, "DEAT", "", individual.Death)
+	e.event(1, "BURI", "", individual.Burial)
+	for _, residence := range individual.Residences {
+		e.event(1, "RESI", "", residence)
+	}
+	for _, event := range individual.Events {
+		e.event(1, "EVEN", event.Type, event)
+	}
+	for _, famC := range individual.FamC {
+		e.line(1, "", "FAMC", famC.XRef)
+		if famC.Pedigree != "" {
+			e.line(2, "", "PEDI", famC.Pedigree)
+		}
+	}
+	for _, famS := range individual.FamS {
+		e.line(1, "", "FAMS", famS)
+	}
+}
+
+func (e *encoder) family(family *Family) {
+	e.line(0, family.XRef, "FAM", "")
+	if family.Husband != "" {
+		e.line(1, "", "HUSB", family.Husband)
+	}
+	if family.Wife != "" {
+		e.line(1, "", "WIFE", family.Wife)
+	}
+	for _, child := range family.Children {
+		e.line(1, "", "CHIL", child)
+	}
+	e.event(1, "MARR", "", family.Marriage)
+	e.event(1, "DIV", "", family.Divorce)
+	e.event(1, "EVEN", EventPartnership, family.Partnership)
+	e.event(1, "EVEN", EventSeparation, family.Separation)
+}
+
+// Delimita a última palavra do nome como sobrenome (ex.: "John Smith" vira "John /Smith/").
+func personalNameValue(name string) string {
+	i := strings.LastIndex(name, " ")
+	if i < 0 {
+		return name
+	}
+	return name[:i] + " /" + name[i+1:] + "/"
+}
+
+// Eventos sem data e local são escritos com o valor Y, que indica apenas que o evento ocorreu.
+func (e *encoder) event(level int, tag, eventType string, event *Event) {
+	if event == nil {
+		return
+	}
+	if event.Date == "" && event.Place == "" && eventType == "" {
+		e.line(level, "", tag, "Y")
+		return
+	}
+	e.line(level, "", tag, "")
+	if eventType != "" {
+		e.line(level+1, "", "TYPE", eventType)
+	}
+	if event.Date != "" {
+		e.line(level+1, "", "DATE", event.Date)
+	}
+	if event.Place != "" {
+		e.line(level+1, "", "PLAC", event.Place)
+	}
+}
+
+// Escreve a linha, quebrando valores longos com CONC e quebras de linha com CONT.
+func (e *encoder) line(level int, xref, tag, value string) {
+	for i, text := range strings.Split(value, "\n") {
+		chunks := splitValue(text)
+		if i == 0 {
+			e.write(level, xref, tag, chunks[0])
+		} else {
+			e.write(level+1, "", "CONT", chunks[0])
+		}
+		for _, chunk := range chunks[1:] {
+			e.write(level+1, "", "CONC", chunk)
+		}
+	}
+}
+
+func (e *encoder) write(level int, xref, tag, value string) {
+	if e.err != nil {
+		return
+	}
+	text := fmt.Sprintf("%d", level)
+	if xref != "" {
+		text += " " + xref
+	}
+	text += " " + tag
+	if value != "" {
+		text += " " + value
+	}
+	_, e.err = e.w.WriteString(text + "\n")
+}
+
+// Divide o valor em partes sem cortar caracteres UTF-8 e sem terminar uma parte em espaço,
+// já que muitos leitores removem o espaço no fim da linha.
+func splitValue(value string) []string {
+	runes := []rune(value)
+	var chunks []string
+	for len(runes) > maxValueLength {
+		cut := maxValueLength
+		for cut > 1 && runes[cut-1] == ' ' {
+			cut--
+		}
+		chunks = append(chunks, string(runes[:cut]))
+		runes = runes[cut:]
+	}
+	return append(chunks, string(runes))
+}
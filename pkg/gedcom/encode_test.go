@@ -0,0 +1,96 @@
+package gedcom
+
+import (
+	"bytes"
+	"strings"
+)
+
+func (suite *GedcomTestSuite) TestEncode() {
+	doc := &Document{
+		Individuals: []*Individual{
+			{XRef: "@I1@", Name: "John Smith", Sex: "M", Birth: &Event{Date: "12 MAY 1950", Place: "Recife"}, FamS: []string{"@F1@"}},
+			{XRef: "@I2@", Name: "Mary Smith", Sex: "F", FamS: []string{"@F1@"}},
+			{XRef: "@I3@", Name: "Anne Smith", Sex: "F", FamC: []FamilyLink{{XRef: "@F1@", Pedigree: PedigreeAdopted}}},
+		},
+		Families: []*Family{
+			{XRef: "@F1@", Husband: "@I1@", Wife: "@I2@", Children: []string{"@I3@"}, Marriage: &Event{}, Partnership: &Event{Date: "1970"}},
+		},
+	}
+
+	suite.Run("should write GEDCOM 5.5.1", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(Encode(&buf, doc))
+		suite.Equal(`0 HEAD
+1 SOUR TREE-GENEALOGICAL
+1 SUBM @SUBM1@
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Smith/
+1 SEX M
+1 BIRT
+2 DATE 12 MAY 1950
+2 PLAC Recife
+1 FAMS @F1@
+0 @I2@ INDI
+1 NAME Mary /Smith/
+1 SEX F
+1 FAMS @F1@
+0 @I3@ INDI
+1 NAME Anne /Smith/
+1 SEX F
+1 FAMC @F1@
+2 PEDI adopted
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 CHIL @I3@
+1 MARR Y
+1 EVEN
+2 TYPE partnership
+2 DATE 1970
+0 @SUBM1@ SUBM
+1 NAME TREE-GENEALOGICAL
+0 TRLR
+`, buf.String())
+	})
+
+	suite.Run("should read back what was written", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(Encode(&buf, doc))
+		parsed, err := Parse(&buf)
+		suite.Require().NoError(err)
+
+		suite.Require().Len(parsed.Individuals, 3)
+		suite.Equal("John Smith", parsed.Individuals[0].Name)
+		suite.Equal(doc.Individuals[0].Birth, parsed.Individuals[0].Birth)
+		suite.Equal(PedigreeAdopted, parsed.Individuals[2].FamC[0].Pedigree)
+		suite.Require().Len(parsed.Families, 1)
+		suite.Equal(&Event{}, parsed.Families[0].Marriage)
+		suite.Equal(&Event{Date: "1970"}, parsed.Families[0].Partnership)
+		suite.Equal([]string{"@I3@"}, parsed.Families[0].Children)
+	})
+
+	suite.Run("should keep single word names without surname", func() {
+		var buf bytes.Buffer
+		suite.Require().NoError(Encode(&buf, &Document{Individuals: []*Individual{{XRef: "@I1@", Name: "John"}}}))
+		suite.Contains(buf.String(), "1 NAME John\n")
+
+		parsed, err := Parse(&buf)
+		suite.Require().NoError(err)
+		suite.Equal("John", parsed.Individuals[0].Name)
+	})
+
+	suite.Run("should continue long and multiline values", func() {
+		name := strings.Repeat("a", 250) + "\nb"
+		var buf bytes.Buffer
+		suite.Require().NoError(Encode(&buf, &Document{Individuals: []*Individual{{XRef: "@I1@", Name: name}}}))
+		suite.Contains(buf.String(), "2 CONC "+strings.Repeat("a", 50)+"\n2 CONT b\n")
+
+		parsed, err := Parse(&buf)
+		suite.Require().NoError(err)
+		suite.Equal(strings.Repeat("a", 250)+" b", parsed.Individuals[0].Name)
+	})
+}
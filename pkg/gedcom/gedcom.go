@@ -0,0 +1,372 @@
+// Package gedcom lê arquivos GEDCOM 5.5.1, cobrindo os registros de indivíduos (INDI) e famílias (FAM).
+package gedcom
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Tipos de vínculo com a família de origem (PEDI).
+const (
+	PedigreeBirth   = "birth"
+	PedigreeAdopted = "adopted"
+	PedigreeFoster  = "foster"
+)
+
+// Tipos de EVEN de família usados para uniões sem casamento.
+const (
+	EventPartnership = "partnership"
+	EventSeparation  = "separation"
+)
+
+// Evento com data e local (BIRT, DEAT, MARR, DIV, EVEN). Type é o TYPE dos eventos EVEN de indivíduos.
+type Event struct {
+	Date  string
+	Place string
+	Type  string
+}
+
+// Vínculo de um indivíduo com a família em que é filho (FAMC).
+type FamilyLink struct {
+	XRef     string
+	Pedigree string
+	Line     int
+}
+
+// Registro INDI.
+type Individual struct {
+	XRef  string
+	Name  string
+	Sex   string
+	Birth *Event
+	// BAPM ou CHR.
+	Baptism    *Event
+	Death      *Event
+	Burial     *Event
+	Residences []*Event
+	// Eventos genéricos (EVEN), com o tipo em Event.Type.
+	Events []*Event
+	FamC   []FamilyLink
+	FamS   []string
+	Line   int
+}
+
+// Registro FAM.
+type Family struct {
+	XRef     string
+	Husband  string
+	Wife     string
+	Children []string
+	Marriage *Event
+	Divorce  *Event
+	// Início e fim de uma união sem casamento (EVEN com TYPE partnership ou separation).
+	Partnership *Event
+	Separation  *Event
+	Line        int
+}
+
+// Conteúdo de um arquivo GEDCOM.
+type Document struct {
+	Individuals []*Individual
+	Families    []*Family
+}
+
+// Busca o indivíduo pelo identificador (ex.: @I1@).
+func (d *Document) Individual(xref string) *Individual {
+	for _, i := range d.Individuals {
+		if i.XRef == xref {
+			return i
+		}
+	}
+	return nil
+}
+
+// Busca a família pelo identificador (ex.: @F1@).
+func (d *Document) Family(xref string) *Family {
+	for _, f := range d.Families {
+		if f.XRef == xref {
+			return f
+		}
+	}
+	return nil
+}
+
+// Erro de uma linha do arquivo.
+type ParseError struct {
+	Line    int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Erros encontrados durante a leitura. As linhas com erro são ignoradas e o restante do arquivo é lido.
+type Errors []*ParseError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Linha do arquivo com as suas subestruturas.
+type node struct {
+	level    int
+	xref     string
+	tag      string
+	value    string
+	line     int
+	children []*node
+}
+
+// Lê o arquivo GEDCOM. Quando existem linhas inválidas o documento é retornado junto com um Errors.
+func Parse(r io.Reader) (*Document, error) {
+	records, errs, err := readRecords(r)
+	if err != nil {
+		return nil, fmt.Errorf("read gedcom error: %w", err)
+	}
+
+	doc := &Document{}
+	for _, record := range records {
+		switch record.tag {
+		case "INDI":
+			doc.Individuals = append(doc.Individuals, parseIndividual(record, &errs))
+		case "FAM":
+			doc.Families = append(doc.Families, parseFamily(record, &errs))
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Line < errs[j].Line })
+		return doc, errs
+	}
+	return doc, nil
+}
+
+// Monta a árvore de linhas a partir dos níveis, retornando os registros de nível 0.
+func readRecords(r io.Reader) ([]*node, Errors, error) {
+	var (
+		records []*node
+		errs    Errors
+		stack   []*node
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if number == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		n, err := parseLine(text, number)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if n.level > len(stack) {
+			errs = append(errs, &ParseError{Line: number, Message: fmt.Sprintf("level %d skips a level", n.level)})
+			continue
+		}
+		stack = stack[:n.level]
+
+		switch n.tag {
+		case "CONC", "CONT":
+			if n.level == 0 {
+				errs = append(errs, &ParseError{Line: number, Message: n.tag + " without a parent line"})
+				continue
+			}
+			parent := stack[n.level-1]
+			if n.tag == "CONT" {
+				parent.value += "\n"
+			}
+			parent.value += n.value
+			continue
+		}
+
+		if n.level == 0 {
+			records = append(records, n)
+		} else {
+			parent := stack[n.level-1]
+			parent.children = append(parent.children, n)
+		}
+		stack = append(stack, n)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return records, errs, nil
+}
+
+// Interpreta uma linha no formato: nível [@xref@] TAG [valor].
+func parseLine(text string, number int) (*node, *ParseError) {
+	fields := strings.SplitN(strings.TrimLeft(text, " \t"), " ", 2)
+	level, err := strconv.Atoi(fields[0])
+	if err != nil || level < 0 || level > 99 {
+		return nil, &ParseError{Line: number, Message: fmt.Sprintf("invalid level %q", fields[0])}
+	}
+	if len(fields) < 2 || strings.TrimSpace(fields[1]) == "" {
+		return nil, &ParseError{Line: number, Message: "missing tag"}
+	}
+
+	n := &node{level: level, line: number}
+	rest := fields[1]
+	if strings.HasPrefix(rest, "@") {
+		parts := strings.SplitN(rest, " ", 2)
+		if len(parts) < 2 || len(parts[0]) < 3 || !strings.HasSuffix(parts[0], "@") {
+			return nil, &ParseError{Line: number, Message: fmt.Sprintf("invalid cross-reference %q", parts[0])}
+		}
+		n.xref = parts[0]
+		rest = parts[1]
+	}
+
+	parts := strings.SplitN(rest, " ", 2)
+	n.tag = strings.ToUpper(parts[0])
+	if n.tag == "" {
+		return nil, &ParseError{Line: number, Message: "missing tag"}
+	}
+	if len(parts) == 2 {
+		n.value = parts[1]
+	}
+	return n, nil
+}
+
+func parseIndividual(record *node, errs *Errors) *Individual {
+	individual := &Individual{XRef: record.xref, Line: record.line}
+	if record.xref == "" {
+		*errs = append(*errs, &ParseError{Line: record.line, Message: "INDI record without cross-reference"})
+	}
+
+	for _, child := range record.children {
+		switch child.tag {
+		case "NAME":
+			// Apenas o primeiro nome é utilizado, os demais são nomes alternativos.
+			if individual.Name == "" {
+				individual.Name = personalName(child.value)
+			}
+		case "SEX":
+			sex := strings.ToUpper(strings.TrimSpace(child.value))
+			switch sex {
+			case "M", "F", "U":
+				individual.Sex = sex
+			default:
+				*errs = append(*errs, &ParseError{Line: child.line, Message: fmt.Sprintf("invalid sex %q", child.value)})
+			}
+		case "BIRT":
+			individual.Birth = parseEvent(child)
+		case "BAPM", "CHR":
+			individual.Baptism = parseEvent(child)
+		case "DEAT":
+			individual.Death = parseEvent(child)
+		case "BURI":
+			individual.Burial = parseEvent(child)
+		case "RESI":
+			individual.Residences = append(individual.Residences, parseEvent(child))
+		case "EVEN":
+			event := parseEvent(child)
+			event.Type = eventType(child)
+			individual.Events = append(individual.Events, event)
+		case "FAMC":
+			if !isPointer(child.value) {
+				*errs = append(*errs, &ParseError{Line: child.line, Message: fmt.Sprintf("invalid FAMC pointer %q", child.value)})
+				continue
+			}
+			link := FamilyLink{XRef: child.value, Line: child.line}
+			for _, sub := range child.children {
+				if sub.tag == "PEDI" {
+					link.Pedigree = strings.ToLower(strings.TrimSpace(sub.value))
+				}
+			}
+			individual.FamC = append(individual.FamC, link)
+		case "FAMS":
+			if !isPointer(child.value) {
+				*errs = append(*errs, &ParseError{Line: child.line, Message: fmt.Sprintf("invalid FAMS pointer %q", child.value)})
+				continue
+			}
+			individual.FamS = append(individual.FamS, child.value)
+		}
+	}
+	return individual
+}
+
+func parseFamily(record *node, errs *Errors) *Family {
+	family := &Family{XRef: record.xref, Line: record.line}
+	if record.xref == "" {
+		*errs = append(*errs, &ParseError{Line: record.line, Message: "FAM record without cross-reference"})
+	}
+
+	for _, child := range record.children {
+		switch child.tag {
+		case "HUSB", "WIFE", "CHIL":
+			if !isPointer(child.value) {
+				*errs = append(*errs, &ParseError{Line: child.line, Message: fmt.Sprintf("invalid %s pointer %q", child.tag, child.value)})
+				continue
+			}
+			switch child.tag {
+			case "HUSB":
+				family.Husband = child.value
+			case "WIFE":
+				family.Wife = child.value
+			default:
+				family.Children = append(family.Children, child.value)
+			}
+		case "MARR":
+			family.Marriage = parseEvent(child)
+		case "DIV":
+			family.Divorce = parseEvent(child)
+		case "EVEN":
+			switch strings.ToLower(eventType(child)) {
+			case EventPartnership:
+				family.Partnership = parseEvent(child)
+			case EventSeparation:
+				family.Separation = parseEvent(child)
+			}
+		}
+	}
+	return family
+}
+
+func parseEvent(n *node) *Event {
+	event := &Event{}
+	for _, child := range n.children {
+		switch child.tag {
+		case "DATE":
+			event.Date = strings.TrimSpace(child.value)
+		case "PLAC":
+			event.Place = strings.TrimSpace(child.value)
+		}
+	}
+	return event
+}
+
+func eventType(n *node) string {
+	for _, child := range n.children {
+		if child.tag == "TYPE" {
+			return strings.TrimSpace(child.value)
+		}
+	}
+	return ""
+}
+
+// Remove as barras que delimitam o sobrenome (ex.: "John /Smith/" vira "John Smith").
+func personalName(value string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(value, "/", " ")), " ")
+}
+
+func isPointer(value string) bool {
+	return len(value) > 2 && strings.HasPrefix(value, "@") && strings.HasSuffix(value, "@")
+}
@@ -0,0 +1,164 @@
+package gedcom
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const sample = "\ufeff0 HEAD\r\n" + `1 GEDC
+2 VERS 5.5.1
+0 @I1@ INDI
+1 NAME John /Smith/
+1 SEX M
+1 BIRT
+2 DATE 12 MAY 1950
+2 PLAC Recife, Pernambuco
+1 FAMS @F1@
+0 @I2@ INDI
+1 NAME Mary /Jones/
+1 NAME Mary /Smith/
+1 SEX F
+1 DEAT
+2 DATE ABT 2010
+1 FAMS @F1@
+0 @I3@ INDI
+1 NAME Anne /Smith/
+1 SEX F
+1 FAMC @F1@
+2 PEDI adopted
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 CHIL @I3@
+1 MARR
+2 DATE 1975
+2 PLAC Olinda
+0 TRLR
+`
+
+type GedcomTestSuite struct {
+	suite.Suite
+}
+
+func (suite *GedcomTestSuite) TestParse() {
+	doc, err := Parse(strings.NewReader(sample))
+	suite.Require().NoError(err)
+	suite.Len(doc.Individuals, 3)
+	suite.Len(doc.Families, 1)
+
+	suite.Run("should read individuals", func() {
+		john := doc.Individual("@I1@")
+		suite.Require().NotNil(john)
+		suite.Equal("John Smith", john.Name)
+		suite.Equal("M", john.Sex)
+		suite.Equal(&Event{Date: "12 MAY 1950", Place: "Recife, Pernambuco"}, john.Birth)
+		suite.Equal([]string{"@F1@"}, john.FamS)
+		suite.Equal(4, john.Line)
+	})
+
+	suite.Run("should keep the first name", func() {
+		mary := doc.Individual("@I2@")
+		suite.Equal("Mary Jones", mary.Name)
+		suite.Equal(&Event{Date: "ABT 2010"}, mary.Death)
+	})
+
+	suite.Run("should read the pedigree of the child", func() {
+		anne := doc.Individual("@I3@")
+		suite.Equal([]FamilyLink{{XRef: "@F1@", Pedigree: PedigreeAdopted, Line: 21}}, anne.FamC)
+	})
+
+	suite.Run("should read families", func() {
+		family := doc.Family("@F1@")
+		suite.Require().NotNil(family)
+		suite.Equal("@I1@", family.Husband)
+		suite.Equal("@I2@", family.Wife)
+		suite.Equal([]string{"@I3@"}, family.Children)
+		suite.Equal(&Event{Date: "1975", Place: "Olinda"}, family.Marriage)
+		suite.Nil(family.Divorce)
+	})
+
+	suite.Run("should return nil for unknown references", func() {
+		suite.Nil(doc.Individual("@I9@"))
+		suite.Nil(doc.Family("@F9@"))
+	})
+}
+
+func (suite *GedcomTestSuite) TestParseEvents() {
+	input := `0 @I1@ INDI
+1 NAME John
+1 CHR
+2 DATE 20 MAY 1950
+1 BURI
+2 PLAC Olinda
+1 RESI
+2 DATE FROM 1970 TO 1980
+2 PLAC Recife
+1 RESI
+2 PLAC Natal
+1 EVEN
+2 TYPE Graduation
+2 DATE 1972
+`
+	doc, err := Parse(strings.NewReader(input))
+	suite.Require().NoError(err)
+
+	john := doc.Individual("@I1@")
+	suite.Equal(&Event{Date: "20 MAY 1950"}, john.Baptism)
+	suite.Equal(&Event{Place: "Olinda"}, john.Burial)
+	suite.Equal([]*Event{{Date: "FROM 1970 TO 1980", Place: "Recife"}, {Place: "Natal"}}, john.Residences)
+	suite.Equal([]*Event{{Date: "1972", Type: "Graduation"}}, john.Events)
+}
+
+func (suite *GedcomTestSuite) TestParseContinuation() {
+	doc, err := Parse(strings.NewReader("0 @I1@ INDI\n1 NAME Jo\n2 CONC hn /Smith/\n1 SEX M\n"))
+	suite.Require().NoError(err)
+	suite.Equal("John Smith", doc.Individuals[0].Name)
+}
+
+func (suite *GedcomTestSuite) TestParseErrors() {
+	input := `0 HEAD
+0 @I1@ INDI
+1 NAME John /Smith/
+1 SEX X
+x NAME Broken
+3 DATE 1900
+1 FAMC F1
+0 @I2 INDI
+0 FAM
+1 CHIL @I1@
+`
+	doc, err := Parse(strings.NewReader(input))
+
+	var errs Errors
+	suite.Require().True(errors.As(err, &errs))
+	suite.Equal(Errors{
+		{Line: 4, Message: `invalid sex "X"`},
+		{Line: 5, Message: `invalid level "x"`},
+		{Line: 6, Message: "level 3 skips a level"},
+		{Line: 7, Message: `invalid FAMC pointer "F1"`},
+		{Line: 8, Message: `invalid cross-reference "@I2"`},
+		{Line: 9, Message: "FAM record without cross-reference"},
+	}, errs)
+	suite.Contains(err.Error(), "line 4: invalid sex")
+
+	suite.Run("should keep the valid lines", func() {
+		suite.Require().Len(doc.Individuals, 1)
+		suite.Equal("John Smith", doc.Individuals[0].Name)
+		suite.Empty(doc.Individuals[0].Sex)
+		suite.Require().Len(doc.Families, 1)
+		suite.Equal([]string{"@I1@"}, doc.Families[0].Children)
+	})
+}
+
+func (suite *GedcomTestSuite) TestPersonalName() {
+	suite.Equal("John Smith", personalName("John /Smith/"))
+	suite.Equal("Smith", personalName("/Smith/"))
+	suite.Equal("John", personalName("John"))
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(GedcomTestSuite))
+}
@@ -0,0 +1,58 @@
+package genealogy
+
+import (
+	"context"
+	"sort"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Lista os ancestrais compartilhados por person e relative, do mais próximo ao mais distante,
+// marcando os ancestrais comuns mais recentes. Quando uma pessoa é ancestral da outra, ela mesma
+// é o ancestral comum, com zero gerações do seu lado. Retorna vazio quando não há ancestral em comum.
+func (tg *TreeGenealogical) CommonAncestors(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) []*entity.CommonAncestor {
+	if person == nil || relative == nil {
+		return nil
+	}
+
+	k := newKinshipCalculator(persons)
+	personAncestors := k.ancestors(person.ID)
+	relativeAncestors := k.ancestors(relative.ID)
+
+	var common []*entity.CommonAncestor
+	for id, up := range personAncestors {
+		down, ok := relativeAncestors[id]
+		if !ok {
+			continue
+		}
+		ancestor := findPerson(id, persons)
+		if ancestor == nil {
+			continue
+		}
+		common = append(common, &entity.CommonAncestor{Person: ancestor, FirstGeneration: up, SecondGeneration: down})
+	}
+
+	// Um ancestral comum é o mais recente quando nenhum outro ancestral comum descende dele.
+	for _, candidate := range common {
+		candidate.MostRecent = true
+		for _, other := range common {
+			if other == candidate {
+				continue
+			}
+			if _, ok := k.ancestors(other.Person.ID)[candidate.Person.ID]; ok {
+				candidate.MostRecent = false
+				break
+			}
+		}
+	}
+
+	sort.Slice(common, func(i, j int) bool {
+		ci, cj := common[i], common[j]
+		if ci.FirstGeneration+ci.SecondGeneration != cj.FirstGeneration+cj.SecondGeneration {
+			return ci.FirstGeneration+ci.SecondGeneration < cj.FirstGeneration+cj.SecondGeneration
+		}
+		return ci.Person.Name < cj.Person.Name
+	})
+
+	return common
+}
@@ -0,0 +1,90 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommonAncestorsTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *CommonAncestorsTestSuite) SetupTest() {
+	suite.family = map[string]*entity.Person{}
+	add := func(name, gender, fatherName, motherName string) {
+		fatherID, motherID := "", ""
+		if father, ok := suite.family[fatherName]; ok {
+			fatherID = father.ID
+		}
+		if mother, ok := suite.family[motherName]; ok {
+			motherID = mother.ID
+		}
+		p := NewPerson(name, gender, fatherID, motherID)
+		suite.family[name] = p
+		suite.persons = append(suite.persons, p)
+	}
+
+	add("GreatAncestor", "M", "", "")
+	add("Ancestor", "M", "GreatAncestor", "")
+	add("Ancestress", "F", "", "")
+	add("Arthur", "M", "Ancestor", "Ancestress")
+	add("Beatrice", "F", "Ancestor", "Ancestress")
+	add("Arthur1", "M", "Arthur", "")
+	add("Beatrice1", "F", "", "Beatrice")
+	add("Stranger", "M", "", "")
+}
+
+func (suite *CommonAncestorsTestSuite) common(person, relative string) []*entity.CommonAncestor {
+	return NewFamilyTree().CommonAncestors(context.Background(), suite.family[person], suite.family[relative], suite.persons)
+}
+
+func (suite *CommonAncestorsTestSuite) TestCommonAncestors() {
+	suite.Run("should return both grandparents of cousins as most recent common ancestors", func() {
+		common := suite.common("Arthur1", "Beatrice1")
+
+		suite.Len(common, 3)
+		suite.Equal("Ancestor", common[0].Person.Name)
+		suite.Equal("Ancestress", common[1].Person.Name)
+		suite.Equal("GreatAncestor", common[2].Person.Name)
+		for _, ancestor := range common[:2] {
+			suite.Equal(2, ancestor.FirstGeneration)
+			suite.Equal(2, ancestor.SecondGeneration)
+			suite.True(ancestor.MostRecent)
+		}
+		suite.Equal(3, common[2].FirstGeneration)
+		suite.Equal(3, common[2].SecondGeneration)
+		suite.False(common[2].MostRecent)
+	})
+
+	suite.Run("should count different generations from each side", func() {
+		common := suite.common("Arthur1", "Beatrice")
+
+		suite.Len(common, 3)
+		suite.Equal(2, common[0].FirstGeneration)
+		suite.Equal(1, common[0].SecondGeneration)
+	})
+
+	suite.Run("should return the parent as the most recent common ancestor of the child", func() {
+		common := suite.common("Arthur1", "Arthur")
+
+		suite.Equal("Arthur", common[0].Person.Name)
+		suite.Equal(1, common[0].FirstGeneration)
+		suite.Equal(0, common[0].SecondGeneration)
+		suite.True(common[0].MostRecent)
+		for _, ancestor := range common[1:] {
+			suite.False(ancestor.MostRecent)
+		}
+	})
+
+	suite.Run("should return empty when the people share no ancestor", func() {
+		suite.Empty(suite.common("Arthur", "Stranger"))
+	})
+
+	suite.Run("should return empty when a person is nil", func() {
+		suite.Empty(NewFamilyTree().CommonAncestors(context.Background(), nil, suite.family["Arthur"], suite.persons))
+	})
+}
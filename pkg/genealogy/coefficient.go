@@ -0,0 +1,108 @@
+package genealogy
+
+import (
+	"context"
+	"math"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Calcula os coeficientes pelo método tabular: o coeficiente de consanguinidade de duas pessoas é a média
+// dos coeficientes dos pais da mais nova com a outra. Ancestrais alcançados por mais de um caminho
+// (colapso de pedigree) somam a contribuição de cada caminho.
+type coefficientCalculator struct {
+	kinship   *kinshipCalculator
+	ancestors map[string]map[string]int
+	memo      map[[2]string]float64
+}
+
+func newCoefficientCalculator(persons []*entity.Person) *coefficientCalculator {
+	return &coefficientCalculator{
+		kinship:   newKinshipCalculator(personsInMode(persons, entity.TreeModeBiological)),
+		ancestors: map[string]map[string]int{},
+		memo:      map[[2]string]float64{},
+	}
+}
+
+// Verifica se ancestorID é ancestral de personID.
+func (c *coefficientCalculator) isAncestor(ancestorID, personID string) bool {
+	if ancestorID == personID {
+		return false
+	}
+	ancestors, ok := c.ancestors[personID]
+	if !ok {
+		ancestors = c.kinship.ancestors(personID)
+		c.ancestors[personID] = ancestors
+	}
+	_, found := ancestors[ancestorID]
+	return found
+}
+
+// Os dois pais genéticos da pessoa. Um pai desconhecido é vazio e não contribui com os coeficientes.
+func (c *coefficientCalculator) parents(personID string) (father, mother string) {
+	parents := c.kinship.parents[personID]
+	if len(parents) > 0 {
+		father = parents[0]
+	}
+	if len(parents) > 1 {
+		mother = parents[1]
+	}
+	return father, mother
+}
+
+// Coeficiente de consanguinidade (kinship) entre duas pessoas.
+func (c *coefficientCalculator) coefficient(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	key := [2]string{min(a, b), max(a, b)}
+	if value, ok := c.memo[key]; ok {
+		return value
+	}
+	// Evita recursão infinita em ciclos cadastrados antes da validação de relacionamentos.
+	c.memo[key] = 0
+
+	var value float64
+	switch {
+	case a == b:
+		value = (1 + c.inbreeding(a)) / 2
+	case c.isAncestor(a, b) && c.isAncestor(b, a):
+		value = 0
+	default:
+		// Expande quem não é ancestral do outro, garantindo que a recursão suba nas gerações.
+		if c.isAncestor(a, b) {
+			a, b = b, a
+		}
+		father, mother := c.parents(a)
+		value = (c.coefficient(father, b) + c.coefficient(mother, b)) / 2
+	}
+
+	c.memo[key] = value
+	return value
+}
+
+// Coeficiente de endogamia (F) da pessoa: a consanguinidade entre os seus pais.
+func (c *coefficientCalculator) inbreeding(personID string) float64 {
+	father, mother := c.parents(personID)
+	return c.coefficient(father, mother)
+}
+
+// Calcula o coeficiente de parentesco de Wright entre person e relative, a consanguinidade entre eles
+// e o coeficiente de endogamia de cada um. Considera apenas os pais genéticos (biológicos e doadores).
+func (tg *TreeGenealogical) Coefficients(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) *entity.Coefficients {
+	if person == nil || relative == nil {
+		return nil
+	}
+
+	c := newCoefficientCalculator(persons)
+	kinship := c.coefficient(person.ID, relative.ID)
+	firstInbreeding := c.inbreeding(person.ID)
+	secondInbreeding := c.inbreeding(relative.ID)
+
+	return &entity.Coefficients{
+		Relationship:     2 * kinship / math.Sqrt((1+firstInbreeding)*(1+secondInbreeding)),
+		Kinship:          kinship,
+		FirstInbreeding:  firstInbreeding,
+		SecondInbreeding: secondInbreeding,
+	}
+}
@@ -0,0 +1,143 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type CoefficientTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *CoefficientTestSuite) SetupTest() {
+	suite.persons = nil
+	suite.family = map[string]*entity.Person{}
+}
+
+func (suite *CoefficientTestSuite) add(name, gender, fatherName, motherName string) *entity.Person {
+	fatherID, motherID := "", ""
+	if father, ok := suite.family[fatherName]; ok {
+		fatherID = father.ID
+	}
+	if mother, ok := suite.family[motherName]; ok {
+		motherID = mother.ID
+	}
+	p := NewPerson(name, gender, fatherID, motherID)
+	suite.family[name] = p
+	suite.persons = append(suite.persons, p)
+	return p
+}
+
+func (suite *CoefficientTestSuite) coefficients(person, relative string) *entity.Coefficients {
+	return NewFamilyTree().Coefficients(context.Background(), suite.family[person], suite.family[relative], suite.persons)
+}
+
+func (suite *CoefficientTestSuite) TestCoefficients() {
+	suite.Run("should return 0.5 for full siblings", func() {
+		suite.add("Father", "M", "", "")
+		suite.add("Mother", "F", "", "")
+		suite.add("Arthur", "M", "Father", "Mother")
+		suite.add("Beatrice", "F", "Father", "Mother")
+
+		c := suite.coefficients("Arthur", "Beatrice")
+		suite.InDelta(0.5, c.Relationship, 1e-9)
+		suite.InDelta(0.25, c.Kinship, 1e-9)
+		suite.Zero(c.FirstInbreeding)
+		suite.Zero(c.SecondInbreeding)
+	})
+
+	suite.Run("should return 0.5 between parent and child", func() {
+		suite.InDelta(0.5, suite.coefficients("Father", "Arthur").Relationship, 1e-9)
+	})
+
+	suite.Run("should return 0.125 for first cousins", func() {
+		suite.SetupTest()
+		suite.add("Grandfather", "M", "", "")
+		suite.add("Grandmother", "F", "", "")
+		suite.add("Arthur", "M", "Grandfather", "Grandmother")
+		suite.add("Beatrice", "F", "Grandfather", "Grandmother")
+		suite.add("Wife", "F", "", "")
+		suite.add("Husband", "M", "", "")
+		suite.add("Arthur1", "M", "Arthur", "Wife")
+		suite.add("Beatrice1", "F", "Husband", "Beatrice")
+
+		suite.InDelta(0.125, suite.coefficients("Arthur1", "Beatrice1").Relationship, 1e-9)
+	})
+
+	suite.Run("should return 0.25 for half siblings", func() {
+		suite.add("Arthur2", "M", "Arthur", "")
+		suite.InDelta(0.25, suite.coefficients("Arthur1", "Arthur2").Relationship, 1e-9)
+	})
+
+	suite.Run("should count the inbreeding of the child of first cousins", func() {
+		suite.add("Child", "M", "Arthur1", "Beatrice1")
+
+		c := suite.coefficients("Child", "Arthur1")
+		suite.InDelta(0.0625, c.FirstInbreeding, 1e-9)
+		suite.Zero(c.SecondInbreeding)
+		// kinship = (1/2 + 1/16) / 2 = 9/32 e r = 2 * 9/32 / sqrt(1 + 1/16).
+		suite.InDelta(0.28125, c.Kinship, 1e-9)
+		suite.InDelta(0.545705, c.Relationship, 1e-6)
+	})
+
+	suite.Run("should return 0.25 for double first cousins", func() {
+		suite.SetupTest()
+		suite.add("PaternalGrandfather", "M", "", "")
+		suite.add("PaternalGrandmother", "F", "", "")
+		suite.add("MaternalGrandfather", "M", "", "")
+		suite.add("MaternalGrandmother", "F", "", "")
+		// Dois irmãos casados com duas irmãs.
+		suite.add("Arthur", "M", "PaternalGrandfather", "PaternalGrandmother")
+		suite.add("Albert", "M", "PaternalGrandfather", "PaternalGrandmother")
+		suite.add("Beatrice", "F", "MaternalGrandfather", "MaternalGrandmother")
+		suite.add("Bianca", "F", "MaternalGrandfather", "MaternalGrandmother")
+		suite.add("Arthur1", "M", "Arthur", "Beatrice")
+		suite.add("Albert1", "M", "Albert", "Bianca")
+
+		suite.InDelta(0.25, suite.coefficients("Arthur1", "Albert1").Relationship, 1e-9)
+	})
+
+	suite.Run("should account for pedigree collapse in the child of siblings", func() {
+		suite.SetupTest()
+		suite.add("Father", "M", "", "")
+		suite.add("Mother", "F", "", "")
+		suite.add("Arthur", "M", "Father", "Mother")
+		suite.add("Beatrice", "F", "Father", "Mother")
+		suite.add("Child", "M", "Arthur", "Beatrice")
+		suite.add("Sibling", "F", "Arthur", "Beatrice")
+
+		c := suite.coefficients("Child", "Sibling")
+		suite.InDelta(0.25, c.FirstInbreeding, 1e-9)
+		suite.InDelta(0.25, c.SecondInbreeding, 1e-9)
+		// Cada pai contribui com (1/2 + 1/4) / 2, logo kinship = 3/8 e r = 2 * 3/8 / (1 + 1/4).
+		suite.InDelta(0.375, c.Kinship, 1e-9)
+		suite.InDelta(0.6, c.Relationship, 1e-9)
+	})
+
+	suite.Run("should ignore adoptive parents", func() {
+		suite.SetupTest()
+		suite.add("Father", "M", "", "")
+		arthur := suite.add("Arthur", "M", "Father", "")
+		adopted := suite.add("Adopted", "M", "", "")
+		adopted.Relationships = []*entity.Relationship{{MainPersonID: adopted.ID, SecundePersonID: suite.family["Father"].ID, Parentage: entity.ParentageAdoptive}}
+
+		suite.Zero(suite.coefficients(arthur.Name, adopted.Name).Relationship)
+	})
+
+	suite.Run("should return zero for unrelated people", func() {
+		suite.add("Stranger", "M", "", "")
+		suite.Zero(suite.coefficients("Arthur", "Stranger").Relationship)
+	})
+
+	suite.Run("should return one for the same person", func() {
+		suite.InDelta(1, suite.coefficients("Arthur", "Arthur").Relationship, 1e-9)
+	})
+
+	suite.Run("should return nil when a person is nil", func() {
+		suite.Nil(NewFamilyTree().Coefficients(context.Background(), nil, suite.family["Arthur"], suite.persons))
+	})
+}
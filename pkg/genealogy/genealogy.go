@@ -2,63 +2,100 @@ package genealogy
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 )
 
 const (
-	father                string = "Father"
-	son                   string = "Son"
-	brother               string = "Brother"
-	grandFather           string = "GrandFather"
-	greatUncle            string = "GreatUncle"
-	greatGrandfather      string = "GreatGrandFather"
-	greatGreatGrandfather string = "GreatGreatGrandFather"
-	uncle                 string = "Uncle"
-	cousin                string = "Cousin"
-	nephew                string = "Nephew"
-	grandSon              string = "GrandSon"
-	greatGrandSon         string = "GreatGrandSon"
-	unknownRelation       string = "Unknown Relation"
+	father                     string = "Father"
+	son                        string = "Son"
+	brother                    string = "Brother"
+	grandFather                string = "GrandFather"
+	greatUncle                 string = "GreatUncle"
+	greatGrandfather           string = "GreatGrandFather"
+	greatGreatGrandfather      string = "GreatGreatGrandFather"
+	uncle                      string = "Uncle"
+	cousin                     string = "Cousin"
+	nephew                     string = "Nephew"
+	grandSon                   string = "GrandSon"
+	greatGrandSon              string = "GreatGrandSon"
+	grandNephew                string = "GrandNephew"
+	spouse                     string = "Spouse"
+	exSpouse                   string = "ExSpouse"
+	partner                    string = "Partner"
+	exPartner                  string = "ExPartner"
+	fatherInLaw                string = "FatherInLaw"
+	brotherInLaw               string = "BrotherInLaw"
+	sonInLaw                   string = "SonInLaw"
+	grandFatherInLaw           string = "GrandFatherInLaw"
+	greatGrandfatherInLaw      string = "GreatGrandFatherInLaw"
+	greatGreatGrandfatherInLaw string = "GreatGreatGrandFatherInLaw"
+	grandSonInLaw              string = "GrandSonInLaw"
+	greatGrandSonInLaw         string = "GreatGrandSonInLaw"
+	uncleInLaw                 string = "UncleInLaw"
+	greatUncleInLaw            string = "GreatUncleInLaw"
+	nephewInLaw                string = "NephewInLaw"
+	grandNephewInLaw           string = "GrandNephewInLaw"
+	cousinInLaw                string = "CousinInLaw"
+	coParentInLaw              string = "CoParentInLaw"
+	uncleByMarriage            string = "UncleByMarriage"
+	greatUncleByMarriage       string = "GreatUncleByMarriage"
+	halfBrother                string = "HalfBrother"
+	stepFather                 string = "StepFather"
+	stepSon                    string = "StepSon"
+	stepBrother                string = "StepBrother"
+	root                       string = "Root"
+	unrelated                  string = "unrelated"
+	unknownRelation            string = "Unknown Relation"
 )
 
 var kinshipTypes = map[string]map[string]string{
-	father:                {"F": "Mother", "M": "Father"},
-	son:                   {"F": "Daughter", "M": "Son"},
-	brother:               {"F": "Sister", "M": "Brother"},
-	grandFather:           {"F": "GrandMother", "M": "GrandFather"},
-	greatUncle:            {"F": "GreatAunt", "M": "GreatUncle"},
-	greatGrandfather:      {"F": "GreatGrandMother", "M": "GreatGrandFather"},
-	greatGreatGrandfather: {"F": "GreatGreatGrandMother", "M": "GreatGreatGrandFather"},
-	uncle:                 {"F": "Aunt", "M": "Uncle"},
-	cousin:                {"F": "Cousin", "M": "Cousin"},
-	nephew:                {"F": "Niece", "M": "Nephew"},
-	grandSon:              {"F": "Granddaughter", "M": "GrandSon"},
-	greatGrandSon:         {"F": "GreatGranddaughter", "M": "GreatGrandson"},
+	father:                     {"F": "Mother", "M": "Father"},
+	son:                        {"F": "Daughter", "M": "Son"},
+	brother:                    {"F": "Sister", "M": "Brother"},
+	grandFather:                {"F": "GrandMother", "M": "GrandFather"},
+	greatUncle:                 {"F": "GreatAunt", "M": "GreatUncle"},
+	greatGrandfather:           {"F": "GreatGrandMother", "M": "GreatGrandFather"},
+	greatGreatGrandfather:      {"F": "GreatGreatGrandMother", "M": "GreatGreatGrandFather"},
+	uncle:                      {"F": "Aunt", "M": "Uncle"},
+	cousin:                     {"F": "Cousin", "M": "Cousin"},
+	nephew:                     {"F": "Niece", "M": "Nephew"},
+	grandSon:                   {"F": "GrandDaughter", "M": "GrandSon"},
+	greatGrandSon:              {"F": "GreatGrandDaughter", "M": "GreatGrandSon"},
+	grandNephew:                {"F": "GrandNiece", "M": "GrandNephew"},
+	spouse:                     {"F": "Wife", "M": "Husband"},
+	exSpouse:                   {"F": "ExWife", "M": "ExHusband"},
+	partner:                    {"F": "Partner", "M": "Partner"},
+	exPartner:                  {"F": "ExPartner", "M": "ExPartner"},
+	fatherInLaw:                {"F": "MotherInLaw", "M": "FatherInLaw"},
+	brotherInLaw:               {"F": "SisterInLaw", "M": "BrotherInLaw"},
+	sonInLaw:                   {"F": "DaughterInLaw", "M": "SonInLaw"},
+	grandFatherInLaw:           {"F": "GrandMotherInLaw", "M": "GrandFatherInLaw"},
+	greatGrandfatherInLaw:      {"F": "GreatGrandMotherInLaw", "M": "GreatGrandFatherInLaw"},
+	greatGreatGrandfatherInLaw: {"F": "GreatGreatGrandMotherInLaw", "M": "GreatGreatGrandFatherInLaw"},
+	grandSonInLaw:              {"F": "GrandDaughterInLaw", "M": "GrandSonInLaw"},
+	greatGrandSonInLaw:         {"F": "GreatGrandDaughterInLaw", "M": "GreatGrandSonInLaw"},
+	uncleInLaw:                 {"F": "AuntInLaw", "M": "UncleInLaw"},
+	greatUncleInLaw:            {"F": "GreatAuntInLaw", "M": "GreatUncleInLaw"},
+	nephewInLaw:                {"F": "NieceInLaw", "M": "NephewInLaw"},
+	grandNephewInLaw:           {"F": "GrandNieceInLaw", "M": "GrandNephewInLaw"},
+	cousinInLaw:                {"F": "CousinInLaw", "M": "CousinInLaw"},
+	coParentInLaw:              {"F": "CoMotherInLaw", "M": "CoFatherInLaw"},
+	uncleByMarriage:            {"F": "AuntByMarriage", "M": "UncleByMarriage"},
+	greatUncleByMarriage:       {"F": "GreatAuntByMarriage", "M": "GreatUncleByMarriage"},
+	halfBrother:                {"F": "HalfSister", "M": "HalfBrother"},
+	stepFather:                 {"F": "StepMother", "M": "StepFather"},
+	stepSon:                    {"F": "StepDaughter", "M": "StepSon"},
+	stepBrother:                {"F": "StepSister", "M": "StepBrother"},
 }
 
-// Regras para determinar o novo parente com base no parente encontrado.
-var rulesChild = map[string]string{
-	grandFather:      uncle,
-	uncle:            cousin,
-	cousin:           nephew,
-	brother:          nephew,
-	greatGrandfather: greatUncle,
-	nephew:           nephew,
-	son:              grandSon,
-	grandSon:         greatGrandSon,
-}
-
-// Regras para determinar o novo parente com base no parente encontrado.
-var rulesParents = map[string]string{
-	father:      grandFather,
-	grandFather: greatGrandfather,
-}
+// Motor de genealogia sem estado, pode ser compartilhado entre requisições.
+type TreeGenealogical struct{}
 
-type TreeGenealogical struct {
-	Root      *entity.Person
-	Relatives []*entity.Relative
+// Estado de uma única construção de árvore. Cada chamada de BuildFamilyTree cria o seu.
+type treeBuilder struct {
+	root    *entity.Person
+	kinship *kinshipCalculator
 }
 
 // Cria uma nova árvore genealógica com base no parente e na lista de pessoas.
@@ -67,180 +104,150 @@ func NewFamilyTree() *TreeGenealogical {
 	return tg
 }
 
+func newTreeBuilder(root *entity.Person) *treeBuilder {
+	return &treeBuilder{root: root}
+}
+
 // Constrói a árvore genealógica com base no parente e na lista de pessoas.
-func (tg *TreeGenealogical) BuildFamilyTree(ctx context.Context, rootPerson *entity.Person, persons []*entity.Person, level int) []*entity.Relative {
+// O modo define quais tipos de paternidade são considerados (todos, biológicos ou legais).
+func (tg *TreeGenealogical) BuildFamilyTree(ctx context.Context, rootPerson *entity.Person, persons []*entity.Person, level int, mode entity.TreeMode) *entity.FamilyTree {
+	rootPerson = personInMode(rootPerson, mode)
+	persons = personsInMode(persons, mode)
+
+	b := newTreeBuilder(rootPerson)
+	b.kinship = newKinshipCalculator(persons)
 
 	relatives := []*entity.Relative{
 		{
-			Type:   "Root",
+			Type:   root,
 			Level:  level,
 			Person: rootPerson,
 		},
 	}
 
-	tg.Root = rootPerson
 	// Busca por descendentes.
-	relatives = tg.searchDescendants(ctx, tg.Root, persons, level, relatives)
+	relatives = b.searchDescendants(ctx, b.root, persons, level, relatives)
 	// Busca por ancestrais e seus parentes.
-	relatives = tg.searchAncestors(ctx, tg.Root, persons, level, relatives)
-
-	tg.Relatives = relatives
-	return relatives
-}
-
-// Retorn a arvore genealógica
-func (tg *TreeGenealogical) GetRelatives(ctx context.Context) []*entity.Relative {
-	return tg.Relatives
+	relatives = b.searchAncestors(ctx, b.root, persons, level, relatives)
+	// Busca por cônjuges e companheiros.
+	relatives = b.searchSpouses(ctx, persons, level, relatives)
+	// Busca por padrastos, enteados e irmãos postiços.
+	relatives = b.searchStepRelatives(ctx, persons, level, relatives)
+	// Busca por parentes por afinidade.
+	relatives = b.searchInLaws(ctx, persons, level, relatives)
+
+	return entity.NewFamilyTree(rootPerson, relatives)
 }
 
 // Descrição da relação com base no parente e no sexo.
-func (tg *TreeGenealogical) relationshipDescription(relative *entity.Person, relatives []*entity.Relative, persons []*entity.Person) string {
+func (b *treeBuilder) relationshipDescription(relative *entity.Person, persons []*entity.Person) string {
 
-	// Verifica se a relação é direta.
-	description := tg.directRelationDescription(relative, persons)
-	if description != "" {
-		return description
+	// Cônjuges e companheiros do Root.
+	if relative != nil {
+		if union := findUnion(b.root, relative.ID); union != nil {
+			return unionDescription(union, relative.Gender)
+		}
 	}
 
-	// Busca os pais da pessoa com base nos parentes ja catalogados.
-	parent := tg.findParents(relative, relatives)
-
-	// Aplica as regras para determinar o novo parente.
-	if parent != nil {
-		for key, value := range rulesParents {
-			if isTypeOfTypeKinship(parent.Type, key) {
-				return descriptionBySex(value, relative.Gender)
-			}
+	// Calcula o parentesco pelo ancestral comum mais próximo.
+	if b.kinship != nil && b.root != nil && relative != nil {
+		if description := b.kinship.describe(b.root, relative); description != "" && description != unknownRelation {
+			return description
 		}
 	}
 
-	// Busca os filhos da pessoa com base nos parentes ja catalogados.
-	child := tg.findChildren(relative, relatives)
-
-	// Regras para determinar o novo parente com base no parente encontrado.
-
-	// Aplica as regras para determinar o novo parente.
-	if child != nil {
-		for key, value := range rulesChild {
-			if child != nil && isTypeOfTypeKinship(child.Type, key) {
-				return descriptionBySex(value, relative.Gender)
-			}
-		}
+	// Verifica se a relação é direta.
+	description := b.directRelationDescription(relative, persons)
+	if description != "" {
+		return description
 	}
 
 	return unknownRelation
 }
 
-// Busca por ancestrais de maneira recursiva.
-func (tg *TreeGenealogical) searchAncestors(ctx context.Context, relative *entity.Person, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
+// Busca pelos ancestrais e, a partir de cada um, pelos seus descendentes ainda não catalogados.
+func (b *treeBuilder) searchAncestors(ctx context.Context, relative *entity.Person, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
 	if relative == nil {
 		return relatives
 	}
-	for _, relationship := range relative.Relationships {
-		secundePerson := findPerson(relationship.SecundePersonID, persons)
-		if secundePerson == nil || tg.alreadyInFamily(secundePerson, relatives) {
-			continue // Pula para o próximo relacionamento se o parente já estiver na lista ou não for encontrado
+	walk(relative.ID, b.links(persons).parents, func(id string, distance int) bool {
+		ancestor := findPerson(id, persons)
+		if ancestor == nil {
+			return false
 		}
-		// Adiciona o parente encontrado (ancestral) apenas se não estiver já na lista
-		re := tg.newRelative(secundePerson, level, relatives, persons)
-		relatives = append(relatives, re)
-
-		// Recursivamente busca por mais ancestrais deste parente encontrado
-		relatives = tg.searchForRelatives(ctx, secundePerson, persons, level+1, relatives)
-		// Recursivamente busca por mais ancestrais
-		relatives = tg.searchAncestors(ctx, secundePerson, persons, level+1, relatives)
-
-	}
-
+		if !b.alreadyInFamily(ancestor, relatives) {
+			relatives = append(relatives, b.newRelative(ancestor, level+distance-1, persons))
+			relatives = b.searchForRelatives(ctx, ancestor, persons, level+distance, relatives)
+		}
+		return true
+	})
 	return relatives
-
 }
 
-// Busca por descendentes de maneira recursiva.
-func (tg *TreeGenealogical) searchDescendants(ctx context.Context, relative *entity.Person, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
+// Busca pelos descendentes da pessoa.
+func (b *treeBuilder) searchDescendants(ctx context.Context, relative *entity.Person, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
 	if relative == nil {
 		return relatives
 	}
-	for _, person := range persons {
-		for _, relationship := range person.Relationships {
-			if relationship.SecundePersonID == relative.ID {
-				// Verifica se a pessoa já foi adicionada e não é o Root
-				if !tg.alreadyInFamily(person, relatives) {
-					re := tg.newRelative(person, level, relatives, persons) // Assumindo que newRelative agora aceita relatives
-					relatives = append(relatives, re)                       // Adiciona o parente apenas uma vez
-				}
-				// Continua a busca por descendentes de maneira recursiva
-				relatives = tg.searchDescendants(ctx, person, persons, level+1, relatives)
-			}
+	walk(relative.ID, b.links(persons).children, func(id string, distance int) bool {
+		descendant := findPerson(id, persons)
+		if descendant == nil {
+			return false
 		}
-	}
+		if !b.alreadyInFamily(descendant, relatives) {
+			relatives = append(relatives, b.newRelative(descendant, level+distance-1, persons))
+		}
+		return true
+	})
 	return relatives
 }
 
-// Busca por parentes de maneira recursiva.
-func (tg *TreeGenealogical) searchForRelatives(ctx context.Context, relative *entity.Person, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
+// Busca pelos descendentes da pessoa que ainda não estão na lista, sem passar pelo Root.
+func (b *treeBuilder) searchForRelatives(ctx context.Context, relative *entity.Person, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
 	if relative == nil {
 		return relatives
 	}
-	for _, person := range persons {
-		for _, relationship := range person.Relationships {
-			if relationship.SecundePersonID == relative.ID {
-				// Verifica se a pessoa já foi adicionada e não é o Root
-				if !tg.alreadyInFamily(person, relatives) && tg.Root.ID != person.ID {
-					// Se não estiver na lista, adicione e continue a busca recursiva
-					re := tg.newRelative(person, level, relatives, persons)
-					relatives = append(relatives, re) // Adiciona uma única vez
-
-					// Continua a busca por mais parentes sem passar o mesmo slice modificado
-					relatives = tg.searchForRelatives(ctx, person, persons, level+1, relatives)
-				}
-			}
+	walk(relative.ID, b.links(persons).children, func(id string, distance int) bool {
+		person := findPerson(id, persons)
+		if person == nil || b.root.ID == person.ID || b.alreadyInFamily(person, relatives) {
+			return false
 		}
-	}
+		relatives = append(relatives, b.newRelative(person, level+distance-1, persons))
+		return true
+	})
 	return relatives
 }
 
-// Encontra o pais do relative (Parente interado no momento).
-func (tg *TreeGenealogical) findParents(relative *entity.Person, relatives []*entity.Relative) *entity.Relative {
-	fmt.Println("findParents")
-	if relative == nil {
-		return nil
-	}
-	for _, p := range relatives {
-		if p.Person == nil {
-			continue
-		}
-		for _, relationship := range p.Person.Relationships {
-			if relative.ID == relationship.SecundePersonID {
-				return p
-			}
-		}
+// Ligações de pais e filhos da construção ou, fora dela, das pessoas informadas.
+func (b *treeBuilder) links(persons []*entity.Person) *kinshipCalculator {
+	if b.kinship != nil {
+		return b.kinship
 	}
-	return nil
+	return newKinshipCalculator(persons)
 }
 
-func (tg *TreeGenealogical) directRelationDescription(relative *entity.Person, persons []*entity.Person) string {
+func (b *treeBuilder) directRelationDescription(relative *entity.Person, persons []*entity.Person) string {
 	if relative == nil {
 		return ""
 	}
 	// Verifica se o relative é filho do Root.
-	if tg.isChildOfRoot(relative) {
+	if b.isChildOfRoot(relative) {
 		return descriptionBySex(son, relative.Gender)
 	}
 
 	// Verifica se o relative é pai ou mae do Root.
-	if tg.isParentOfRoot(relative) {
+	if b.isParentOfRoot(relative) {
 		return descriptionBySex(father, relative.Gender)
 	}
 
 	// Verifica se o relative é irmão do Root.
-	return tg.checkSiblingRelation(relative, persons)
+	return b.checkSiblingRelation(relative, persons)
 }
 
 // Verifica se a pessoa é filho(a) do Root.
-func (tg *TreeGenealogical) isChildOfRoot(relative *entity.Person) bool {
+func (b *treeBuilder) isChildOfRoot(relative *entity.Person) bool {
 	for _, relationship := range relative.Relationships {
-		if relationship.SecundePersonID == tg.Root.ID {
+		if relationship.SecundePersonID == b.root.ID {
 			return true
 		}
 	}
@@ -248,8 +255,8 @@ func (tg *TreeGenealogical) isChildOfRoot(relative *entity.Person) bool {
 }
 
 // Verifica se a pessoa é pai/mãe do Root.
-func (tg *TreeGenealogical) isParentOfRoot(relative *entity.Person) bool {
-	for _, relationship := range tg.Root.Relationships {
+func (b *treeBuilder) isParentOfRoot(relative *entity.Person) bool {
+	for _, relationship := range b.root.Relationships {
 		if relationship.SecundePersonID == relative.ID {
 			return true
 		}
@@ -257,40 +264,31 @@ func (tg *TreeGenealogical) isParentOfRoot(relative *entity.Person) bool {
 	return false
 }
 
-// Verifica se a pessoa é irmão do Root.
-func (tg *TreeGenealogical) checkSiblingRelation(relative *entity.Person, persons []*entity.Person) string {
-	for _, relationship := range tg.Root.Relationships {
-		for _, relationshipParent := range relative.Relationships {
-			if relationshipParent.SecundePersonID == relationship.SecundePersonID {
-				return descriptionBySex(brother, relative.Gender)
-			}
-		}
+// Verifica se a pessoa é irmão ou meio-irmão do Root.
+func (b *treeBuilder) checkSiblingRelation(relative *entity.Person, persons []*entity.Person) string {
+	rootParents, relativeParents := parentIDs(b.root), parentIDs(relative)
+	if !sharesAny(rootParents, relativeParents) {
+		return ""
+	}
+	if isHalfSibling(rootParents, relativeParents) {
+		return descriptionBySex(halfBrother, relative.Gender)
 	}
-	return ""
+	return descriptionBySex(brother, relative.Gender)
 }
 
-// Encontra o parente que é filho do relative (Parente interado no momento).
-func (tg *TreeGenealogical) findChildren(relative *entity.Person, relatives []*entity.Relative) *entity.Relative {
-	if relative == nil {
-		return nil
+// IDs dos pais cadastrados da pessoa.
+func parentIDs(person *entity.Person) []string {
+	ids := make([]string, 0, len(person.Relationships))
+	for _, relationship := range person.Relationships {
+		ids = append(ids, relationship.SecundePersonID)
 	}
-	for _, p := range relatives {
-		for _, relationship := range relative.Relationships {
-			if p.Person == nil {
-				continue
-			}
-			if p.Person.ID == relationship.SecundePersonID {
-				return p
-			}
-		}
-	}
-	return nil
+	return ids
 }
 
 // Cria um novo parente com base no relative e o adiciona à lista de parentes.
-func (tg *TreeGenealogical) newRelative(person *entity.Person, level int, relatives []*entity.Relative, persons []*entity.Person) *entity.Relative {
+func (b *treeBuilder) newRelative(person *entity.Person, level int, persons []*entity.Person) *entity.Relative {
 	relative := &entity.Relative{
-		Type:   tg.relationshipDescription(person, relatives, persons),
+		Type:   b.relationshipDescription(person, persons),
 		Level:  level,
 		Person: person,
 	}
@@ -298,7 +296,7 @@ func (tg *TreeGenealogical) newRelative(person *entity.Person, level int, relati
 }
 
 // Verifica se o parente já está na lista de parentes.
-func (tg *TreeGenealogical) alreadyInFamily(relative *entity.Person, relatives []*entity.Relative) bool {
+func (b *treeBuilder) alreadyInFamily(relative *entity.Person, relatives []*entity.Relative) bool {
 	for _, p := range relatives {
 		if p.Person == nil {
 			continue
@@ -321,19 +319,6 @@ func descriptionBySex(relative, gender string) string {
 	return unknownRelation
 }
 
-// Verifica se o tipo de parentesco é válido.
-func isTypeOfTypeKinship(typeKinship, p string) bool {
-	if _, ok := kinshipTypes[p]; !ok {
-		return false
-	}
-	for _, value := range kinshipTypes[p] {
-		if typeKinship == value {
-			return true
-		}
-	}
-	return false
-}
-
 // Encontra a pessoa com base no ID.
 func findPerson(ID string, persons []*entity.Person) *entity.Person {
 	for _, person := range persons {
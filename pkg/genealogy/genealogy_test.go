@@ -2,6 +2,7 @@ package genealogy
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
@@ -56,48 +57,82 @@ func (suite *GenealogyTestSuite) TestBuildFamilyTree() {
 
 	suite.Run("should return the populated Root and Relative properties", func() {
 		familytree := NewFamilyTree()
-		family := familytree.BuildFamilyTree(ctx, suite.root, suite.persons, 0)
-		assert.Equal(suite.T(), "Phoebe", familytree.Root.Name)
-		assert.NotEmpty(suite.T(), family)
-		assert.NotEmpty(suite.T(), familytree.Relatives)
+		family := familytree.BuildFamilyTree(ctx, suite.root, suite.persons, 0, entity.TreeModeAll)
+		assert.Equal(suite.T(), "Phoebe", family.Root().Name)
+		assert.NotEmpty(suite.T(), family.Relatives())
 	})
 
 	suite.Run("should return the Relative and family property only with root", func() {
 		familytree := NewFamilyTree()
-		family := familytree.BuildFamilyTree(ctx, suite.root, []*entity.Person{}, 0)
+		family := familytree.BuildFamilyTree(ctx, suite.root, []*entity.Person{}, 0, entity.TreeModeAll).Relatives()
 		assert.Len(suite.T(), family, 1)
-		assert.Len(suite.T(), familytree.Relatives, 1)
 		assert.Equal(suite.T(), "Phoebe", family[0].Person.Name)
 		assert.Equal(suite.T(), "Root", family[0].Type)
 	})
 }
 
-func (suite *GenealogyTestSuite) TestGetRelatives() {
+func (suite *GenealogyTestSuite) TestRelatives() {
 	ctx := context.Background()
 
 	suite.Run("should return the relatives of the root", func() {
 		familytree := NewFamilyTree()
-		_ = familytree.BuildFamilyTree(ctx, suite.root, suite.persons, 0)
-		relatives := familytree.GetRelatives(ctx)
+		relatives := familytree.BuildFamilyTree(ctx, suite.root, suite.persons, 0, entity.TreeModeAll).Relatives()
 		assert.Len(suite.T(), relatives, 4)
 	})
+
+	suite.Run("should not change the tree when the returned relatives are modified", func() {
+		family := NewFamilyTree().BuildFamilyTree(ctx, suite.root, suite.persons, 0, entity.TreeModeAll)
+		relatives := family.Relatives()
+		relatives[0].Type = "Changed"
+		_ = append(relatives[:0], relatives[1:]...)
+		assert.Equal(suite.T(), "Root", family.Relatives()[0].Type)
+		assert.Len(suite.T(), family.Relatives(), 4)
+	})
+}
+
+func (suite *GenealogyTestSuite) TestBuildFamilyTreeConcurrently() {
+	ctx := context.Background()
+
+	suite.Run("should keep each concurrent computation isolated", func() {
+		familytree := NewFamilyTree()
+		roots := []*entity.Person{suite.persons[0], suite.persons[2], suite.persons[3]}
+
+		expected := map[string][]*entity.Relative{}
+		for _, root := range roots {
+			expected[root.ID] = familytree.BuildFamilyTree(ctx, root, suite.persons, 0, entity.TreeModeAll).Relatives()
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			for _, root := range roots {
+				wg.Add(1)
+				go func(root *entity.Person) {
+					defer wg.Done()
+					family := familytree.BuildFamilyTree(ctx, root, suite.persons, 0, entity.TreeModeAll)
+					relatives := family.Relatives()
+					assert.Equal(suite.T(), root.ID, family.Root().ID)
+					assert.Equal(suite.T(), root.ID, relatives[0].Person.ID)
+					assert.Equal(suite.T(), expected[root.ID], relatives)
+				}(root)
+			}
+		}
+		wg.Wait()
+	})
 }
 
 func (suite *GenealogyTestSuite) TestSearchDescendants() {
 	ctx := context.Background()
 
 	suite.Run("should return the descendants of the root", func() {
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
+		familytree := newTreeBuilder(suite.root)
 		relatives := []*entity.Relative{}
-		descendants := familytree.searchDescendants(ctx, familytree.Root, suite.persons, 0, relatives)
+		descendants := familytree.searchDescendants(ctx, familytree.root, suite.persons, 0, relatives)
 		assert.Len(suite.T(), descendants, 1)
 		assert.Equal(suite.T(), "Bruce", descendants[0].Person.Name)
 	})
 
 	suite.Run("should must return the original relatives when the alanised relative was nil", func() {
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
+		familytree := newTreeBuilder(suite.root)
 		relatives := []*entity.Relative{}
 		descendants := familytree.searchDescendants(ctx, nil, suite.persons, 0, relatives)
 		assert.Len(suite.T(), descendants, 0)
@@ -107,8 +142,7 @@ func (suite *GenealogyTestSuite) TestSearchDescendants() {
 func (suite *GenealogyTestSuite) TestSearchAncestors() {
 	ctx := context.Background()
 	suite.Run("should return the ancestors of the root", func() {
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
+		familytree := newTreeBuilder(suite.root)
 		relatives := []*entity.Relative{}
 		ancestors := familytree.searchAncestors(ctx, suite.root, suite.persons, 0, relatives)
 		assert.Len(suite.T(), ancestors, 2)
@@ -117,8 +151,7 @@ func (suite *GenealogyTestSuite) TestSearchAncestors() {
 	})
 
 	suite.Run("should must return the original relatives when the alanised relative was nil", func() {
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
+		familytree := newTreeBuilder(suite.root)
 		relatives := []*entity.Relative{}
 		ancestors := familytree.searchAncestors(ctx, nil, suite.persons, 0, relatives)
 		assert.Len(suite.T(), ancestors, 0)
@@ -128,8 +161,7 @@ func (suite *GenealogyTestSuite) TestSearchAncestors() {
 func (suite *GenealogyTestSuite) TestSearchForRelatives() {
 	ctx := context.Background()
 	suite.Run("should return the relatives of the root", func() {
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
+		familytree := newTreeBuilder(suite.root)
 		relatives := []*entity.Relative{}
 		relatives = familytree.searchForRelatives(ctx, suite.root, suite.persons, 0, relatives)
 		assert.Len(suite.T(), relatives, 1)
@@ -137,8 +169,7 @@ func (suite *GenealogyTestSuite) TestSearchForRelatives() {
 	})
 
 	suite.Run("should must return the original relatives when the alanised relative was nil", func() {
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
+		familytree := newTreeBuilder(suite.root)
 		relatives := []*entity.Relative{}
 		relatives = familytree.searchForRelatives(ctx, nil, suite.persons, 0, relatives)
 		assert.Len(suite.T(), relatives, 0)
@@ -185,15 +216,12 @@ func (suite *GenealogyTestSuite) TestRelationshipDescription() {
 			},
 		}
 
-		relatives := []*entity.Relative{}
-
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
-		description := familytree.relationshipDescription(anastasia, relatives, suite.persons)
+		familytree := newTreeBuilder(suite.root)
+		description := familytree.relationshipDescription(anastasia, suite.persons)
 		assert.Equal(suite.T(), "Mother", description)
 	})
 
-	suite.Run("should returns root's relationship to a new relative based on parent rules", func() {
+	suite.Run("should returns root's relationship to a new relative based on the closest common ancestor", func() {
 
 		anastasia.Relationships = []*entity.Relationship{
 			{
@@ -204,50 +232,31 @@ func (suite *GenealogyTestSuite) TestRelationshipDescription() {
 
 		suite.persons = append(suite.persons, anastasia, roberta)
 
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
-		familytree.Root.Relationships = []*entity.Relationship{
+		familytree := newTreeBuilder(suite.root)
+		familytree.root.Relationships = []*entity.Relationship{
 			{
 				MainPersonID:    suite.root.ID,
 				SecundePersonID: anastasia.ID,
 			},
 		}
-		relatives := []*entity.Relative{
-			{
-				Type:   "Mother",
-				Person: anastasia,
-				Level:  1,
-			},
-		}
-		description := familytree.relationshipDescription(roberta, relatives, suite.persons)
+		familytree.kinship = newKinshipCalculator(suite.persons)
+		description := familytree.relationshipDescription(roberta, suite.persons)
 		assert.Equal(suite.T(), "GrandMother", description)
 	})
 
-	suite.Run("should returns root's relationship to a new relative based on parent rules", func() {
+	suite.Run("should returns root's relationship to a new relative based on the closest common ancestor", func() {
 
 		suite.persons = append(suite.persons, anastasia, roberta, frida)
 
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
-		familytree.Root.Relationships = []*entity.Relationship{
+		familytree := newTreeBuilder(suite.root)
+		familytree.root.Relationships = []*entity.Relationship{
 			{
 				MainPersonID:    suite.root.ID,
 				SecundePersonID: anastasia.ID,
 			},
 		}
-		relatives := []*entity.Relative{
-			{
-				Type:   "Mother",
-				Person: anastasia,
-				Level:  1,
-			},
-			{
-				Type:   "GrandMother",
-				Person: roberta,
-				Level:  2,
-			},
-		}
-		description := familytree.relationshipDescription(frida, relatives, suite.persons)
+		familytree.kinship = newKinshipCalculator(suite.persons)
+		description := familytree.relationshipDescription(frida, suite.persons)
 		assert.Equal(suite.T(), "Aunt", description)
 	})
 
@@ -262,58 +271,23 @@ func (suite *GenealogyTestSuite) TestRelationshipDescription() {
 			},
 		}
 
-		familytree := NewFamilyTree()
-		familytree.Root = suite.root
-		relatives := []*entity.Relative{}
-		description := familytree.relationshipDescription(roberta, relatives, suite.persons)
+		familytree := newTreeBuilder(suite.root)
+		description := familytree.relationshipDescription(roberta, suite.persons)
 		assert.Equal(suite.T(), "Unknown Relation", description)
 	})
 }
 
-func (suite *GenealogyTestSuite) TestFindParents() {
-	ruff := &entity.Person{
-		ID:     "6",
-		Name:   "Ruff",
-		Gender: "M",
-	}
-	suite.Run("should return the parents of the root", func() {
-		familytree := NewFamilyTree()
-		relatives := familytree.BuildFamilyTree(context.Background(), suite.root, suite.persons, 0)
-		suite.root.Relationships[0].SecundePersonID = ruff.ID
-		parents := familytree.findParents(ruff, relatives)
-		assert.Equal(suite.T(), "Root", parents.Type)
-	})
-
-	suite.Run("should return empty when the root has no parents", func() {
-		familytree := NewFamilyTree()
-		relatives := familytree.BuildFamilyTree(context.Background(), suite.root, suite.persons, 0)
-		relatives[0].Person = nil
-		suite.root.Relationships[0].SecundePersonID = ruff.ID
-		parents := familytree.findParents(ruff, relatives)
-		assert.Empty(suite.T(), parents)
-	})
-
-	suite.Run("should return empty aa when the root has no parents", func() {
-		familytree := NewFamilyTree()
-		relatives := []*entity.Relative{}
-		parents := familytree.findParents(nil, relatives)
-		assert.Empty(suite.T(), parents)
-	})
-}
-
 func (suite *GenealogyTestSuite) TestDirectRelationDescription() {
 	suite.Run("should must return an empty string when parent is nil", func() {
-		familytree := NewFamilyTree()
+		familytree := newTreeBuilder(nil)
 		description := familytree.directRelationDescription(nil, suite.persons)
 		assert.Equal(suite.T(), "", description)
 	})
 }
 
 func (suite *GenealogyTestSuite) TestCheckSiblingRelation() {
-	ctx := context.Background()
 	suite.Run("should return root's sibling", func() {
-		familytree := NewFamilyTree()
-		_ = familytree.BuildFamilyTree(ctx, suite.root, suite.persons, 0)
+		familytree := newTreeBuilder(suite.root)
 		ruff := &entity.Person{
 			ID:            "6",
 			Name:          "Ruff",
@@ -326,26 +300,9 @@ func (suite *GenealogyTestSuite) TestCheckSiblingRelation() {
 
 }
 
-func (suite *GenealogyTestSuite) TestFindChildren() {
-	suite.Run("should return the parents of the root", func() {
-		familytree := NewFamilyTree()
-		relatives := familytree.BuildFamilyTree(context.Background(), suite.root, suite.persons, 0)
-		relatives[0].Person = nil
-		parents := familytree.findChildren(suite.root, relatives)
-		assert.NotNil(suite.T(), parents)
-	})
-
-	suite.Run("should return empty aa when the root has no parents", func() {
-		familytree := NewFamilyTree()
-		relatives := []*entity.Relative{}
-		parents := familytree.findChildren(nil, relatives)
-		assert.Empty(suite.T(), parents)
-	})
-}
-
 func (suite *GenealogyTestSuite) TestAlreadyInFamily() {
 	suite.Run("should return the parents of the root", func() {
-		familytree := NewFamilyTree()
+		familytree := newTreeBuilder(nil)
 		relatives := []*entity.Relative{
 			{
 				Type:   "Mother",
@@ -370,24 +327,16 @@ func (suite *GenealogyTestSuite) TestDescriptionBySex() {
 	})
 }
 
-func (suite *GenealogyTestSuite) TestIsTypeOfTypeKinship() {
-	suite.Run("should return the type invalid", func() {
-		check := isTypeOfTypeKinship("greatgretgrandfather", "F")
-		assert.Equal(suite.T(), false, check)
-	})
-
-	suite.Run("should return the type unknown", func() {
-		check := isTypeOfTypeKinship("Father", "a")
-		assert.Equal(suite.T(), false, check)
-	})
-
-	suite.Run("should return the type valid", func() {
-		check := isTypeOfTypeKinship("Father", "M")
-		assert.Equal(suite.T(), false, check)
-	})
-
-}
-
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(GenealogyTestSuite))
+	suite.Run(t, new(KinshipTestSuite))
+	suite.Run(t, new(UnionTestSuite))
+	suite.Run(t, new(InLawTestSuite))
+	suite.Run(t, new(StepTestSuite))
+	suite.Run(t, new(ModeTestSuite))
+	suite.Run(t, new(KinshipPathTestSuite))
+	suite.Run(t, new(CommonAncestorsTestSuite))
+	suite.Run(t, new(CoefficientTestSuite))
+	suite.Run(t, new(GenerationTestSuite))
+	suite.Run(t, new(NumberingTestSuite))
 }
@@ -0,0 +1,44 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Lista os ancestrais da pessoa agrupados por geração, até a profundidade informada.
+// Um ancestral alcançado por mais de um caminho aparece apenas na geração mais próxima.
+func (tg *TreeGenealogical) Ancestors(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.Generation {
+	k := newKinshipCalculator(personsInMode(persons, mode))
+	return generations(person, persons, depth, k.parents)
+}
+
+// Lista os descendentes da pessoa agrupados por geração, até a profundidade informada.
+func (tg *TreeGenealogical) Descendants(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.Generation {
+	k := newKinshipCalculator(personsInMode(persons, mode))
+	return generations(person, persons, depth, k.children)
+}
+
+// Agrupa por geração as pessoas alcançadas a partir da pessoa pelas ligações informadas (pais ou filhos).
+func generations(person *entity.Person, persons []*entity.Person, depth int, next map[string][]string) []*entity.Generation {
+	if person == nil {
+		return nil
+	}
+
+	byID := personsByID(persons)
+
+	result := []*entity.Generation{{Level: 0, Persons: []*entity.Person{person}}}
+	walk(person.ID, next, func(id string, distance int) bool {
+		p := byID[id]
+		if p == nil || distance > depth {
+			return false
+		}
+		if distance == len(result) {
+			result = append(result, &entity.Generation{Level: distance})
+		}
+		result[distance].Persons = append(result[distance].Persons, p)
+		return true
+	})
+
+	return result
+}
@@ -0,0 +1,98 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerationTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *GenerationTestSuite) SetupTest() {
+	suite.persons, _ = loadPersons()
+	suite.family = map[string]*entity.Person{}
+	for _, p := range suite.persons {
+		suite.family[p.Name] = p
+	}
+
+	oscar := NewPerson("Oscar", "M", "", "")
+	suite.family["Martin"].Relationships = append(suite.family["Martin"].Relationships, &entity.Relationship{MainPersonID: suite.family["Martin"].ID, SecundePersonID: oscar.ID})
+	dylan := NewPerson("Dylan", "M", "", suite.family["Phoebe"].ID)
+	adopted := NewPerson("Adopted", "F", suite.family["Bruce"].ID, "")
+	adopted.Relationships[0].Parentage = entity.ParentageAdoptive
+	suite.persons = append(suite.persons, oscar, dylan, adopted)
+	for _, p := range []*entity.Person{oscar, dylan, adopted} {
+		suite.family[p.Name] = p
+	}
+}
+
+func names(generation *entity.Generation) []string {
+	result := make([]string, 0, len(generation.Persons))
+	for _, p := range generation.Persons {
+		result = append(result, p.Name)
+	}
+	return result
+}
+
+func (suite *GenerationTestSuite) TestAncestors() {
+	ctx := context.Background()
+
+	suite.Run("should group the ancestors by generation", func() {
+		generations := NewFamilyTree().Ancestors(ctx, suite.family["Bruce"], suite.persons, 5, entity.TreeModeAll)
+
+		suite.Len(generations, 4)
+		suite.Equal([]string{"Bruce"}, names(generations[0]))
+		suite.Equal([]string{"Phoebe"}, names(generations[1]))
+		suite.Equal([]string{"Martin", "Anastasia"}, names(generations[2]))
+		suite.Equal([]string{"Oscar"}, names(generations[3]))
+		suite.Equal(3, generations[3].Level)
+	})
+
+	suite.Run("should stop at the depth", func() {
+		generations := NewFamilyTree().Ancestors(ctx, suite.family["Bruce"], suite.persons, 1, entity.TreeModeAll)
+
+		suite.Len(generations, 2)
+		suite.Equal([]string{"Phoebe"}, names(generations[1]))
+	})
+
+	suite.Run("should return only the person without ancestors", func() {
+		generations := NewFamilyTree().Ancestors(ctx, suite.family["Oscar"], suite.persons, 5, entity.TreeModeAll)
+
+		suite.Len(generations, 1)
+	})
+
+	suite.Run("should return nil when the person is nil", func() {
+		suite.Nil(NewFamilyTree().Ancestors(ctx, nil, suite.persons, 5, entity.TreeModeAll))
+	})
+}
+
+func (suite *GenerationTestSuite) TestDescendants() {
+	ctx := context.Background()
+
+	suite.Run("should group the descendants by generation", func() {
+		generations := NewFamilyTree().Descendants(ctx, suite.family["Martin"], suite.persons, 5, entity.TreeModeAll)
+
+		suite.Len(generations, 4)
+		suite.Equal([]string{"Phoebe"}, names(generations[1]))
+		suite.Equal([]string{"Bruce", "Dylan"}, names(generations[2]))
+		suite.Equal([]string{"Adopted"}, names(generations[3]))
+	})
+
+	suite.Run("should ignore parents outside the tree mode", func() {
+		generations := NewFamilyTree().Descendants(ctx, suite.family["Martin"], suite.persons, 5, entity.TreeModeBiological)
+
+		suite.Len(generations, 3)
+	})
+
+	suite.Run("should stop at the depth", func() {
+		generations := NewFamilyTree().Descendants(ctx, suite.family["Oscar"], suite.persons, 2, entity.TreeModeAll)
+
+		suite.Len(generations, 3)
+		suite.Equal([]string{"Phoebe"}, names(generations[2]))
+	})
+}
@@ -0,0 +1,121 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Parentesco por afinidade encontrado entre duas pessoas.
+type affinity struct {
+	description string
+	distance    int
+}
+
+// Busca os parentes por afinidade (sogros, cunhados, genros, concunhados dos filhos) do Root
+// que ainda não estão na árvore.
+func (b *treeBuilder) searchInLaws(ctx context.Context, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
+	if b.root == nil || b.kinship == nil {
+		return relatives
+	}
+	for _, person := range persons {
+		if person.ID == b.root.ID || b.alreadyInFamily(person, relatives) {
+			continue
+		}
+		a, ok := b.kinship.inLaw(b.root.ID, person)
+		if !ok {
+			continue
+		}
+		relatives = append(relatives, &entity.Relative{
+			Type:   a.description,
+			Level:  level - 1 + a.distance,
+			Person: person,
+			InLaw:  true,
+		})
+	}
+	return relatives
+}
+
+// Determina o parentesco por afinidade de relative em relação a person. Considera, nessa ordem,
+// os consanguíneos do cônjuge, os cônjuges dos consanguíneos e os pais dos cônjuges dos filhos.
+func (k *kinshipCalculator) inLaw(personID string, relative *entity.Person) (affinity, bool) {
+	// Consanguíneos do cônjuge (sogros, cunhados...). Descendentes do cônjuge são enteados.
+	for _, spouseID := range k.spouses[personID] {
+		if spouseID == relative.ID {
+			continue
+		}
+		_, up, down, ok := k.closestCommonAncestor(spouseID, relative.ID)
+		if !ok || up == 0 {
+			continue
+		}
+		if description := inLawTerm(up, down, relative.Gender); description != unknownRelation {
+			return affinity{description: description, distance: 1 + up + down}, true
+		}
+	}
+
+	// Cônjuges dos consanguíneos (genros, cunhados...). Cônjuges dos ancestrais são padrastos.
+	for _, spouseID := range k.spouses[relative.ID] {
+		if spouseID == personID {
+			continue
+		}
+		_, up, down, ok := k.closestCommonAncestor(personID, spouseID)
+		if !ok || down == 0 {
+			continue
+		}
+		if description := spouseOfRelativeTerm(up, down, relative.Gender); description != unknownRelation {
+			return affinity{description: description, distance: up + down + 1}, true
+		}
+	}
+
+	// Pais do cônjuge de um filho.
+	for _, childID := range k.children[personID] {
+		for _, childSpouseID := range k.spouses[childID] {
+			for _, parentID := range k.parents[childSpouseID] {
+				if parentID == relative.ID && parentID != personID {
+					if description := descriptionBySex(coParentInLaw, relative.Gender); description != unknownRelation {
+						return affinity{description: description, distance: 3}, true
+					}
+				}
+			}
+		}
+	}
+
+	return affinity{}, false
+}
+
+// Tipo por afinidade equivalente a cada tipo consanguíneo.
+var inLawTypes = map[string]string{
+	father:                fatherInLaw,
+	grandFather:           grandFatherInLaw,
+	greatGrandfather:      greatGrandfatherInLaw,
+	greatGreatGrandfather: greatGreatGrandfatherInLaw,
+	son:                   sonInLaw,
+	grandSon:              grandSonInLaw,
+	greatGrandSon:         greatGrandSonInLaw,
+	brother:               brotherInLaw,
+	uncle:                 uncleInLaw,
+	greatUncle:            greatUncleInLaw,
+	nephew:                nephewInLaw,
+	grandNephew:           grandNephewInLaw,
+	cousin:                cousinInLaw,
+}
+
+// Tipo do cônjuge de um tio ou tio-avô (UncleByMarriage, AuntByMarriage).
+var byMarriageTypes = map[string]string{
+	uncle:      uncleByMarriage,
+	greatUncle: greatUncleByMarriage,
+}
+
+// Converte as gerações até o ancestral comum no termo por afinidade.
+func inLawTerm(up, down int, gender string) string {
+	return relationTerm(up, down, gender, inLawTypes)
+}
+
+// Termo do cônjuge de um consanguíneo. O cônjuge de um tio ou tio-avô é tio por casamento;
+// nos demais casos vale o termo por afinidade.
+func spouseOfRelativeTerm(up, down int, gender string) string {
+	if up < 2 || down != 1 {
+		return inLawTerm(up, down, gender)
+	}
+	return relationTerm(up, down, gender, byMarriageTypes)
+}
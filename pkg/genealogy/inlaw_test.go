@@ -0,0 +1,117 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type InLawTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *InLawTestSuite) SetupTest() {
+	suite.family = map[string]*entity.Person{}
+	suite.persons = nil
+	add := func(name, gender string, parents ...string) {
+		p := NewPerson(name, gender, "", "")
+		for _, parent := range parents {
+			p.Relationships = append(p.Relationships, &entity.Relationship{MainPersonID: p.ID, SecundePersonID: suite.family[parent].ID})
+		}
+		suite.family[name] = p
+		suite.persons = append(suite.persons, p)
+	}
+
+	add("Carl", "M")
+	add("Rose", "F")
+	add("Paul", "M", "Carl", "Rose")
+	add("Sue", "F", "Carl", "Rose")
+	add("Tom", "M")
+	add("Frank", "M")
+	add("Grace", "F")
+	add("Linda", "F", "Frank", "Grace")
+	add("Ben", "M", "Frank", "Grace")
+	add("Kate", "F")
+	add("Max", "M", "Paul", "Linda")
+	add("Otto", "M")
+	add("Ida", "F")
+	add("Eva", "F", "Otto", "Ida")
+
+	unite(entity.RelationshipKindSpouse, suite.family["Carl"], suite.family["Rose"], nil)
+	unite(entity.RelationshipKindSpouse, suite.family["Paul"], suite.family["Linda"], nil)
+	unite(entity.RelationshipKindSpouse, suite.family["Sue"], suite.family["Tom"], nil)
+	unite(entity.RelationshipKindSpouse, suite.family["Ben"], suite.family["Kate"], nil)
+	unite(entity.RelationshipKindSpouse, suite.family["Max"], suite.family["Eva"], nil)
+	unite(entity.RelationshipKindSpouse, suite.family["Otto"], suite.family["Ida"], nil)
+}
+
+func (suite *InLawTestSuite) TestDetermineRelationship() {
+	ctx := context.Background()
+	tests := []struct {
+		person   string
+		relative string
+		expected string
+	}{
+		{"Paul", "Grace", "MotherInLaw"},
+		{"Paul", "Frank", "FatherInLaw"},
+		{"Paul", "Ben", "BrotherInLaw"},
+		{"Paul", "Tom", "BrotherInLaw"},
+		{"Linda", "Sue", "SisterInLaw"},
+		{"Linda", "Carl", "FatherInLaw"},
+		{"Paul", "Eva", "DaughterInLaw"},
+		{"Frank", "Paul", "SonInLaw"},
+		{"Carl", "Linda", "DaughterInLaw"},
+		{"Carl", "Eva", "GrandDaughterInLaw"},
+		{"Eva", "Rose", "GrandMotherInLaw"},
+		{"Eva", "Sue", "AuntInLaw"},
+		{"Sue", "Eva", "NieceInLaw"},
+		{"Max", "Tom", "UncleByMarriage"},
+		{"Max", "Kate", "AuntByMarriage"},
+		{"Paul", "Otto", "CoFatherInLaw"},
+		{"Linda", "Ida", "CoMotherInLaw"},
+		{"Paul", "Kate", "unrelated"},
+		{"Paul", "Linda", "Wife"},
+		{"Paul", "Sue", "Sister"},
+	}
+
+	tg := NewFamilyTree()
+	for _, tt := range tests {
+		suite.Run(tt.person+" to "+tt.relative, func() {
+			got := tg.DetermineRelationship(ctx, suite.family[tt.person], suite.family[tt.relative], suite.persons)
+			suite.Equal(tt.expected, got)
+		})
+	}
+}
+
+func (suite *InLawTestSuite) TestDivorceEndsAffinity() {
+	suite.family["Paul"].Unions[0].End = &entity.RelationshipEvent{Date: "2000"}
+
+	got := NewFamilyTree().DetermineRelationship(context.Background(), suite.family["Paul"], suite.family["Grace"], suite.persons)
+	suite.Equal("unrelated", got)
+}
+
+func (suite *InLawTestSuite) TestBuildFamilyTree() {
+	tree := NewFamilyTree().BuildFamilyTree(context.Background(), suite.family["Paul"], suite.persons, 1, entity.TreeModeAll)
+
+	relatives := map[string]*entity.Relative{}
+	for _, relative := range tree.Relatives() {
+		relatives[relative.Person.Name] = relative
+	}
+
+	suite.Equal("Wife", relatives["Linda"].Type)
+	suite.False(relatives["Linda"].InLaw)
+	suite.Equal("Son", relatives["Max"].Type)
+	suite.False(relatives["Max"].InLaw)
+
+	for name, expected := range map[string]string{"Grace": "MotherInLaw", "Ben": "BrotherInLaw", "Tom": "BrotherInLaw", "Eva": "DaughterInLaw", "Ida": "CoMotherInLaw"} {
+		suite.Require().Contains(relatives, name)
+		suite.Equal(expected, relatives[name].Type, name)
+		suite.True(relatives[name].InLaw, name)
+	}
+
+	suite.Equal(2, relatives["Grace"].Level)
+	suite.NotContains(relatives, "Kate")
+}
@@ -0,0 +1,279 @@
+package genealogy
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+var numbers = []string{"", "One", "Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten",
+	"Eleven", "Twelve", "Thirteen", "Fourteen", "Fifteen", "Sixteen", "Seventeen", "Eighteen", "Nineteen"}
+
+var ordinals = []string{"", "First", "Second", "Third", "Fourth", "Fifth", "Sixth", "Seventh", "Eighth", "Ninth", "Tenth",
+	"Eleventh", "Twelfth", "Thirteenth", "Fourteenth", "Fifteenth", "Sixteenth", "Seventeenth", "Eighteenth", "Nineteenth"}
+
+var tens = []string{"", "", "Twenty", "Thirty", "Forty", "Fifty", "Sixty", "Seventy", "Eighty", "Ninety"}
+
+var tenths = []string{"", "", "Twentieth", "Thirtieth", "Fortieth", "Fiftieth", "Sixtieth", "Seventieth", "Eightieth", "Ninetieth"}
+
+// Calcula o parentesco consanguíneo a partir dos ancestrais em comum de duas pessoas.
+// Também guarda filhos e cônjuges atuais para o parentesco por afinidade.
+type kinshipCalculator struct {
+	parents  map[string][]string
+	children map[string][]string
+	spouses  map[string][]string
+}
+
+func newKinshipCalculator(persons []*entity.Person) *kinshipCalculator {
+	k := &kinshipCalculator{
+		parents:  map[string][]string{},
+		children: map[string][]string{},
+		spouses:  map[string][]string{},
+	}
+	for _, person := range persons {
+		for _, relationship := range person.Relationships {
+			k.parents[person.ID] = append(k.parents[person.ID], relationship.SecundePersonID)
+			k.children[relationship.SecundePersonID] = append(k.children[relationship.SecundePersonID], person.ID)
+		}
+		for _, union := range person.Unions {
+			if union.Ended() {
+				continue
+			}
+			if other := union.OtherPersonID(person.ID); other != "" && !contains(k.spouses[person.ID], other) {
+				k.spouses[person.ID] = append(k.spouses[person.ID], other)
+			}
+		}
+	}
+	return k
+}
+
+// Retorna cada ancestral da pessoa (incluindo ela mesma) com o menor número de gerações até ele.
+func (k *kinshipCalculator) ancestors(personID string) map[string]int {
+	generations := map[string]int{personID: 0}
+	walk(personID, k.parents, func(id string, distance int) bool {
+		generations[id] = distance
+		return true
+	})
+	return generations
+}
+
+// Percorre em largura as ligações informadas (pais ou filhos) a partir da pessoa. Cada pessoa
+// alcançada é visitada uma única vez, com a menor distância até ela; a busca só continua a
+// partir das pessoas para as quais visit retorna true.
+func walk(personID string, next map[string][]string, visit func(id string, distance int) bool) {
+	visited := map[string]bool{personID: true}
+	current := []string{personID}
+	for distance := 1; len(current) > 0; distance++ {
+		var following []string
+		for _, id := range current {
+			for _, nextID := range next[id] {
+				if visited[nextID] {
+					continue
+				}
+				visited[nextID] = true
+				if visit(nextID, distance) {
+					following = append(following, nextID)
+				}
+			}
+		}
+		current = following
+	}
+}
+
+// Encontra o ancestral comum mais próximo e as gerações de cada lado até ele.
+func (k *kinshipCalculator) closestCommonAncestor(personID, relativeID string) (ancestorID string, up, down int, ok bool) {
+	personAncestors := k.ancestors(personID)
+	relativeAncestors := k.ancestors(relativeID)
+
+	type candidate struct {
+		id       string
+		up, down int
+	}
+	var candidates []candidate
+	for id, a := range personAncestors {
+		if b, found := relativeAncestors[id]; found {
+			candidates = append(candidates, candidate{id: id, up: a, down: b})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", 0, 0, false
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ci.up+ci.down != cj.up+cj.down {
+			return ci.up+ci.down < cj.up+cj.down
+		}
+		if abs(ci.up-ci.down) != abs(cj.up-cj.down) {
+			return abs(ci.up-ci.down) < abs(cj.up-cj.down)
+		}
+		return ci.id < cj.id
+	})
+
+	best := candidates[0]
+	return best.id, best.up, best.down, true
+}
+
+// Retorna o parentesco de relative em relação a person, ou vazio se não houver ancestral em comum.
+func (k *kinshipCalculator) describe(person, relative *entity.Person) string {
+	_, up, down, ok := k.closestCommonAncestor(person.ID, relative.ID)
+	if !ok {
+		return ""
+	}
+	if up == 1 && down == 1 && isHalfSibling(k.parents[person.ID], k.parents[relative.ID]) {
+		return descriptionBySex(halfBrother, relative.Gender)
+	}
+	return kinshipTerm(up, down, relative.Gender)
+}
+
+// Determina o parentesco de relative em relação a person: cônjuge ou companheiro, ou o parentesco
+// consanguíneo a partir do ancestral comum mais próximo.
+func (tg *TreeGenealogical) DetermineRelationship(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) string {
+	if person == nil || relative == nil {
+		return ""
+	}
+	if union := findUnion(person, relative.ID); union != nil {
+		return unionDescription(union, relative.Gender)
+	}
+	k := newKinshipCalculator(persons)
+	if description := k.describe(person, relative); description != "" {
+		return description
+	}
+	if a, ok := k.step(person.ID, relative); ok {
+		return a.description
+	}
+	if a, ok := k.inLaw(person.ID, relative); ok {
+		return a.description
+	}
+	return unrelated
+}
+
+// Converte as gerações até o ancestral comum no termo em inglês.
+// up é a distância de quem pergunta até o ancestral e down a distância do parente até ele.
+func kinshipTerm(up, down int, gender string) string {
+	if up == 0 && down == 0 {
+		return root
+	}
+	return relationTerm(up, down, gender, nil)
+}
+
+// Escolhe o tipo de parentesco para as gerações até o ancestral comum. Quando variant é informado,
+// cada tipo consanguíneo é trocado pelo tipo equivalente (ex.: Father por FatherInLaw); tipos sem
+// equivalente resultam em parentesco desconhecido.
+func relationTerm(up, down int, gender string, variant map[string]string) string {
+	typeOf := func(types ...string) []string {
+		if variant == nil {
+			return types
+		}
+		mapped := make([]string, len(types))
+		for i, t := range types {
+			mapped[i] = variant[t]
+		}
+		return mapped
+	}
+	switch {
+	case up == 0 && down == 0:
+		return unknownRelation
+	case up == 0:
+		return lineal(down, gender, typeOf(son, grandSon, greatGrandSon)...)
+	case down == 0:
+		return lineal(up, gender, typeOf(father, grandFather, greatGrandfather, greatGreatGrandfather)...)
+	case up == 1 && down == 1:
+		return descriptionBySex(typeOf(brother)[0], gender)
+	case up == 1:
+		return lineal(down-1, gender, typeOf(nephew, grandNephew)...)
+	case down == 1:
+		return lineal(up-1, gender, typeOf(uncle, greatUncle)...)
+	default:
+		return cousinTerm(min(up, down)-1, abs(up-down), gender, typeOf(cousin)[0])
+	}
+}
+
+// Escolhe o tipo da geração e adiciona "Great" para as gerações além do último tipo mapeado.
+func lineal(generation int, gender string, types ...string) string {
+	if generation <= len(types) {
+		return descriptionBySex(types[generation-1], gender)
+	}
+	description := descriptionBySex(types[len(types)-1], gender)
+	if description == unknownRelation {
+		return unknownRelation
+	}
+	return strings.Repeat("Great", generation-len(types)) + description
+}
+
+// Primo de n-ésimo grau, m vezes removido (ex.: FirstCousin, SecondCousinOnceRemoved).
+func cousinTerm(degree, removed int, gender, relative string) string {
+	description := descriptionBySex(relative, gender)
+	if description == unknownRelation {
+		return unknownRelation
+	}
+	term := ordinal(degree) + description
+	if removed > 0 {
+		term += removal(removed) + "Removed"
+	}
+	return term
+}
+
+// Número ordinal por extenso (ex.: Second, TwentyFirst, OneHundredth).
+func ordinal(n int) string {
+	switch {
+	case n < 20:
+		return ordinals[n]
+	case n < 100 && n%10 == 0:
+		return tenths[n/10]
+	case n < 100:
+		return tens[n/10] + ordinals[n%10]
+	case n < 1000 && n%100 == 0:
+		return numbers[n/100] + "Hundredth"
+	case n < 1000:
+		return numbers[n/100] + "Hundred" + ordinal(n%100)
+	case n%1000 == 0:
+		return cardinal(n/1000) + "Thousandth"
+	default:
+		return cardinal(n/1000) + "Thousand" + ordinal(n%1000)
+	}
+}
+
+// Número cardinal por extenso (ex.: Three, TwentyOne, OneHundred).
+func cardinal(n int) string {
+	switch {
+	case n < 20:
+		return numbers[n]
+	case n < 100:
+		return tens[n/10] + numbers[n%10]
+	case n < 1000:
+		return numbers[n/100] + "Hundred" + cardinal(n%100)
+	default:
+		return cardinal(n/1000) + "Thousand" + cardinal(n%1000)
+	}
+}
+
+// Quantidade de gerações de diferença entre os primos (ex.: Once, Twice, ThreeTimes).
+func removal(n int) string {
+	switch n {
+	case 1:
+		return "Once"
+	case 2:
+		return "Twice"
+	}
+	return cardinal(n) + "Times"
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,102 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type KinshipTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *KinshipTestSuite) SetupTest() {
+	suite.family = map[string]*entity.Person{}
+	add := func(name, gender, parentName string) {
+		parentID := ""
+		if parent, ok := suite.family[parentName]; ok {
+			parentID = parent.ID
+		}
+		p := NewPerson(name, gender, parentID, "")
+		suite.family[name] = p
+		suite.persons = append(suite.persons, p)
+	}
+
+	add("Ancestor", "M", "")
+	add("Arthur", "M", "Ancestor")
+	add("Beatrice", "F", "Ancestor")
+	add("Arthur1", "M", "Arthur")
+	add("Beatrice1", "F", "Beatrice")
+	add("Arthur2", "M", "Arthur1")
+	add("Beatrice2", "F", "Beatrice1")
+	add("Arthur3", "F", "Arthur2")
+	add("Arthur4", "M", "Arthur3")
+	add("Stranger", "M", "")
+}
+
+func (suite *KinshipTestSuite) TestDetermineRelationship() {
+	ctx := context.Background()
+	tests := []struct {
+		person   string
+		relative string
+		expected string
+	}{
+		{"Arthur", "Beatrice", "Sister"},
+		{"Arthur1", "Beatrice1", "FirstCousin"},
+		{"Arthur2", "Beatrice2", "SecondCousin"},
+		{"Arthur1", "Beatrice2", "FirstCousinOnceRemoved"},
+		{"Arthur3", "Beatrice2", "SecondCousinOnceRemoved"},
+		{"Beatrice1", "Arthur3", "FirstCousinTwiceRemoved"},
+		{"Arthur1", "Beatrice", "Aunt"},
+		{"Arthur2", "Beatrice", "GreatAunt"},
+		{"Arthur3", "Beatrice", "GreatGreatAunt"},
+		{"Beatrice", "Arthur1", "Nephew"},
+		{"Beatrice", "Arthur2", "GrandNephew"},
+		{"Beatrice", "Arthur3", "GreatGrandNiece"},
+		{"Arthur", "Arthur3", "GreatGrandDaughter"},
+		{"Arthur", "Arthur4", "GreatGreatGrandSon"},
+		{"Arthur4", "Ancestor", "GreatGreatGreatGrandFather"},
+		{"Arthur4", "Arthur3", "Mother"},
+		{"Arthur", "Stranger", "unrelated"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.person+" to "+tt.relative, func() {
+			description := NewFamilyTree().DetermineRelationship(ctx, suite.family[tt.person], suite.family[tt.relative], suite.persons)
+			suite.Equal(tt.expected, description)
+		})
+	}
+
+	suite.Run("should return empty when a person is nil", func() {
+		description := NewFamilyTree().DetermineRelationship(ctx, nil, suite.family["Arthur"], suite.persons)
+		suite.Empty(description)
+	})
+}
+
+func (suite *KinshipTestSuite) TestBuildFamilyTreeUsesKinship() {
+	suite.Run("should label distant relatives instead of unknown relation", func() {
+		relatives := NewFamilyTree().BuildFamilyTree(context.Background(), suite.family["Arthur2"], suite.persons, 0, entity.TreeModeAll).Relatives()
+		types := map[string]string{}
+		for _, relative := range relatives {
+			types[relative.Person.Name] = relative.Type
+		}
+		suite.Equal("SecondCousin", types["Beatrice2"])
+		suite.Equal("FirstCousinOnceRemoved", types["Beatrice1"])
+		suite.Equal("GreatAunt", types["Beatrice"])
+		suite.NotContains(types, "Stranger")
+	})
+}
+
+func (suite *KinshipTestSuite) TestOrdinalAndRemoval() {
+	suite.Equal("Twelfth", ordinal(12))
+	suite.Equal("Thirteenth", ordinal(13))
+	suite.Equal("Twentieth", ordinal(20))
+	suite.Equal("TwentyFirst", ordinal(21))
+	suite.Equal("OneHundredTwelfth", ordinal(112))
+	suite.Equal("TwoThousandth", ordinal(2000))
+	suite.Equal("Twice", removal(2))
+	suite.Equal("ElevenTimes", removal(11))
+}
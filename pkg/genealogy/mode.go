@@ -0,0 +1,29 @@
+package genealogy
+
+import "github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+
+// Retorna cópias das pessoas apenas com os relacionamentos de paternidade considerados no modo.
+func personsInMode(persons []*entity.Person, mode entity.TreeMode) []*entity.Person {
+	if mode == entity.TreeModeAll {
+		return persons
+	}
+	filtered := make([]*entity.Person, 0, len(persons))
+	for _, person := range persons {
+		filtered = append(filtered, personInMode(person, mode))
+	}
+	return filtered
+}
+
+func personInMode(person *entity.Person, mode entity.TreeMode) *entity.Person {
+	if person == nil || mode == entity.TreeModeAll {
+		return person
+	}
+	p := *person
+	p.Relationships = make([]*entity.Relationship, 0, len(person.Relationships))
+	for _, relationship := range person.Relationships {
+		if relationship.InMode(mode) {
+			p.Relationships = append(p.Relationships, relationship)
+		}
+	}
+	return &p
+}
@@ -0,0 +1,75 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModeTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *ModeTestSuite) SetupTest() {
+	suite.family = map[string]*entity.Person{}
+	suite.persons = nil
+	add := func(name, gender string) {
+		p := NewPerson(name, gender, "", "")
+		suite.family[name] = p
+		suite.persons = append(suite.persons, p)
+	}
+	link := func(child, parent, parentage string) {
+		c := suite.family[child]
+		c.Relationships = append(c.Relationships, &entity.Relationship{MainPersonID: c.ID, SecundePersonID: suite.family[parent].ID, Parentage: parentage})
+	}
+
+	add("Birth", "F")
+	add("Donor", "M")
+	add("Adopter", "F")
+	add("Foster", "M")
+	add("Child", "M")
+
+	link("Child", "Birth", "")
+	link("Child", "Donor", entity.ParentageDonor)
+	link("Child", "Adopter", entity.ParentageAdoptive)
+	link("Child", "Foster", entity.ParentageFoster)
+}
+
+func (suite *ModeTestSuite) members(mode entity.TreeMode) map[string]string {
+	tree := NewFamilyTree().BuildFamilyTree(context.Background(), suite.family["Child"], suite.persons, 0, mode)
+	types := map[string]string{}
+	for _, relative := range tree.Relatives() {
+		types[relative.Person.Name] = relative.Type
+	}
+	return types
+}
+
+func (suite *ModeTestSuite) TestBuildFamilyTree() {
+	suite.Run("should consider every parent by default", func() {
+		suite.Len(suite.members(entity.TreeModeAll), 5)
+	})
+
+	suite.Run("should consider only genetic parents in biological mode", func() {
+		types := suite.members(entity.TreeModeBiological)
+		suite.Equal("Mother", types["Birth"])
+		suite.Equal("Father", types["Donor"])
+		suite.NotContains(types, "Adopter")
+		suite.NotContains(types, "Foster")
+	})
+
+	suite.Run("should consider biological and adoptive parents in legal mode", func() {
+		types := suite.members(entity.TreeModeLegal)
+		suite.Equal("Mother", types["Birth"])
+		suite.Equal("Mother", types["Adopter"])
+		suite.NotContains(types, "Donor")
+		suite.NotContains(types, "Foster")
+	})
+
+	suite.Run("should not change the informed persons", func() {
+		suite.members(entity.TreeModeBiological)
+		suite.Len(suite.family["Child"].Relationships, 4)
+	})
+}
@@ -0,0 +1,140 @@
+package genealogy
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Numera os ancestrais da pessoa pelo sistema Ahnentafel até a profundidade informada, em ordem de número.
+// O pai recebe o número par e a mãe o ímpar; um pai sem sexo cadastrado ocupa a posição livre.
+func (tg *TreeGenealogical) Ahnentafel(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.NumberedPerson {
+	if person == nil {
+		return nil
+	}
+
+	byID := personsByID(persons)
+	k := newKinshipCalculator(personsInMode(persons, mode))
+
+	var result []*entity.NumberedPerson
+	numbers := map[int]*entity.Person{1: person}
+	current := []int{1}
+	for generation := 0; len(current) > 0; generation++ {
+		var next []int
+		for _, number := range current {
+			result = append(result, &entity.NumberedPerson{Number: strconv.Itoa(number), Generation: generation, Person: numbers[number]})
+			if generation == depth {
+				continue
+			}
+			for parentNumber, parent := range ahnentafelParents(number, k.parents[numbers[number].ID], byID) {
+				numbers[parentNumber] = parent
+				next = append(next, parentNumber)
+			}
+		}
+		sort.Ints(next)
+		current = next
+	}
+
+	return result
+}
+
+// Números Ahnentafel dos pais da pessoa de número n.
+func ahnentafelParents(n int, parentIDs []string, byID map[string]*entity.Person) map[int]*entity.Person {
+	parents := map[int]*entity.Person{}
+	var unknown []*entity.Person
+	for _, id := range parentIDs {
+		parent := byID[id]
+		switch {
+		case parent == nil:
+			continue
+		case parent.Gender == "M" && parents[2*n] == nil:
+			parents[2*n] = parent
+		case parent.Gender == "F" && parents[2*n+1] == nil:
+			parents[2*n+1] = parent
+		default:
+			unknown = append(unknown, parent)
+		}
+	}
+	for _, parent := range unknown {
+		for _, number := range []int{2 * n, 2*n + 1} {
+			if parents[number] == nil {
+				parents[number] = parent
+				break
+			}
+		}
+	}
+	return parents
+}
+
+// Numera os descendentes da pessoa pelo sistema d'Aboville até a profundidade informada.
+// Os filhos são ordenados pela data de nascimento, quando conhecida, e o resultado segue a ordem do relatório:
+// cada pessoa seguida dos seus descendentes.
+func (tg *TreeGenealogical) DAboville(ctx context.Context, person *entity.Person, persons []*entity.Person, depth int, mode entity.TreeMode) []*entity.NumberedPerson {
+	if person == nil {
+		return nil
+	}
+
+	byID := personsByID(persons)
+	k := newKinshipCalculator(personsInMode(persons, mode))
+
+	var result []*entity.NumberedPerson
+	var walk func(p *entity.Person, number string, generation int, path map[string]bool)
+	walk = func(p *entity.Person, number string, generation int, path map[string]bool) {
+		result = append(result, &entity.NumberedPerson{Number: number, Generation: generation, Person: p})
+		if generation == depth {
+			return
+		}
+		path[p.ID] = true
+		for i, child := range childrenByBirth(k.children[p.ID], byID) {
+			// Ignora ciclos cadastrados antes da validação de relacionamentos.
+			if path[child.ID] {
+				continue
+			}
+			walk(child, number+"."+strconv.Itoa(i+1), generation+1, path)
+		}
+		delete(path, p.ID)
+	}
+	walk(person, "1", 0, map[string]bool{})
+
+	return result
+}
+
+// Filhos em ordem de nascimento. Filhos sem data de nascimento ficam no fim, na ordem de cadastro.
+func childrenByBirth(ids []string, byID map[string]*entity.Person) []*entity.Person {
+	children := make([]*entity.Person, 0, len(ids))
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if child := byID[id]; child != nil && !seen[id] {
+			seen[id] = true
+			children = append(children, child)
+		}
+	}
+	sort.SliceStable(children, func(i, j int) bool {
+		bi, iok := birthTime(children[i])
+		bj, jok := birthTime(children[j])
+		if iok && jok {
+			return bi.Before(bj)
+		}
+		return iok && !jok
+	})
+	return children
+}
+
+func birthTime(p *entity.Person) (time.Time, bool) {
+	birth, ok := p.BirthDate()
+	if !ok {
+		return time.Time{}, false
+	}
+	return birth.Earliest()
+}
+
+func personsByID(persons []*entity.Person) map[string]*entity.Person {
+	byID := make(map[string]*entity.Person, len(persons))
+	for _, p := range persons {
+		byID[p.ID] = p
+	}
+	return byID
+}
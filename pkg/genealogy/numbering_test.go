@@ -0,0 +1,103 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type NumberingTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *NumberingTestSuite) SetupTest() {
+	suite.persons = nil
+	suite.family = map[string]*entity.Person{}
+	add := func(name, gender, fatherName, motherName, birth string) {
+		fatherID, motherID := "", ""
+		if father, ok := suite.family[fatherName]; ok {
+			fatherID = father.ID
+		}
+		if mother, ok := suite.family[motherName]; ok {
+			motherID = mother.ID
+		}
+		p := NewPerson(name, gender, fatherID, motherID)
+		if birth != "" {
+			p.Events = []*entity.Event{{Type: entity.EventBirth, Date: birth}}
+		}
+		suite.family[name] = p
+		suite.persons = append(suite.persons, p)
+	}
+
+	add("PaternalGrandmother", "F", "", "", "")
+	add("PaternalGrandfather", "M", "", "", "")
+	add("MaternalGrandfather", "M", "", "", "")
+	add("Father", "M", "PaternalGrandfather", "PaternalGrandmother", "")
+	add("Mother", "F", "MaternalGrandfather", "", "")
+	add("John", "M", "Father", "Mother", "1990")
+	add("Anna", "F", "Father", "Mother", "1985-03")
+	add("Paul", "M", "Father", "Mother", "")
+	add("Kid", "M", "John", "", "2015")
+}
+
+func numbered(persons []*entity.NumberedPerson) []string {
+	result := make([]string, 0, len(persons))
+	for _, p := range persons {
+		result = append(result, p.Number+" "+p.Person.Name)
+	}
+	return result
+}
+
+func (suite *NumberingTestSuite) TestAhnentafel() {
+	ctx := context.Background()
+
+	suite.Run("should number fathers as even and mothers as odd", func() {
+		persons := NewFamilyTree().Ahnentafel(ctx, suite.family["John"], suite.persons, 5, entity.TreeModeAll)
+
+		suite.Equal([]string{"1 John", "2 Father", "3 Mother", "4 PaternalGrandfather", "5 PaternalGrandmother", "6 MaternalGrandfather"}, numbered(persons))
+		suite.Equal(2, persons[3].Generation)
+	})
+
+	suite.Run("should stop at the depth", func() {
+		persons := NewFamilyTree().Ahnentafel(ctx, suite.family["John"], suite.persons, 1, entity.TreeModeAll)
+
+		suite.Equal([]string{"1 John", "2 Father", "3 Mother"}, numbered(persons))
+	})
+
+	suite.Run("should place a parent without gender in the free position", func() {
+		suite.family["Mother"].Gender = ""
+		persons := NewFamilyTree().Ahnentafel(ctx, suite.family["Kid"], suite.persons, 1, entity.TreeModeAll)
+
+		suite.Equal([]string{"1 Kid", "2 John"}, numbered(persons))
+		persons = NewFamilyTree().Ahnentafel(ctx, suite.family["John"], suite.persons, 1, entity.TreeModeAll)
+		suite.Equal([]string{"1 John", "2 Father", "3 Mother"}, numbered(persons))
+	})
+
+	suite.Run("should return nil when the person is nil", func() {
+		suite.Nil(NewFamilyTree().Ahnentafel(ctx, nil, suite.persons, 5, entity.TreeModeAll))
+	})
+}
+
+func (suite *NumberingTestSuite) TestDAboville() {
+	ctx := context.Background()
+
+	suite.Run("should number the children by birth after their parent", func() {
+		persons := NewFamilyTree().DAboville(ctx, suite.family["Father"], suite.persons, 5, entity.TreeModeAll)
+
+		suite.Equal([]string{"1 Father", "1.1 Anna", "1.2 John", "1.2.1 Kid", "1.3 Paul"}, numbered(persons))
+		suite.Equal(2, persons[3].Generation)
+	})
+
+	suite.Run("should stop at the depth", func() {
+		persons := NewFamilyTree().DAboville(ctx, suite.family["PaternalGrandfather"], suite.persons, 1, entity.TreeModeAll)
+
+		suite.Equal([]string{"1 PaternalGrandfather", "1.1 Father"}, numbered(persons))
+	})
+
+	suite.Run("should return nil when the person is nil", func() {
+		suite.Nil(NewFamilyTree().DAboville(ctx, nil, suite.persons, 5, entity.TreeModeAll))
+	})
+}
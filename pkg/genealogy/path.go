@@ -0,0 +1,66 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Encontra o menor caminho entre person e relative por busca em largura nas ligações de pais e filhos.
+// Retorna nil quando não há caminho entre as duas pessoas.
+func (tg *TreeGenealogical) KinshipPath(ctx context.Context, person, relative *entity.Person, persons []*entity.Person) *entity.KinshipPath {
+	if person == nil || relative == nil {
+		return nil
+	}
+
+	k := newKinshipCalculator(persons)
+	byID := personsByID(persons)
+	byID[person.ID] = person
+	byID[relative.ID] = relative
+
+	previous := map[string]string{person.ID: ""}
+	relations := map[string]string{}
+	queue := []string{person.ID}
+
+	for len(queue) > 0 && !hasKey(previous, relative.ID) {
+		current := queue[0]
+		queue = queue[1:]
+		visit := func(nextID, relation string) {
+			if hasKey(previous, nextID) || byID[nextID] == nil {
+				return
+			}
+			previous[nextID] = current
+			relations[nextID] = relation
+			queue = append(queue, nextID)
+		}
+		for _, parentID := range k.parents[current] {
+			visit(parentID, entity.KinshipChildOf)
+		}
+		for _, childID := range k.children[current] {
+			visit(childID, entity.KinshipParentOf)
+		}
+	}
+
+	if !hasKey(previous, relative.ID) {
+		return nil
+	}
+
+	// Reconstrói o caminho do parente até a pessoa e inverte a ordem.
+	steps := []*entity.KinshipStep{{Person: relative}}
+	for id := relative.ID; id != person.ID; id = previous[id] {
+		steps = append(steps, &entity.KinshipStep{
+			Person:   byID[previous[id]],
+			Relation: relations[id],
+		})
+	}
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+
+	return &entity.KinshipPath{Steps: steps}
+}
+
+func hasKey(m map[string]string, key string) bool {
+	_, ok := m[key]
+	return ok
+}
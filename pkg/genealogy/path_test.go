@@ -0,0 +1,84 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type KinshipPathTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *KinshipPathTestSuite) SetupTest() {
+	persons, _ := loadPersons()
+	suite.family = map[string]*entity.Person{}
+	for _, p := range persons {
+		suite.family[p.Name] = p
+	}
+
+	// Primos de segundo grau com um filho em comum, que liga as duas linhas por um caminho mais curto.
+	ancestor := NewPerson("Ancestor", "M", "", "")
+	arthur := NewPerson("Arthur", "M", ancestor.ID, "")
+	beatrice := NewPerson("Beatrice", "F", ancestor.ID, "")
+	arthur1 := NewPerson("Arthur1", "M", arthur.ID, "")
+	beatrice1 := NewPerson("Beatrice1", "F", beatrice.ID, "")
+	child := NewPerson("Child", "M", arthur1.ID, beatrice1.ID)
+	stranger := NewPerson("Stranger", "M", "", "")
+
+	for _, p := range []*entity.Person{ancestor, arthur, beatrice, arthur1, beatrice1, child, stranger} {
+		suite.family[p.Name] = p
+		persons = append(persons, p)
+	}
+	suite.persons = persons
+}
+
+func (suite *KinshipPathTestSuite) path(person, relative string) *entity.KinshipPath {
+	return NewFamilyTree().KinshipPath(context.Background(), suite.family[person], suite.family[relative], suite.persons)
+}
+
+func (suite *KinshipPathTestSuite) TestKinshipPath() {
+	suite.Run("should return the chain of people up to the grandfather", func() {
+		path := suite.path("Bruce", "Martin")
+
+		suite.Equal(2, path.Distance())
+		suite.Equal("Bruce → (child of) Phoebe → (child of) Martin", path.String())
+		suite.Equal(entity.KinshipChildOf, path.Steps[0].Relation)
+		suite.Empty(path.Steps[2].Relation)
+	})
+
+	suite.Run("should go down to the descendants", func() {
+		path := suite.path("Anastasia", "Bruce")
+
+		suite.Equal(2, path.Distance())
+		suite.Equal("Anastasia → (parent of) Phoebe → (parent of) Bruce", path.String())
+	})
+
+	suite.Run("should go up to the common ancestor and down to the cousin", func() {
+		path := suite.path("Arthur", "Beatrice1")
+
+		suite.Equal(3, path.Distance())
+		suite.Equal("Arthur → (child of) Ancestor → (parent of) Beatrice → (parent of) Beatrice1", path.String())
+	})
+
+	suite.Run("should return the shortest path instead of the common ancestor path", func() {
+		path := suite.path("Arthur1", "Beatrice1")
+
+		suite.Equal(2, path.Distance())
+		suite.Equal("Arthur1 → (parent of) Child → (child of) Beatrice1", path.String())
+	})
+
+	suite.Run("should return nil when the people are not related", func() {
+		path := suite.path("Arthur", "Stranger")
+
+		suite.Nil(path)
+		suite.Zero(path.Distance())
+	})
+
+	suite.Run("should return nil when a person is nil", func() {
+		suite.Nil(NewFamilyTree().KinshipPath(context.Background(), nil, suite.family["Arthur"], suite.persons))
+	})
+}
@@ -0,0 +1,98 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Busca os padrastos, enteados e meio-irmãos por casamento do Root que ainda não estão na árvore.
+func (b *treeBuilder) searchStepRelatives(ctx context.Context, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
+	if b.root == nil || b.kinship == nil {
+		return relatives
+	}
+	for _, person := range persons {
+		if person.ID == b.root.ID || b.alreadyInFamily(person, relatives) {
+			continue
+		}
+		a, ok := b.kinship.step(b.root.ID, person)
+		if !ok {
+			continue
+		}
+		relatives = append(relatives, &entity.Relative{
+			Type:   a.description,
+			Level:  level - 1 + a.distance,
+			Person: person,
+		})
+	}
+	return relatives
+}
+
+// Determina a relação de relative com person criada pelo casamento de um dos pais ou da própria
+// pessoa: padrasto/madrasta, enteado(a) e irmão(ã) postiço(a). Considera apenas uniões ativas.
+func (k *kinshipCalculator) step(personID string, relative *entity.Person) (affinity, bool) {
+	parents := k.parents[personID]
+
+	// Cônjuge de um dos pais que não é pai/mãe da pessoa.
+	if !contains(parents, relative.ID) {
+		for _, parentID := range parents {
+			if contains(k.spouses[parentID], relative.ID) {
+				return stepAffinity(stepFather, relative.Gender, 2)
+			}
+		}
+	}
+
+	// Filho do cônjuge que não é filho da pessoa.
+	if !contains(k.children[personID], relative.ID) {
+		for _, spouseID := range k.spouses[personID] {
+			if contains(k.children[spouseID], relative.ID) {
+				return stepAffinity(stepSon, relative.Gender, 2)
+			}
+		}
+	}
+
+	// Filho do padrasto/madrasta sem nenhum dos pais em comum com a pessoa.
+	if !sharesAny(parents, k.parents[relative.ID]) {
+		for _, parentID := range parents {
+			for _, stepParentID := range k.spouses[parentID] {
+				if !contains(parents, stepParentID) && contains(k.children[stepParentID], relative.ID) {
+					return stepAffinity(stepBrother, relative.Gender, 3)
+				}
+			}
+		}
+	}
+
+	return affinity{}, false
+}
+
+func stepAffinity(kinship, gender string, distance int) (affinity, bool) {
+	description := descriptionBySex(kinship, gender)
+	if description == unknownRelation {
+		return affinity{}, false
+	}
+	return affinity{description: description, distance: distance}, true
+}
+
+// Irmãos que compartilham apenas um dos pais: cada um tem um pai/mãe cadastrado que o outro não tem.
+// Quando um dos pais não está cadastrado não é possível afirmar, e os dois são tratados como irmãos.
+func isHalfSibling(parents, otherParents []string) bool {
+	return sharesAny(parents, otherParents) && hasOther(parents, otherParents) && hasOther(otherParents, parents)
+}
+
+func sharesAny(ids, others []string) bool {
+	for _, id := range ids {
+		if contains(others, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasOther(ids, others []string) bool {
+	for _, id := range ids {
+		if !contains(others, id) {
+			return true
+		}
+	}
+	return false
+}
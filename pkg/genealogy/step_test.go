@@ -0,0 +1,103 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type StepTestSuite struct {
+	suite.Suite
+	persons []*entity.Person
+	family  map[string]*entity.Person
+}
+
+func (suite *StepTestSuite) SetupTest() {
+	suite.family = map[string]*entity.Person{}
+	suite.persons = nil
+	add := func(name, gender string, parents ...string) {
+		p := NewPerson(name, gender, "", "")
+		for _, parent := range parents {
+			p.Relationships = append(p.Relationships, &entity.Relationship{MainPersonID: p.ID, SecundePersonID: suite.family[parent].ID})
+		}
+		suite.family[name] = p
+		suite.persons = append(suite.persons, p)
+	}
+
+	add("Mark", "M")
+	add("Ana", "F")
+	add("Julia", "F")
+	add("Rick", "M")
+	add("Sam", "M")
+	add("Leo", "M", "Mark", "Ana")
+	add("Mia", "F", "Mark", "Ana")
+	add("Noah", "M", "Mark", "Julia")
+	add("Zoe", "F", "Rick", "Julia")
+	add("Ivy", "F", "Mark")
+
+	unite(entity.RelationshipKindSpouse, suite.family["Mark"], suite.family["Ana"], &entity.RelationshipEvent{Date: "2005"})
+	unite(entity.RelationshipKindSpouse, suite.family["Mark"], suite.family["Julia"], nil)
+	unite(entity.RelationshipKindPartner, suite.family["Ana"], suite.family["Sam"], nil)
+}
+
+func (suite *StepTestSuite) TestDetermineRelationship() {
+	ctx := context.Background()
+	tests := []struct {
+		person   string
+		relative string
+		expected string
+	}{
+		{"Leo", "Mia", "Sister"},
+		{"Leo", "Noah", "HalfBrother"},
+		{"Noah", "Mia", "HalfSister"},
+		{"Leo", "Ivy", "Sister"},
+		{"Leo", "Julia", "StepMother"},
+		{"Leo", "Sam", "StepFather"},
+		{"Julia", "Leo", "StepSon"},
+		{"Mark", "Zoe", "StepDaughter"},
+		{"Leo", "Zoe", "StepSister"},
+		{"Zoe", "Leo", "StepBrother"},
+		{"Zoe", "Noah", "HalfBrother"},
+		{"Leo", "Rick", "unrelated"},
+	}
+
+	tg := NewFamilyTree()
+	for _, tt := range tests {
+		suite.Run(tt.person+" to "+tt.relative, func() {
+			got := tg.DetermineRelationship(ctx, suite.family[tt.person], suite.family[tt.relative], suite.persons)
+			suite.Equal(tt.expected, got)
+		})
+	}
+}
+
+func (suite *StepTestSuite) TestCheckSiblingRelation() {
+	b := newTreeBuilder(suite.family["Leo"])
+
+	suite.Equal("Sister", b.checkSiblingRelation(suite.family["Mia"], suite.persons))
+	suite.Equal("HalfBrother", b.checkSiblingRelation(suite.family["Noah"], suite.persons))
+	suite.Equal("", b.checkSiblingRelation(suite.family["Zoe"], suite.persons))
+}
+
+func (suite *StepTestSuite) TestIsHalfSibling() {
+	suite.True(isHalfSibling([]string{"a", "b"}, []string{"a", "c"}))
+	suite.False(isHalfSibling([]string{"a", "b"}, []string{"b", "a"}))
+	suite.False(isHalfSibling([]string{"a", "b"}, []string{"a"}))
+	suite.False(isHalfSibling([]string{"a"}, []string{"c"}))
+}
+
+func (suite *StepTestSuite) TestBuildFamilyTree() {
+	tree := NewFamilyTree().BuildFamilyTree(context.Background(), suite.family["Leo"], suite.persons, 1, entity.TreeModeAll)
+
+	types := map[string]string{}
+	for _, relative := range tree.Relatives() {
+		types[relative.Person.Name] = relative.Type
+	}
+
+	suite.Equal("Sister", types["Mia"])
+	suite.Equal("HalfBrother", types["Noah"])
+	suite.Equal("StepMother", types["Julia"])
+	suite.Equal("StepFather", types["Sam"])
+	suite.Equal("StepSister", types["Zoe"])
+	suite.NotContains(types, "Rick")
+}
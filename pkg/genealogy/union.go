@@ -0,0 +1,61 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Busca os cônjuges e companheiros do Root que ainda não estão na árvore.
+func (b *treeBuilder) searchSpouses(ctx context.Context, persons []*entity.Person, level int, relatives []*entity.Relative) []*entity.Relative {
+	if b.root == nil {
+		return relatives
+	}
+	for _, union := range b.root.Unions {
+		other := findPerson(union.OtherPersonID(b.root.ID), persons)
+		if other == nil || b.alreadyInFamily(other, relatives) {
+			continue
+		}
+		relatives = append(relatives, &entity.Relative{
+			Type:   unionDescription(findUnion(b.root, other.ID), other.Gender),
+			Level:  level,
+			Person: other,
+		})
+	}
+	return relatives
+}
+
+// Encontra a união entre a pessoa e otherID, dando preferência a uma união ainda ativa.
+func findUnion(person *entity.Person, otherID string) *entity.Relationship {
+	if person == nil {
+		return nil
+	}
+	var found *entity.Relationship
+	for _, union := range person.Unions {
+		if union.OtherPersonID(person.ID) != otherID {
+			continue
+		}
+		if !union.Ended() {
+			return union
+		}
+		found = union
+	}
+	return found
+}
+
+// Descrição da união com base no tipo, no encerramento e no sexo do parceiro.
+func unionDescription(union *entity.Relationship, gender string) string {
+	kind := spouse
+	if union.Kind == entity.RelationshipKindPartner {
+		kind = partner
+	}
+	if union.Ended() {
+		kind = "Ex" + kind
+	}
+
+	description := descriptionBySex(kind, gender)
+	if description == unknownRelation {
+		return kind
+	}
+	return description
+}
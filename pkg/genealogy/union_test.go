@@ -0,0 +1,103 @@
+package genealogy
+
+import (
+	"context"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnionTestSuite struct {
+	suite.Suite
+	john    *entity.Person
+	mary    *entity.Person
+	anne    *entity.Person
+	alex    *entity.Person
+	child   *entity.Person
+	persons []*entity.Person
+}
+
+// Liga as duas pessoas com uma união, como fazem os repositórios.
+func unite(kind string, first, second *entity.Person, end *entity.RelationshipEvent) {
+	union := &entity.Relationship{
+		Kind:            kind,
+		MainPersonID:    first.ID,
+		SecundePersonID: second.ID,
+		Start:           &entity.RelationshipEvent{Date: "1990"},
+		End:             end,
+	}
+	first.Unions = append(first.Unions, union)
+	second.Unions = append(second.Unions, union)
+}
+
+func (suite *UnionTestSuite) SetupTest() {
+	suite.john = NewPerson("John", "M", "", "")
+	suite.mary = NewPerson("Mary", "F", "", "")
+	suite.anne = NewPerson("Anne", "F", "", "")
+	suite.alex = NewPerson("Alex", "", "", "")
+	suite.child = NewPerson("Child", "M", suite.john.ID, suite.mary.ID)
+
+	unite(entity.RelationshipKindSpouse, suite.john, suite.anne, &entity.RelationshipEvent{Date: "1989"})
+	unite(entity.RelationshipKindSpouse, suite.john, suite.mary, nil)
+	unite(entity.RelationshipKindPartner, suite.mary, suite.alex, &entity.RelationshipEvent{Date: "2010"})
+
+	suite.persons = []*entity.Person{suite.john, suite.mary, suite.anne, suite.alex, suite.child}
+}
+
+func (suite *UnionTestSuite) TestDetermineRelationship() {
+	ctx := context.Background()
+	tg := NewFamilyTree()
+
+	suite.Run("should return Wife for an active marriage", func() {
+		suite.Equal("Wife", tg.DetermineRelationship(ctx, suite.john, suite.mary, suite.persons))
+	})
+
+	suite.Run("should return Husband from the other side", func() {
+		suite.Equal("Husband", tg.DetermineRelationship(ctx, suite.mary, suite.john, suite.persons))
+	})
+
+	suite.Run("should return ExWife after a divorce", func() {
+		suite.Equal("ExWife", tg.DetermineRelationship(ctx, suite.john, suite.anne, suite.persons))
+	})
+
+	suite.Run("should return a neutral label when gender is unknown", func() {
+		suite.Equal("ExPartner", tg.DetermineRelationship(ctx, suite.mary, suite.alex, suite.persons))
+	})
+
+	suite.Run("should keep blood kinship for non spouses", func() {
+		suite.Equal("Father", tg.DetermineRelationship(ctx, suite.child, suite.john, suite.persons))
+	})
+}
+
+func (suite *UnionTestSuite) TestUnionDescription() {
+	suite.Equal("Spouse", unionDescription(&entity.Relationship{Kind: entity.RelationshipKindSpouse}, ""))
+	suite.Equal("ExSpouse", unionDescription(&entity.Relationship{Kind: entity.RelationshipKindSpouse, End: &entity.RelationshipEvent{}}, ""))
+	suite.Equal("Partner", unionDescription(&entity.Relationship{Kind: entity.RelationshipKindPartner}, "F"))
+}
+
+func (suite *UnionTestSuite) TestFindUnion() {
+	suite.Run("should prefer the active union", func() {
+		unite(entity.RelationshipKindSpouse, suite.john, suite.anne, nil)
+		union := findUnion(suite.john, suite.anne.ID)
+		suite.False(union.Ended())
+	})
+
+	suite.Run("should return nil without a union", func() {
+		suite.Nil(findUnion(suite.child, suite.john.ID))
+		suite.Nil(findUnion(nil, suite.john.ID))
+	})
+}
+
+func (suite *UnionTestSuite) TestBuildFamilyTree() {
+	tree := NewFamilyTree().BuildFamilyTree(context.Background(), suite.john, suite.persons, 1, entity.TreeModeAll)
+
+	types := map[string]string{}
+	for _, relative := range tree.Relatives() {
+		types[relative.Person.Name] = relative.Type
+	}
+
+	suite.Equal("Wife", types["Mary"])
+	suite.Equal("ExWife", types["Anne"])
+	suite.Equal("Son", types["Child"])
+	suite.NotContains(types, "Alex")
+}
@@ -0,0 +1,92 @@
+// Package negotiate escolhe a representação da resposta pelo cabeçalho Accept, com pesos (q),
+// curingas (*/* e tipo/*) e a especificidade das faixas de tipos de mídia da RFC 9110.
+package negotiate
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+)
+
+// Faixa de tipos de mídia do Accept, como application/json, text/* ou */*, com o seu peso.
+type MediaRange struct {
+	Type    string
+	Subtype string
+	Q       float64
+}
+
+// Interpreta o cabeçalho Accept. Faixas inválidas ou com peso inválido são ignoradas.
+// Os parâmetros das faixas, exceto q, não restringem os tipos oferecidos.
+func ParseAccept(header string) []MediaRange {
+	var ranges []MediaRange
+	for _, part := range strings.Split(header, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		if mediaType == "*" {
+			mediaType = "*/*"
+		}
+		typ, subtype, ok := strings.Cut(mediaType, "/")
+		if !ok || (typ == "*" && subtype != "*") {
+			continue
+		}
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			q, err = strconv.ParseFloat(value, 64)
+			if err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+		ranges = append(ranges, MediaRange{Type: typ, Subtype: subtype, Q: q})
+	}
+	return ranges
+}
+
+// Especificidade da faixa para o tipo de mídia: 3 para o tipo exato, 2 para tipo/*, 1 para */*
+// e 0 quando a faixa não inclui o tipo.
+func (r MediaRange) match(typ, subtype string) int {
+	switch {
+	case r.Type == typ && r.Subtype == subtype:
+		return 3
+	case r.Type == typ && r.Subtype == "*":
+		return 2
+	case r.Type == "*" && r.Subtype == "*":
+		return 1
+	}
+	return 0
+}
+
+// Peso do tipo de mídia, dado pela faixa mais específica que o inclui. Sem faixa, o tipo não é aceito.
+func Quality(ranges []MediaRange, mediaType string) float64 {
+	typ, subtype, _ := strings.Cut(strings.ToLower(mediaType), "/")
+	best, q := 0, 0.0
+	for _, r := range ranges {
+		if s := r.match(typ, subtype); s > best {
+			best, q = s, r.Q
+		}
+	}
+	return q
+}
+
+// Escolhe o tipo oferecido de maior peso. Os empates ficam com a ordem das ofertas e o Accept
+// vazio aceita qualquer tipo. Retorna false quando nenhum tipo é aceito (406 Not Acceptable).
+func Best(header string, offers []string) (string, bool) {
+	if len(offers) == 0 {
+		return "", false
+	}
+	if strings.TrimSpace(header) == "" {
+		return offers[0], true
+	}
+	ranges := ParseAccept(header)
+	best, bestQ := "", 0.0
+	for _, offer := range offers {
+		if q := Quality(ranges, offer); q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+	return best, bestQ > 0
+}
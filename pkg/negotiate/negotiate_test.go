@@ -0,0 +1,116 @@
+package negotiate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NegotiateTestSuite struct {
+	suite.Suite
+}
+
+func (suite *NegotiateTestSuite) TestParseAccept() {
+	suite.Run("should read the weights and ignore invalid ranges", func() {
+		ranges := ParseAccept("Application/JSON, text/*;q=0.5, */*;q=0.1, invalid, text/plain;q=2, *")
+		suite.Equal([]MediaRange{
+			{Type: "application", Subtype: "json", Q: 1},
+			{Type: "text", Subtype: "*", Q: 0.5},
+			{Type: "*", Subtype: "*", Q: 0.1},
+			{Type: "*", Subtype: "*", Q: 1},
+		}, ranges)
+	})
+
+	suite.Run("should return no range for an empty header", func() {
+		suite.Empty(ParseAccept(""))
+	})
+}
+
+func (suite *NegotiateTestSuite) TestQuality() {
+	ranges := ParseAccept("text/*;q=0.5, text/plain;q=0.8, */*;q=0.1, image/svg+xml;q=0")
+
+	suite.Equal(0.8, Quality(ranges, "text/plain"))
+	suite.Equal(0.5, Quality(ranges, "text/xml"))
+	suite.Equal(0.1, Quality(ranges, "application/json"))
+	suite.Equal(0.0, Quality(ranges, "image/svg+xml"))
+	suite.Equal(0.0, Quality(ParseAccept("application/json"), "text/plain"))
+}
+
+func (suite *NegotiateTestSuite) TestBest() {
+	offers := []string{"application/json", "application/xml", "text/plain"}
+
+	tests := []struct {
+		accept   string
+		expected string
+		ok       bool
+	}{
+		{"", "application/json", true},
+		{"application/json, text/plain;q=0.5", "application/json", true},
+		{"application/xml;q=0.9,*/*;q=0.1", "application/xml", true},
+		{"text/*", "text/plain", true},
+		{"*/*", "application/json", true},
+		{"application/*;q=0.5, text/plain", "text/plain", true},
+		{"*/*, application/json;q=0", "application/xml", true},
+		{"image/png", "", false},
+		{"application/json;q=0", "", false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.accept, func() {
+			mediaType, ok := Best(tt.accept, offers)
+			suite.Equal(tt.ok, ok)
+			suite.Equal(tt.expected, mediaType)
+		})
+	}
+}
+
+type textData struct{}
+
+func (suite *NegotiateTestSuite) TestNegotiator() {
+	encode := func(data interface{}) ([]byte, error) { return nil, errors.New("not used") }
+	json := &Encoder{MediaTypes: []string{"application/json"}, ContentType: "application/json", Encode: encode}
+	xml := &Encoder{MediaTypes: []string{"application/xml", "text/xml"}, ContentType: "application/xml", Encode: encode}
+	text := &Encoder{
+		MediaTypes:  []string{"text/plain"},
+		ContentType: "text/plain; charset=utf-8",
+		Supports:    func(data interface{}) bool { _, ok := data.(textData); return ok },
+		Encode:      encode,
+	}
+	n := NewNegotiator(json, xml)
+	n.Register(text)
+
+	suite.Run("should choose the encoder of the accepted media type", func() {
+		e, ok := n.Negotiate("text/xml", nil)
+		suite.True(ok)
+		suite.Same(xml, e)
+	})
+
+	suite.Run("should choose the first encoder without Accept", func() {
+		e, ok := n.Negotiate("", textData{})
+		suite.True(ok)
+		suite.Same(json, e)
+	})
+
+	suite.Run("should only offer the encoders that support the data", func() {
+		e, ok := n.Negotiate("text/plain, */*;q=0.5", textData{})
+		suite.True(ok)
+		suite.Same(text, e)
+
+		e, ok = n.Negotiate("text/*", nil)
+		suite.True(ok)
+		suite.Same(xml, e)
+
+		_, ok = n.Negotiate("text/plain", nil)
+		suite.False(ok)
+	})
+
+	suite.Run("should list the media types of the data", func() {
+		suite.Equal([]string{"application/json", "application/xml", "text/xml"}, n.MediaTypes(nil))
+		suite.Equal([]string{"application/json", "application/xml", "text/xml", "text/plain"}, n.MediaTypes(textData{}))
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(NegotiateTestSuite))
+}
@@ -0,0 +1,67 @@
+package negotiate
+
+// Codificador de uma representação da resposta.
+type Encoder struct {
+	// Tipos de mídia aceitos no Accept, em ordem de preferência.
+	MediaTypes []string
+	// Content-Type da resposta codificada.
+	ContentType string
+	// Indica se os dados têm essa representação. Nil aceita quaisquer dados.
+	Supports func(data interface{}) bool
+	Encode   func(data interface{}) ([]byte, error)
+}
+
+func (e *Encoder) supports(data interface{}) bool {
+	return e.Supports == nil || e.Supports(data)
+}
+
+// Negociador com os codificadores registrados, na ordem de preferência do servidor.
+type Negotiator struct {
+	encoders []*Encoder
+}
+
+func NewNegotiator(encoders ...*Encoder) *Negotiator {
+	n := &Negotiator{}
+	for _, e := range encoders {
+		n.Register(e)
+	}
+	return n
+}
+
+func (n *Negotiator) Register(encoder *Encoder) {
+	n.encoders = append(n.encoders, encoder)
+}
+
+// Escolhe o codificador dos dados pelo Accept. Retorna false quando nenhuma representação é aceita.
+func (n *Negotiator) Negotiate(accept string, data interface{}) (*Encoder, bool) {
+	var offers []string
+	byType := map[string]*Encoder{}
+	for _, e := range n.encoders {
+		if !e.supports(data) {
+			continue
+		}
+		for _, mediaType := range e.MediaTypes {
+			if _, ok := byType[mediaType]; ok {
+				continue
+			}
+			offers = append(offers, mediaType)
+			byType[mediaType] = e
+		}
+	}
+	mediaType, ok := Best(accept, offers)
+	if !ok {
+		return nil, false
+	}
+	return byType[mediaType], true
+}
+
+// Tipos de mídia disponíveis para os dados, informados na resposta 406.
+func (n *Negotiator) MediaTypes(data interface{}) []string {
+	var mediaTypes []string
+	for _, e := range n.encoders {
+		if e.supports(data) {
+			mediaTypes = append(mediaTypes, e.MediaTypes...)
+		}
+	}
+	return mediaTypes
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/GeovaneCavalcante/tree-genealogical/database"
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	relationshipDomain "github.com/GeovaneCavalcante/tree-genealogical/relationship"
 	"github.com/google/uuid"
 )
 
@@ -20,60 +21,115 @@ func NewRelationshipRepository(inmenDB *database.Database) *RelationshipReposito
 	}
 }
 
-func (r *RelationshipRepository) Create(ctx context.Context, relationship *entity.Relationship) error {
+func (r *RelationshipRepository) Create(ctx context.Context, relationship *entity.Relationship, check entity.RelationshipCheck) error {
 	logger.Info("[Repository] Create relationship started")
 	relationship.ID = uuid.New().String()
-	r.InmenDB.Relationships = append(r.InmenDB.Relationships, *relationship)
+	linked, err := r.InmenDB.AddLinkedRelationship(*relationship, snapshotCheck(check))
+	if err != nil {
+		logger.Error("[Repository] Create relationship error: ", err)
+		return err
+	}
+	if !linked {
+		logger.Info("[Repository] Create relationship error: unknown person")
+		return relationshipDomain.ErrUnknownPerson
+	}
 	logger.Info("[Repository] Create relationship finished")
 	return nil
 }
 
 func (r *RelationshipRepository) Get(ctx context.Context, relationshipID string) (*entity.Relationship, error) {
 	logger.Info(fmt.Sprint("[Repository] Get relationship by relationshipID: ", relationshipID))
-	for _, r := range r.InmenDB.Relationships {
-		if r.ID == relationshipID {
-			relationship := r
-			return &relationship, nil
-		}
+	relationship, ok := r.InmenDB.FindRelationship(relationshipID)
+	if !ok {
+		logger.Info(fmt.Sprintf("[Repository] Get relationship by relationshipID: %s not found", relationshipID))
+		return nil, relationshipDomain.ErrNotFound
 	}
-	logger.Info(fmt.Sprintf("[Repository] Get relationship by relationshipID: %s not found", relationshipID))
-	return nil, nil
+	return &relationship, nil
 }
 
-func (r *RelationshipRepository) List(ctx context.Context, filters map[string]interface{}) ([]*entity.Relationship, error) {
+func (r *RelationshipRepository) List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, error) {
 	logger.Info("[Repository] List relationship started")
 
-	relationships := []*entity.Relationship{}
-	for _, r := range r.InmenDB.Relationships {
-		relationship := r
-		relationships = append(relationships, &relationship)
+	relationships := r.filter(filter)
+	if filter != nil {
+		relationships = entity.Paginate(relationships, filter.Page)
 	}
 
 	logger.Info("[Repository] List relationship finished")
 	return relationships, nil
 }
 
-func (r *RelationshipRepository) Update(ctx context.Context, relationshipID string, relationship *entity.Relationship) error {
-	logger.Info(fmt.Sprintf("[Repository] Update relationship started by relationshipID: %s", relationshipID))
-	for i, rr := range r.InmenDB.Relationships {
-		if rr.ID == relationshipID {
-			relationship.ID = rr.ID
-			r.InmenDB.Relationships[i] = *relationship
-			return nil
+func (r *RelationshipRepository) Count(ctx context.Context, filter *entity.RelationshipFilter) (int, error) {
+	return len(r.filter(filter)), nil
+}
+
+// Relacionamentos que atendem ao filtro, já ordenados e sem paginação.
+func (r *RelationshipRepository) filter(filter *entity.RelationshipFilter) []*entity.Relationship {
+	relationships := []*entity.Relationship{}
+	for _, r := range r.InmenDB.Relationships() {
+		relationship := r
+		if filter.Match(&relationship) {
+			relationships = append(relationships, &relationship)
 		}
 	}
-	logger.Info(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s not found", relationshipID))
+	filter.SortRelationships(relationships)
+	return relationships
+}
+
+func (r *RelationshipRepository) Update(ctx context.Context, relationshipID string, relationship *entity.Relationship, check entity.RelationshipCheck) error {
+	logger.Info(fmt.Sprintf("[Repository] Update relationship started by relationshipID: %s", relationshipID))
+	relationship.ID = relationshipID
+	found, linked, err := r.InmenDB.UpdateLinkedRelationship(relationshipID, *relationship, snapshotCheck(check))
+	if !found {
+		logger.Info(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s not found", relationshipID))
+		return relationshipDomain.ErrNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error", relationshipID), err)
+		return err
+	}
+	if !linked {
+		logger.Info(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error: unknown person", relationshipID))
+		return relationshipDomain.ErrUnknownPerson
+	}
 	return nil
 }
 
 func (r *RelationshipRepository) Delete(ctx context.Context, relationshipID string) error {
 	logger.Info(fmt.Sprintf("[Repository] Delete relationship started by relationshipID: %s", relationshipID))
-	for i, rr := range r.InmenDB.Relationships {
-		if rr.ID == relationshipID {
-			r.InmenDB.Relationships = append(r.InmenDB.Relationships[:i], r.InmenDB.Relationships[i+1:]...)
-			return nil
-		}
+	if !r.InmenDB.DeleteRelationship(relationshipID) {
+		logger.Info(fmt.Sprintf("[Repository] Delete relationship by relationshipID: %s not found", relationshipID))
+		return relationshipDomain.ErrNotFound
 	}
-	logger.Info(fmt.Sprintf("[Repository] Delete relationship by relationshipID: %s not found", relationshipID))
 	return nil
 }
+
+// Executa a verificação com os dados do banco obtidos sob o lock de escrita, vinculando a cada
+// pessoa os seus eventos.
+func snapshotCheck(check entity.RelationshipCheck) func(database.Snapshot) error {
+	if check == nil {
+		return nil
+	}
+	return func(snapshot database.Snapshot) error {
+		persons := make([]*entity.Person, 0, len(snapshot.Persons))
+		byID := make(map[string]*entity.Person, len(snapshot.Persons))
+		for _, p := range snapshot.Persons {
+			person := p
+			persons = append(persons, &person)
+			byID[person.ID] = &person
+		}
+		for _, e := range snapshot.Events {
+			event := e
+			if person := byID[event.PersonID]; person != nil {
+				person.Events = append(person.Events, &event)
+			}
+		}
+
+		relationships := make([]*entity.Relationship, 0, len(snapshot.Relationships))
+		for _, r := range snapshot.Relationships {
+			relationship := r
+			relationships = append(relationships, &relationship)
+		}
+		return check(persons, relationships)
+	}
+}
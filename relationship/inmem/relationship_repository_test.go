@@ -0,0 +1,186 @@
+package inmem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	relationshipDomain "github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelationshipRepositoryTestSuite struct {
+	suite.Suite
+	Repo *RelationshipRepository
+}
+
+func (suite *RelationshipRepositoryTestSuite) SetupTest() {
+	suite.Repo = NewRelationshipRepository(database.NewEmpty())
+}
+
+func (suite *RelationshipRepositoryTestSuite) addPersons(IDs ...string) {
+	for _, ID := range IDs {
+		suite.Repo.InmenDB.AddPerson(entity.Person{ID: ID})
+	}
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestUnknownPerson() {
+	ctx := context.Background()
+	suite.addPersons("child", "parent")
+	relationship := &entity.Relationship{MainPersonID: "child", SecundePersonID: "parent"}
+	suite.Require().NoError(suite.Repo.Create(ctx, relationship, nil))
+
+	suite.Run("should refuse to create a link to an unknown person", func() {
+		err := suite.Repo.Create(ctx, &entity.Relationship{MainPersonID: "child", SecundePersonID: "unknown"}, nil)
+		suite.ErrorIs(err, relationshipDomain.ErrUnknownPerson)
+		suite.Len(suite.Repo.InmenDB.Relationships(), 1)
+	})
+
+	suite.Run("should refuse to update a link to an unknown person", func() {
+		err := suite.Repo.Update(ctx, relationship.ID, &entity.Relationship{MainPersonID: "unknown", SecundePersonID: "parent"}, nil)
+		suite.ErrorIs(err, relationshipDomain.ErrUnknownPerson)
+		found, _ := suite.Repo.Get(ctx, relationship.ID)
+		suite.Equal("child", found.MainPersonID)
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestNotFound() {
+	ctx := context.Background()
+	suite.addPersons("child", "parent")
+
+	suite.Run("should return not found for a missing ID", func() {
+		found, err := suite.Repo.Get(ctx, "unknown")
+		suite.ErrorIs(err, relationshipDomain.ErrNotFound)
+		suite.Nil(found)
+		err = suite.Repo.Update(ctx, "unknown", &entity.Relationship{MainPersonID: "child", SecundePersonID: "parent"}, nil)
+		suite.ErrorIs(err, relationshipDomain.ErrNotFound)
+		suite.ErrorIs(suite.Repo.Delete(ctx, "unknown"), relationshipDomain.ErrNotFound)
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestConcurrentAccess() {
+	ctx := context.Background()
+	suite.addPersons("parent", "other")
+	for i := 0; i < 100; i++ {
+		suite.addPersons(fmt.Sprint(i))
+	}
+	suite.Run("should create, list, update and delete relationships from many goroutines", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				r := &entity.Relationship{MainPersonID: fmt.Sprint(i), SecundePersonID: "parent"}
+				suite.NoError(suite.Repo.Create(ctx, r, nil))
+				_, err := suite.Repo.List(ctx, nil)
+				suite.NoError(err)
+				suite.NoError(suite.Repo.Update(ctx, r.ID, &entity.Relationship{MainPersonID: r.MainPersonID, SecundePersonID: "other"}, nil))
+				if i%2 == 0 {
+					suite.NoError(suite.Repo.Delete(ctx, r.ID))
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		relationships, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Len(relationships, 50)
+		for _, r := range relationships {
+			suite.Equal("other", r.SecundePersonID)
+		}
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestConcurrentValidation() {
+	ctx := context.Background()
+	suite.addPersons("child")
+	for i := 0; i < 20; i++ {
+		suite.addPersons(fmt.Sprint("parent", i))
+	}
+	service := relationshipDomain.NewService(suite.Repo)
+
+	suite.Run("should check and write each relationship atomically", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				service.Create(ctx, &entity.Relationship{MainPersonID: "child", SecundePersonID: fmt.Sprint("parent", i)})
+			}(i)
+			go func() {
+				defer wg.Done()
+				service.Create(ctx, &entity.Relationship{Kind: entity.RelationshipKindSpouse, MainPersonID: "parent0", SecundePersonID: "parent1"})
+			}()
+		}
+		wg.Wait()
+
+		parents, err := suite.Repo.List(ctx, &entity.RelationshipFilter{ChildID: "child"})
+		suite.Require().NoError(err)
+		suite.Len(parents, 2)
+		unions, err := suite.Repo.List(ctx, &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse})
+		suite.Require().NoError(err)
+		suite.Len(unions, 1)
+	})
+
+	suite.Run("should not write when the check fails", func() {
+		checkErr := errors.New("check failed")
+		var persons []*entity.Person
+		err := suite.Repo.Create(ctx, &entity.Relationship{MainPersonID: "parent5", SecundePersonID: "parent6"}, func(p []*entity.Person, _ []*entity.Relationship) error {
+			persons = p
+			return checkErr
+		})
+		suite.ErrorIs(err, checkErr)
+		suite.Len(persons, 21)
+
+		parents, _ := suite.Repo.List(ctx, &entity.RelationshipFilter{ChildID: "parent5"})
+		suite.Empty(parents)
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestListFilter() {
+	ctx := context.Background()
+	suite.addPersons("first", "second", "father", "mother")
+	for _, r := range []*entity.Relationship{
+		{MainPersonID: "first", SecundePersonID: "father"},
+		{MainPersonID: "second", SecundePersonID: "father"},
+		{MainPersonID: "first", SecundePersonID: "mother"},
+		{Kind: entity.RelationshipKindSpouse, MainPersonID: "father", SecundePersonID: "mother"},
+	} {
+		suite.Require().NoError(suite.Repo.Create(ctx, r, nil))
+	}
+
+	suite.Run("should filter by parent, child and person", func() {
+		children, err := suite.Repo.List(ctx, &entity.RelationshipFilter{ParentID: "father"})
+		suite.Nil(err)
+		suite.Len(children, 2)
+
+		parents, err := suite.Repo.List(ctx, &entity.RelationshipFilter{ChildID: "first"})
+		suite.Nil(err)
+		suite.Len(parents, 2)
+
+		unions, err := suite.Repo.List(ctx, &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse, PersonID: "mother"})
+		suite.Nil(err)
+		suite.Len(unions, 1)
+		suite.Equal("father", unions[0].MainPersonID)
+	})
+
+	suite.Run("should sort and paginate", func() {
+		filter := &entity.RelationshipFilter{Kind: entity.RelationshipKindParent, Order: entity.OrderDesc, Page: entity.Page{Offset: 1, Limit: 1}}
+		relationships, err := suite.Repo.List(ctx, filter)
+		suite.Nil(err)
+		suite.Len(relationships, 1)
+		suite.Equal("second", relationships[0].MainPersonID)
+
+		total, err := suite.Repo.Count(ctx, filter)
+		suite.Nil(err)
+		suite.Equal(3, total)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipRepositoryTestSuite))
+}
@@ -40,18 +40,33 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockRepository) Count(ctx context.Context, filter *entity.RelationshipFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx, filter)
+}
+
 // Create mocks base method.
-func (m *MockRepository) Create(ctx context.Context, relationship *entity.Relationship) error {
+func (m *MockRepository) Create(ctx context.Context, relationship *entity.Relationship, check entity.RelationshipCheck) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, relationship)
+	ret := m.ctrl.Call(m, "Create", ctx, relationship, check)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockRepositoryMockRecorder) Create(ctx, relationship any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Create(ctx, relationship, check any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, relationship)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, relationship, check)
 }
 
 // Delete mocks base method.
@@ -84,32 +99,32 @@ func (mr *MockRepositoryMockRecorder) Get(ctx, ID any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockRepository) List(ctx context.Context, filters map[string]any) ([]*entity.Relationship, error) {
+func (m *MockRepository) List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, filters)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]*entity.Relationship)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockRepositoryMockRecorder) List(ctx, filters any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filter)
 }
 
 // Update mocks base method.
-func (m *MockRepository) Update(ctx context.Context, ID string, relationship *entity.Relationship) error {
+func (m *MockRepository) Update(ctx context.Context, ID string, relationship *entity.Relationship, check entity.RelationshipCheck) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, ID, relationship)
+	ret := m.ctrl.Call(m, "Update", ctx, ID, relationship, check)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRepositoryMockRecorder) Update(ctx, ID, relationship any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Update(ctx, ID, relationship, check any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, ID, relationship)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, ID, relationship, check)
 }
 
 // MockUseCase is a mock of UseCase interface.
@@ -179,18 +194,19 @@ func (mr *MockUseCaseMockRecorder) Get(ctx, ID any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockUseCase) List(ctx context.Context, filters map[string]any) ([]*entity.Relationship, error) {
+func (m *MockUseCase) List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, filters)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]*entity.Relationship)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
-func (mr *MockUseCaseMockRecorder) List(ctx, filters any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) List(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, filter)
 }
 
 // Update mocks base method.
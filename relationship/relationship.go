@@ -6,18 +6,30 @@ import (
 	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
 )
 
+var (
+	// Os repositórios retornam ErrNotFound em Get, Update e Delete quando o relacionamento não existe.
+	ErrNotFound = entity.NewNotFoundError("relationship not found")
+	// O relacionamento referencia uma pessoa que não está cadastrada.
+	ErrUnknownPerson = entity.NewValidationError("relationship person does not exist")
+)
+
 type Repository interface {
-	Create(ctx context.Context, relationship *entity.Relationship) error
+	// Create e Update executam check, quando informado, sob o mesmo lock (em memória) ou
+	// transação (SQLite) da gravação, e retornam ErrUnknownPerson quando alguma das pessoas não existe.
+	Create(ctx context.Context, relationship *entity.Relationship, check entity.RelationshipCheck) error
 	Get(ctx context.Context, ID string) (*entity.Relationship, error)
-	List(ctx context.Context, filters map[string]interface{}) ([]*entity.Relationship, error)
-	Update(ctx context.Context, ID string, relationship *entity.Relationship) error
+	List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, error)
+	// Total de relacionamentos que atendem ao filtro, desconsiderando a paginação.
+	Count(ctx context.Context, filter *entity.RelationshipFilter) (int, error)
+	Update(ctx context.Context, ID string, relationship *entity.Relationship, check entity.RelationshipCheck) error
 	Delete(ctx context.Context, ID string) error
 }
 
 type UseCase interface {
 	Create(ctx context.Context, relationship *entity.Relationship) error
 	Get(ctx context.Context, ID string) (*entity.Relationship, error)
-	List(ctx context.Context, filters map[string]interface{}) ([]*entity.Relationship, error)
+	// Relacionamentos da página solicitada e o total de relacionamentos que atendem ao filtro.
+	List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, int, error)
 	Update(ctx context.Context, ID string, relationship *entity.Relationship) error
 	Delete(ctx context.Context, ID string) error
 }
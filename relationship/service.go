@@ -21,7 +21,7 @@ func NewService(repo Repository) *Service {
 func (s *Service) Create(ctx context.Context, relationship *entity.Relationship) error {
 	logger.Info("[Service] Create relationship started")
 
-	err := s.repo.Create(ctx, relationship)
+	err := s.repo.Create(ctx, relationship, validation("", relationship))
 	if err != nil {
 		logger.Error("[Service] Create relationship error: ", err)
 		return fmt.Errorf("create relationship error: %w", err)
@@ -44,34 +44,29 @@ func (s *Service) Get(ctx context.Context, relationshipID string) (*entity.Relat
 	return relationship, nil
 }
 
-func (s *Service) List(ctx context.Context, filters map[string]interface{}) ([]*entity.Relationship, error) {
+func (s *Service) List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, int, error) {
 	logger.Info("[Service] List relationship started")
 
-	relationships, err := s.repo.List(ctx, filters)
+	relationships, err := s.repo.List(ctx, filter)
 	if err != nil {
 		logger.Error("[Service] List relationship error: ", err)
-		return nil, fmt.Errorf("list relationship error: %w", err)
+		return nil, 0, fmt.Errorf("list relationship error: %w", err)
+	}
+
+	total, err := s.repo.Count(ctx, filter)
+	if err != nil {
+		logger.Error("[Service] Count relationship error: ", err)
+		return nil, 0, fmt.Errorf("count relationship error: %w", err)
 	}
 
 	logger.Info("[Service] List relationship finished")
-	return relationships, nil
+	return relationships, total, nil
 }
 
 func (s *Service) Update(ctx context.Context, relationshipID string, relationship *entity.Relationship) error {
 	logger.Info(fmt.Sprintf("[Service] Update relationship started by relationshipID: %s", relationshipID))
 
-	r, err := s.Get(ctx, relationshipID)
-	if err != nil {
-		logger.Error(fmt.Sprintf("[Service] Update relationship by relationshipID: %s error ", relationshipID), err)
-		return fmt.Errorf("update relationship error: %w", err)
-	}
-
-	if r == nil {
-		logger.Error(fmt.Sprintf("[Service] Update relationship by relationshipID %s error not found ", relationshipID), nil)
-		return fmt.Errorf("relationship not found")
-	}
-
-	err = s.repo.Update(ctx, relationshipID, relationship)
+	err := s.repo.Update(ctx, relationshipID, relationship, validation(relationshipID, relationship))
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] Update relationship by relationshipID: %s error ", relationshipID), err)
 		return fmt.Errorf("update relationship error: %w", err)
@@ -84,18 +79,7 @@ func (s *Service) Update(ctx context.Context, relationshipID string, relationshi
 func (s *Service) Delete(ctx context.Context, relationshipID string) error {
 	logger.Info(fmt.Sprintf("[Service] Delete relationship started by relationshipID: %s", relationshipID))
 
-	r, err := s.Get(ctx, relationshipID)
-	if err != nil {
-		logger.Error(fmt.Sprintf("[Service] Delete relationship by relationshipID: %s error ", relationshipID), err)
-		return fmt.Errorf("delete relationship error: %w", err)
-	}
-
-	if r == nil {
-		logger.Error(fmt.Sprintf("[Service] Delete relationship by relationshipID %s error not found ", relationshipID), nil)
-		return fmt.Errorf("relationship not found")
-	}
-
-	err = s.repo.Delete(ctx, relationshipID)
+	err := s.repo.Delete(ctx, relationshipID)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[Service] Delete relationship by relationshipID: %s error ", relationshipID), err)
 		return fmt.Errorf("delete relationship error: %w", err)
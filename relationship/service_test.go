@@ -27,17 +27,39 @@ func (suite *RelationshipServiceTestSuite) SetupTest() {
 	}
 }
 
+// Simula o repositório executando a verificação com as pessoas 2 e 3 e os relacionamentos informados,
+// e retorna err quando ela passa.
+func (suite *RelationshipServiceTestSuite) expectCreate(relationships []*entity.Relationship, err error) {
+	suite.RelationshipRepoMock.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *entity.Relationship, check entity.RelationshipCheck) error {
+			if checkErr := check([]*entity.Person{{ID: "2"}, {ID: "3"}}, relationships); checkErr != nil {
+				return checkErr
+			}
+			return err
+		})
+}
+
+func (suite *RelationshipServiceTestSuite) expectUpdate(ID string, relationships []*entity.Relationship, err error) {
+	suite.RelationshipRepoMock.EXPECT().Update(gomock.Any(), ID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ *entity.Relationship, check entity.RelationshipCheck) error {
+			if checkErr := check([]*entity.Person{{ID: "2"}, {ID: "3"}}, relationships); checkErr != nil {
+				return checkErr
+			}
+			return err
+		})
+}
+
 func (suite *RelationshipServiceTestSuite) TestCreate() {
 	ctx := context.Background()
 	suite.Run("should return success when creating a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Create(gomock.Any(), suite.Relationship).Return(nil)
+		suite.expectCreate(nil, nil)
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Create(ctx, suite.Relationship)
 		suite.Nil(err)
 	})
 
 	suite.Run("should return error when creating a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Create(gomock.Any(), suite.Relationship).Return(errors.New("database error"))
+		suite.expectCreate(nil, errors.New("database error"))
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Create(ctx, suite.Relationship)
 		suite.NotNil(err)
@@ -67,65 +89,66 @@ func (suite *RelationshipServiceTestSuite) TestGet() {
 
 func (suite *RelationshipServiceTestSuite) TestList() {
 	ctx := context.Background()
+	filter := &entity.RelationshipFilter{Kind: entity.RelationshipKindParent}
 	suite.Run("should return success when listing relationships", func() {
-		suite.RelationshipRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Relationship{suite.Relationship}, nil)
+		suite.RelationshipRepoMock.EXPECT().List(gomock.Any(), filter).Return([]*entity.Relationship{suite.Relationship}, nil)
+		suite.RelationshipRepoMock.EXPECT().Count(gomock.Any(), filter).Return(1, nil)
 		service := NewService(suite.RelationshipRepoMock)
-		relationships, err := service.List(ctx, map[string]interface{}{})
+		relationships, total, err := service.List(ctx, filter)
 		suite.Nil(err)
 		suite.NotNil(relationships)
 		suite.Equal([]*entity.Relationship{suite.Relationship}, relationships)
+		suite.Equal(1, total)
 	})
 
 	suite.Run("should return error when listing relationships", func() {
 		suite.RelationshipRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 		service := NewService(suite.RelationshipRepoMock)
-		relationships, err := service.List(ctx, map[string]interface{}{})
+		relationships, _, err := service.List(ctx, filter)
 		suite.NotNil(err)
 		suite.Nil(relationships)
 		suite.Equal("list relationship error: database error", err.Error())
 	})
+
+	suite.Run("should return error when counting relationships", func() {
+		suite.RelationshipRepoMock.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*entity.Relationship{suite.Relationship}, nil)
+		suite.RelationshipRepoMock.EXPECT().Count(gomock.Any(), gomock.Any()).Return(0, errors.New("database error"))
+		service := NewService(suite.RelationshipRepoMock)
+		relationships, _, err := service.List(ctx, filter)
+		suite.Nil(relationships)
+		suite.Equal("count relationship error: database error", err.Error())
+	})
 }
 
 func (suite *RelationshipServiceTestSuite) TestUpdate() {
 	ctx := context.Background()
 	suite.Run("should return success when updating a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
-		suite.RelationshipRepoMock.EXPECT().Update(gomock.Any(), suite.Relationship.ID, suite.Relationship).Return(nil)
+		suite.expectUpdate(suite.Relationship.ID, []*entity.Relationship{suite.Relationship}, nil)
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Update(ctx, suite.Relationship.ID, suite.Relationship)
 		suite.Nil(err)
 	})
 
 	suite.Run("should return error when updating a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
-		suite.RelationshipRepoMock.EXPECT().Update(gomock.Any(), suite.Relationship.ID, suite.Relationship).Return(errors.New("database error"))
+		suite.expectUpdate(suite.Relationship.ID, []*entity.Relationship{suite.Relationship}, errors.New("database error"))
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Update(ctx, suite.Relationship.ID, suite.Relationship)
 		suite.NotNil(err)
 		suite.Equal("update relationship error: database error", err.Error())
 	})
 
-	suite.Run("should return error when getting a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(nil, errors.New("database error"))
-		service := NewService(suite.RelationshipRepoMock)
-		err := service.Update(ctx, suite.Relationship.ID, suite.Relationship)
-		suite.NotNil(err)
-		suite.Equal("update relationship error: get relationship error: database error", err.Error())
-	})
-
-	suite.Run("should return error when getting a relationship nil", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(nil, nil)
+	suite.Run("should return not found when the relationship does not exist", func() {
+		suite.RelationshipRepoMock.EXPECT().Update(gomock.Any(), suite.Relationship.ID, suite.Relationship, gomock.Any()).Return(ErrNotFound)
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Update(ctx, suite.Relationship.ID, suite.Relationship)
-		suite.NotNil(err)
-		suite.Equal("relationship not found", err.Error())
+		suite.ErrorIs(err, ErrNotFound)
+		suite.Equal("update relationship error: relationship not found", err.Error())
 	})
 }
 
 func (suite *RelationshipServiceTestSuite) TestDelete() {
 	ctx := context.Background()
 	suite.Run("should return success when deleting a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
 		suite.RelationshipRepoMock.EXPECT().Delete(gomock.Any(), suite.Relationship.ID).Return(nil)
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Delete(ctx, suite.Relationship.ID)
@@ -133,7 +156,6 @@ func (suite *RelationshipServiceTestSuite) TestDelete() {
 	})
 
 	suite.Run("should return error when deleting a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(suite.Relationship, nil)
 		suite.RelationshipRepoMock.EXPECT().Delete(gomock.Any(), suite.Relationship.ID).Return(errors.New("database error"))
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Delete(ctx, suite.Relationship.ID)
@@ -141,20 +163,12 @@ func (suite *RelationshipServiceTestSuite) TestDelete() {
 		suite.Equal("delete relationship error: database error", err.Error())
 	})
 
-	suite.Run("should return error when getting a relationship", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(nil, errors.New("database error"))
+	suite.Run("should return not found when the relationship does not exist", func() {
+		suite.RelationshipRepoMock.EXPECT().Delete(gomock.Any(), suite.Relationship.ID).Return(ErrNotFound)
 		service := NewService(suite.RelationshipRepoMock)
 		err := service.Delete(ctx, suite.Relationship.ID)
-		suite.NotNil(err)
-		suite.Equal("delete relationship error: get relationship error: database error", err.Error())
-	})
-
-	suite.Run("should return error when getting a relationship nil", func() {
-		suite.RelationshipRepoMock.EXPECT().Get(gomock.Any(), suite.Relationship.ID).Return(nil, nil)
-		service := NewService(suite.RelationshipRepoMock)
-		err := service.Delete(ctx, suite.Relationship.ID)
-		suite.NotNil(err)
-		suite.Equal("relationship not found", err.Error())
+		suite.ErrorIs(err, ErrNotFound)
+		suite.Equal("delete relationship error: relationship not found", err.Error())
 	})
 }
 
@@ -0,0 +1,299 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	"github.com/GeovaneCavalcante/tree-genealogical/pkg/logger"
+	relationshipDomain "github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/google/uuid"
+)
+
+// Condição que exige as duas pessoas do relacionamento cadastradas.
+const personsExist = "EXISTS (SELECT 1 FROM persons WHERE id = ?) AND EXISTS (SELECT 1 FROM persons WHERE id = ?)"
+
+type RelationshipRepository struct {
+	DB *sql.DB
+}
+
+func NewRelationshipRepository(db *sql.DB) *RelationshipRepository {
+	return &RelationshipRepository{
+		DB: db,
+	}
+}
+
+func (r *RelationshipRepository) Create(ctx context.Context, relationship *entity.Relationship, check entity.RelationshipCheck) error {
+	logger.Info("[Repository] Create relationship started")
+	relationship.ID = uuid.New().String()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("[Repository] Create relationship error: ", err)
+		return fmt.Errorf("begin transaction error: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := runCheck(ctx, tx, check); err != nil {
+		logger.Error("[Repository] Create relationship error: ", err)
+		return err
+	}
+
+	startDate, startPlace := database.EventColumns(relationship.Start)
+	endDate, endPlace := database.EventColumns(relationship.End)
+
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO relationships ("+database.RelationshipColumns+") SELECT ?, ?, ?, ?, ?, ?, ?, ?, ? WHERE "+personsExist,
+		relationship.ID, relationship.KindOrDefault(), relationship.MainPersonID, relationship.SecundePersonID,
+		startDate, startPlace, endDate, endPlace, relationship.Parentage,
+		relationship.MainPersonID, relationship.SecundePersonID,
+	)
+	if err != nil {
+		logger.Error("[Repository] Create relationship error: ", err)
+		return fmt.Errorf("insert relationship error: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.Info("[Repository] Create relationship error: unknown person")
+		return relationshipDomain.ErrUnknownPerson
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("[Repository] Create relationship error: ", err)
+		return fmt.Errorf("commit transaction error: %w", err)
+	}
+
+	logger.Info("[Repository] Create relationship finished")
+	return nil
+}
+
+func (r *RelationshipRepository) Get(ctx context.Context, relationshipID string) (*entity.Relationship, error) {
+	logger.Info(fmt.Sprint("[Repository] Get relationship by relationshipID: ", relationshipID))
+
+	row := r.DB.QueryRowContext(ctx, "SELECT "+database.RelationshipColumns+" FROM relationships WHERE id = ?", relationshipID)
+	relationship, err := database.ScanRelationship(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Info(fmt.Sprintf("[Repository] Get relationship by relationshipID: %s not found", relationshipID))
+		return nil, relationshipDomain.ErrNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Get relationship by relationshipID: %s error", relationshipID), err)
+		return nil, fmt.Errorf("select relationship error: %w", err)
+	}
+
+	return relationship, nil
+}
+
+func (r *RelationshipRepository) List(ctx context.Context, filter *entity.RelationshipFilter) ([]*entity.Relationship, error) {
+	logger.Info("[Repository] List relationship started")
+
+	where, args := relationshipWhere(filter)
+	query := "SELECT " + database.RelationshipColumns + " FROM relationships" + where + " ORDER BY rowid"
+	if filter != nil && filter.Order == entity.OrderDesc {
+		query += " DESC"
+	}
+	if filter != nil && (filter.Limit > 0 || filter.Offset > 0) {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, database.Limit(filter.Page), filter.Offset)
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("[Repository] List relationship error: ", err)
+		return nil, fmt.Errorf("select relationships error: %w", err)
+	}
+	defer rows.Close()
+
+	relationships := []*entity.Relationship{}
+	for rows.Next() {
+		relationship, err := database.ScanRelationship(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan relationship error: %w", err)
+		}
+		relationships = append(relationships, relationship)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select relationships error: %w", err)
+	}
+
+	logger.Info("[Repository] List relationship finished")
+	return relationships, nil
+}
+
+func (r *RelationshipRepository) Count(ctx context.Context, filter *entity.RelationshipFilter) (int, error) {
+	where, args := relationshipWhere(filter)
+
+	var total int
+	if err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM relationships"+where, args...).Scan(&total); err != nil {
+		logger.Error("[Repository] Count relationship error: ", err)
+		return 0, fmt.Errorf("count relationships error: %w", err)
+	}
+	return total, nil
+}
+
+func (r *RelationshipRepository) Update(ctx context.Context, relationshipID string, relationship *entity.Relationship, check entity.RelationshipCheck) error {
+	logger.Info(fmt.Sprintf("[Repository] Update relationship started by relationshipID: %s", relationshipID))
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error", relationshipID), err)
+		return fmt.Errorf("begin transaction error: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, "SELECT 1 FROM relationships WHERE id = ?", relationshipID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Info(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s not found", relationshipID))
+		return relationshipDomain.ErrNotFound
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error", relationshipID), err)
+		return fmt.Errorf("select relationship error: %w", err)
+	}
+
+	if err := runCheck(ctx, tx, check); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error", relationshipID), err)
+		return err
+	}
+
+	startDate, startPlace := database.EventColumns(relationship.Start)
+	endDate, endPlace := database.EventColumns(relationship.End)
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE relationships SET kind = ?, main_person_id = ?, secunde_person_id = ?,
+			start_date = ?, start_place = ?, end_date = ?, end_place = ?, parentage = ? WHERE id = ? AND `+personsExist,
+		relationship.KindOrDefault(), relationship.MainPersonID, relationship.SecundePersonID,
+		startDate, startPlace, endDate, endPlace, relationship.Parentage, relationshipID,
+		relationship.MainPersonID, relationship.SecundePersonID,
+	)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error", relationshipID), err)
+		return fmt.Errorf("update relationship error: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.Info(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error: unknown person", relationshipID))
+		return relationshipDomain.ErrUnknownPerson
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Update relationship by relationshipID: %s error", relationshipID), err)
+		return fmt.Errorf("commit transaction error: %w", err)
+	}
+
+	relationship.ID = relationshipID
+	return nil
+}
+
+func (r *RelationshipRepository) Delete(ctx context.Context, relationshipID string) error {
+	logger.Info(fmt.Sprintf("[Repository] Delete relationship started by relationshipID: %s", relationshipID))
+
+	res, err := r.DB.ExecContext(ctx, "DELETE FROM relationships WHERE id = ?", relationshipID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[Repository] Delete relationship by relationshipID: %s error", relationshipID), err)
+		return fmt.Errorf("delete relationship error: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		logger.Info(fmt.Sprintf("[Repository] Delete relationship by relationshipID: %s not found", relationshipID))
+		return relationshipDomain.ErrNotFound
+	}
+	return nil
+}
+
+// Executa a verificação com as pessoas, os eventos e os relacionamentos lidos na transação da
+// gravação. O banco usa uma única conexão, então nenhuma outra escrita ocorre até o commit.
+func runCheck(ctx context.Context, tx *sql.Tx, check entity.RelationshipCheck) error {
+	if check == nil {
+		return nil
+	}
+
+	persons, err := selectAll(ctx, tx, "SELECT id, name, gender, level FROM persons ORDER BY rowid", func(rows *sql.Rows) (*entity.Person, error) {
+		var p entity.Person
+		err := rows.Scan(&p.ID, &p.Name, &p.Gender, &p.Level)
+		return &p, err
+	})
+	if err != nil {
+		return fmt.Errorf("select persons error: %w", err)
+	}
+
+	events, err := selectAll(ctx, tx, "SELECT "+database.PersonEventColumns+" FROM events ORDER BY rowid", func(rows *sql.Rows) (*entity.Event, error) {
+		return database.ScanEvent(rows)
+	})
+	if err != nil {
+		return fmt.Errorf("select events error: %w", err)
+	}
+
+	relationships, err := selectAll(ctx, tx, "SELECT "+database.RelationshipColumns+" FROM relationships ORDER BY rowid", func(rows *sql.Rows) (*entity.Relationship, error) {
+		return database.ScanRelationship(rows)
+	})
+	if err != nil {
+		return fmt.Errorf("select relationships error: %w", err)
+	}
+
+	byID := make(map[string]*entity.Person, len(persons))
+	for _, p := range persons {
+		byID[p.ID] = p
+	}
+	for _, e := range events {
+		if p := byID[e.PersonID]; p != nil {
+			p.Events = append(p.Events, e)
+		}
+	}
+	return check(persons, relationships)
+}
+
+func selectAll[T any](ctx context.Context, tx *sql.Tx, query string, scan func(*sql.Rows) (*T, error)) ([]*T, error) {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*T
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// Cláusula WHERE com os filtros da listagem de relacionamentos.
+func relationshipWhere(filter *entity.RelationshipFilter) (string, []any) {
+	if filter == nil {
+		return "", nil
+	}
+
+	var conditions []string
+	var args []any
+	if filter.Kind != "" {
+		conditions = append(conditions, "kind = ?")
+		args = append(args, filter.Kind)
+	}
+	if filter.PersonID != "" {
+		conditions = append(conditions, "(main_person_id = ? OR secunde_person_id = ?)")
+		args = append(args, filter.PersonID, filter.PersonID)
+	}
+	if filter.ParentID != "" {
+		conditions = append(conditions, "kind = ? AND secunde_person_id = ?")
+		args = append(args, entity.RelationshipKindParent, filter.ParentID)
+	}
+	if filter.ChildID != "" {
+		conditions = append(conditions, "kind = ? AND main_person_id = ?")
+		args = append(args, entity.RelationshipKindParent, filter.ChildID)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,242 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/database"
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+	relationshipDomain "github.com/GeovaneCavalcante/tree-genealogical/relationship"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelationshipRepositoryTestSuite struct {
+	suite.Suite
+	DB   *sql.DB
+	Repo *RelationshipRepository
+}
+
+func (suite *RelationshipRepositoryTestSuite) SetupTest() {
+	db, err := database.NewSQLite(":memory:")
+	suite.Require().NoError(err)
+	suite.DB = db
+	suite.Repo = NewRelationshipRepository(db)
+}
+
+func (suite *RelationshipRepositoryTestSuite) TearDownTest() {
+	suite.DB.Close()
+}
+
+func (suite *RelationshipRepositoryTestSuite) addPersons(IDs ...string) {
+	for _, ID := range IDs {
+		_, err := suite.DB.Exec("INSERT INTO persons (id, name, gender) VALUES (?, ?, 'M')", ID, ID)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestCRUD() {
+	ctx := context.Background()
+	suite.addPersons("child", "parent", "other")
+	relationship := &entity.Relationship{MainPersonID: "child", SecundePersonID: "parent"}
+
+	suite.Run("should create a relationship with a generated ID", func() {
+		err := suite.Repo.Create(ctx, relationship, nil)
+		suite.Nil(err)
+		suite.NotEmpty(relationship.ID)
+	})
+
+	suite.Run("should get the relationship", func() {
+		found, err := suite.Repo.Get(ctx, relationship.ID)
+		suite.Nil(err)
+		suite.Equal("child", found.MainPersonID)
+		suite.Equal("parent", found.SecundePersonID)
+	})
+
+	suite.Run("should return not found when the relationship does not exist", func() {
+		found, err := suite.Repo.Get(ctx, "unknown")
+		suite.ErrorIs(err, relationshipDomain.ErrNotFound)
+		suite.Nil(found)
+
+		err = suite.Repo.Update(ctx, "unknown", &entity.Relationship{MainPersonID: "child", SecundePersonID: "other"}, nil)
+		suite.ErrorIs(err, relationshipDomain.ErrNotFound)
+		suite.ErrorIs(suite.Repo.Delete(ctx, "unknown"), relationshipDomain.ErrNotFound)
+	})
+
+	suite.Run("should list relationships", func() {
+		relationships, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Len(relationships, 1)
+	})
+
+	suite.Run("should update the relationship", func() {
+		err := suite.Repo.Update(ctx, relationship.ID, &entity.Relationship{MainPersonID: "child", SecundePersonID: "other"}, nil)
+		suite.Nil(err)
+		found, err := suite.Repo.Get(ctx, relationship.ID)
+		suite.Nil(err)
+		suite.Equal("other", found.SecundePersonID)
+	})
+
+	suite.Run("should refuse links to unknown persons", func() {
+		err := suite.Repo.Create(ctx, &entity.Relationship{MainPersonID: "child", SecundePersonID: "unknown"}, nil)
+		suite.ErrorIs(err, relationshipDomain.ErrUnknownPerson)
+
+		err = suite.Repo.Update(ctx, relationship.ID, &entity.Relationship{MainPersonID: "unknown", SecundePersonID: "other"}, nil)
+		suite.ErrorIs(err, relationshipDomain.ErrUnknownPerson)
+
+		relationships, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Len(relationships, 1)
+		suite.Equal("child", relationships[0].MainPersonID)
+	})
+
+	suite.Run("should delete the relationship", func() {
+		suite.Nil(suite.Repo.Delete(ctx, relationship.ID))
+		relationships, err := suite.Repo.List(ctx, nil)
+		suite.Nil(err)
+		suite.Empty(relationships)
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestConcurrentValidation() {
+	ctx := context.Background()
+	suite.addPersons("child")
+	for i := 0; i < 20; i++ {
+		suite.addPersons(fmt.Sprint("parent", i))
+	}
+	service := relationshipDomain.NewService(suite.Repo)
+
+	suite.Run("should check and write each relationship atomically", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				service.Create(ctx, &entity.Relationship{MainPersonID: "child", SecundePersonID: fmt.Sprint("parent", i)})
+			}(i)
+			go func() {
+				defer wg.Done()
+				service.Create(ctx, &entity.Relationship{Kind: entity.RelationshipKindSpouse, MainPersonID: "parent0", SecundePersonID: "parent1"})
+			}()
+		}
+		wg.Wait()
+
+		parents, err := suite.Repo.List(ctx, &entity.RelationshipFilter{ChildID: "child"})
+		suite.Require().NoError(err)
+		suite.Len(parents, 2)
+		unions, err := suite.Repo.List(ctx, &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse})
+		suite.Require().NoError(err)
+		suite.Len(unions, 1)
+	})
+
+	suite.Run("should not write when the check fails", func() {
+		checkErr := errors.New("check failed")
+		var persons []*entity.Person
+		err := suite.Repo.Create(ctx, &entity.Relationship{MainPersonID: "parent5", SecundePersonID: "parent6"}, func(p []*entity.Person, _ []*entity.Relationship) error {
+			persons = p
+			return checkErr
+		})
+		suite.ErrorIs(err, checkErr)
+		suite.Len(persons, 21)
+
+		parents, _ := suite.Repo.List(ctx, &entity.RelationshipFilter{ChildID: "parent5"})
+		suite.Empty(parents)
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestListFilter() {
+	ctx := context.Background()
+	suite.addPersons("first", "second", "father", "mother")
+	for _, r := range []*entity.Relationship{
+		{MainPersonID: "first", SecundePersonID: "father"},
+		{MainPersonID: "second", SecundePersonID: "father"},
+		{MainPersonID: "first", SecundePersonID: "mother"},
+		{Kind: entity.RelationshipKindSpouse, MainPersonID: "father", SecundePersonID: "mother"},
+	} {
+		suite.Require().NoError(suite.Repo.Create(ctx, r, nil))
+	}
+
+	suite.Run("should filter by parent, child and person", func() {
+		children, err := suite.Repo.List(ctx, &entity.RelationshipFilter{ParentID: "father"})
+		suite.Nil(err)
+		suite.Len(children, 2)
+
+		parents, err := suite.Repo.List(ctx, &entity.RelationshipFilter{ChildID: "first"})
+		suite.Nil(err)
+		suite.Len(parents, 2)
+
+		unions, err := suite.Repo.List(ctx, &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse, PersonID: "mother"})
+		suite.Nil(err)
+		suite.Len(unions, 1)
+		suite.Equal("father", unions[0].MainPersonID)
+	})
+
+	suite.Run("should sort and paginate", func() {
+		filter := &entity.RelationshipFilter{Kind: entity.RelationshipKindParent, Order: entity.OrderDesc, Page: entity.Page{Offset: 1, Limit: 1}}
+		relationships, err := suite.Repo.List(ctx, filter)
+		suite.Nil(err)
+		suite.Len(relationships, 1)
+		suite.Equal("second", relationships[0].MainPersonID)
+
+		total, err := suite.Repo.Count(ctx, filter)
+		suite.Nil(err)
+		suite.Equal(3, total)
+	})
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestParentage() {
+	ctx := context.Background()
+	suite.addPersons("child", "parent")
+	relationship := &entity.Relationship{MainPersonID: "child", SecundePersonID: "parent", Parentage: entity.ParentageAdoptive}
+	suite.Require().NoError(suite.Repo.Create(ctx, relationship, nil))
+
+	found, err := suite.Repo.Get(ctx, relationship.ID)
+	suite.Nil(err)
+	suite.Equal(entity.ParentageAdoptive, found.Parentage)
+}
+
+func (suite *RelationshipRepositoryTestSuite) TestUnions() {
+	ctx := context.Background()
+	suite.addPersons("child", "parent", "husband", "wife")
+	suite.Require().NoError(suite.Repo.Create(ctx, &entity.Relationship{MainPersonID: "child", SecundePersonID: "parent"}, nil))
+
+	union := &entity.Relationship{
+		Kind:            entity.RelationshipKindSpouse,
+		MainPersonID:    "husband",
+		SecundePersonID: "wife",
+		Start:           &entity.RelationshipEvent{Date: "1990-05-12", Place: "Recife"},
+	}
+	suite.Require().NoError(suite.Repo.Create(ctx, union, nil))
+
+	suite.Run("should persist kind and events", func() {
+		found, err := suite.Repo.Get(ctx, union.ID)
+		suite.Nil(err)
+		suite.Equal(entity.RelationshipKindSpouse, found.Kind)
+		suite.Equal(&entity.RelationshipEvent{Date: "1990-05-12", Place: "Recife"}, found.Start)
+		suite.Nil(found.End)
+	})
+
+	suite.Run("should store parent as the default kind", func() {
+		relationships, err := suite.Repo.List(ctx, &entity.RelationshipFilter{Kind: entity.RelationshipKindParent})
+		suite.Nil(err)
+		suite.Len(relationships, 1)
+		suite.Equal("child", relationships[0].MainPersonID)
+	})
+
+	suite.Run("should register the end of the union", func() {
+		union.End = &entity.RelationshipEvent{Date: "2001"}
+		suite.Nil(suite.Repo.Update(ctx, union.ID, union, nil))
+		relationships, err := suite.Repo.List(ctx, &entity.RelationshipFilter{Kind: entity.RelationshipKindSpouse})
+		suite.Nil(err)
+		suite.Len(relationships, 1)
+		suite.True(relationships[0].Ended())
+		suite.Empty(relationships[0].End.Place)
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipRepositoryTestSuite))
+}
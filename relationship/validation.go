@@ -0,0 +1,198 @@
+package relationship
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Códigos das violações de consistência da árvore.
+const (
+	ViolationSelfLink          = "self_link"
+	ViolationUnknownPerson     = "unknown_person"
+	ViolationDuplicate         = "duplicate"
+	ViolationCycle             = "cycle"
+	ViolationBiologicalParents = "too_many_biological_parents"
+	ViolationParentBornAfter   = "parent_born_after_child"
+)
+
+const maxBiologicalParents = 2
+
+// Campos da requisição de cada ponta do relacionamento, em paternidades e em uniões.
+const (
+	childField, parentField = "child", "parent"
+	firstField, secondField = "firstPerson", "secondPerson"
+)
+
+// Regra de consistência violada por um relacionamento. Field é o campo da requisição envolvido.
+type Violation struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// Erro de um relacionamento que deixaria a árvore inconsistente.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, v.Message)
+	}
+	return "invalid relationship: " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return entity.ErrValidation
+}
+
+// Verificação do relationship executada pelo repositório junto com a gravação, para que duas
+// requisições simultâneas não passem pelas mesmas regras. Na atualização, relationshipID é
+// ignorado entre os relacionamentos existentes.
+func validation(relationshipID string, relationship *entity.Relationship) entity.RelationshipCheck {
+	return func(persons []*entity.Person, stored []*entity.Relationship) error {
+		existing := make([]*entity.Relationship, 0, len(stored))
+		for _, r := range stored {
+			if r.ID != relationshipID {
+				existing = append(existing, r)
+			}
+		}
+
+		byID := make(map[string]*entity.Person, len(persons))
+		for _, p := range persons {
+			byID[p.ID] = p
+		}
+
+		violations := checkRelationship(relationship, byID, existing)
+		if len(violations) > 0 {
+			return &ValidationError{Violations: violations}
+		}
+		return nil
+	}
+}
+
+func checkRelationship(relationship *entity.Relationship, persons map[string]*entity.Person, existing []*entity.Relationship) []Violation {
+	main, secunde := firstField, secondField
+	if relationship.IsParent() {
+		main, secunde = childField, parentField
+	}
+
+	var violations []Violation
+	if relationship.MainPersonID == relationship.SecundePersonID {
+		violations = append(violations, Violation{Field: secunde, Code: ViolationSelfLink,
+			Message: "a person cannot be related to themselves"})
+	}
+
+	for _, field := range []struct{ name, personID string }{{main, relationship.MainPersonID}, {secunde, relationship.SecundePersonID}} {
+		if persons[field.personID] == nil {
+			violations = append(violations, Violation{Field: field.name, Code: ViolationUnknownPerson,
+				Message: fmt.Sprintf("person %s does not exist", field.personID)})
+		}
+	}
+
+	// As demais regras dependem das duas pessoas existirem e serem diferentes.
+	if len(violations) > 0 {
+		return violations
+	}
+
+	if duplicated(relationship, existing) {
+		violations = append(violations, Violation{Field: secunde, Code: ViolationDuplicate,
+			Message: "the relationship already exists"})
+	}
+
+	if !relationship.IsParent() {
+		return violations
+	}
+
+	if isAncestor(relationship.MainPersonID, relationship.SecundePersonID, existing) {
+		violations = append(violations, Violation{Field: secunde, Code: ViolationCycle,
+			Message: "the parent is a descendant of the child"})
+	}
+
+	if relationship.ParentageOrDefault() == entity.ParentageBiological && biologicalParents(relationship.MainPersonID, existing) >= maxBiologicalParents {
+		violations = append(violations, Violation{Field: main, Code: ViolationBiologicalParents,
+			Message: fmt.Sprintf("a person cannot have more than %d biological parents", maxBiologicalParents)})
+	}
+
+	if bornAfter(persons[relationship.SecundePersonID], persons[relationship.MainPersonID]) {
+		violations = append(violations, Violation{Field: secunde, Code: ViolationParentBornAfter,
+			Message: "the parent was born after the child"})
+	}
+	return violations
+}
+
+// Relacionamentos de paternidade são iguais pelo filho e pelo pai/mãe; uniões pelo tipo e pelo par,
+// em qualquer ordem.
+func duplicated(relationship *entity.Relationship, existing []*entity.Relationship) bool {
+	for _, r := range existing {
+		if r.KindOrDefault() != relationship.KindOrDefault() {
+			continue
+		}
+		if r.MainPersonID == relationship.MainPersonID && r.SecundePersonID == relationship.SecundePersonID {
+			return true
+		}
+		if r.IsUnion() && r.MainPersonID == relationship.SecundePersonID && r.SecundePersonID == relationship.MainPersonID {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifica se ancestorID é ancestral de personID, subindo pelos relacionamentos de paternidade.
+func isAncestor(ancestorID, personID string, relationships []*entity.Relationship) bool {
+	parents := map[string][]string{}
+	for _, r := range relationships {
+		if r.IsParent() {
+			parents[r.MainPersonID] = append(parents[r.MainPersonID], r.SecundePersonID)
+		}
+	}
+
+	visited := map[string]bool{personID: true}
+	queue := []string{personID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, parentID := range parents[current] {
+			if parentID == ancestorID {
+				return true
+			}
+			if !visited[parentID] {
+				visited[parentID] = true
+				queue = append(queue, parentID)
+			}
+		}
+	}
+	return false
+}
+
+func biologicalParents(childID string, relationships []*entity.Relationship) int {
+	count := 0
+	for _, r := range relationships {
+		if r.IsParent() && r.MainPersonID == childID && r.ParentageOrDefault() == entity.ParentageBiological {
+			count++
+		}
+	}
+	return count
+}
+
+// Só é possível afirmar que o pai/mãe nasceu depois quando as duas datas têm limites: o primeiro
+// dia possível do nascimento do pai/mãe é posterior ao último dia possível do nascimento do filho.
+func bornAfter(parent, child *entity.Person) bool {
+	parentBirth, okParent := parent.BirthDate()
+	childBirth, okChild := child.BirthDate()
+	if !okParent || !okChild {
+		return false
+	}
+	earliest, ok := parentBirth.Earliest()
+	if !ok {
+		return false
+	}
+	latest, ok := childBirth.Latest()
+	if !ok {
+		return false
+	}
+	return earliest.After(latest)
+}
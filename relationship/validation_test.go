@@ -0,0 +1,125 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GeovaneCavalcante/tree-genealogical/internal/entity"
+)
+
+// Avô (g), pai (f), mãe (m) e filho (c). O filho tem os dois pais biológicos cadastrados.
+func validationPersons() map[string]*entity.Person {
+	born := func(id, date string) *entity.Person {
+		return &entity.Person{ID: id, Events: []*entity.Event{{Type: entity.EventBirth, Date: date}}}
+	}
+	return map[string]*entity.Person{
+		"g": born("g", "1920"),
+		"f": born("f", "1950-05"),
+		"m": born("m", "ABT 1952"),
+		"c": born("c", "1980-02-10"),
+		"x": born("x", "BET 1990 AND 1995"),
+		"y": {ID: "y"},
+	}
+}
+
+func validationRelationships() []*entity.Relationship {
+	return []*entity.Relationship{
+		{ID: "1", MainPersonID: "f", SecundePersonID: "g"},
+		{ID: "2", MainPersonID: "c", SecundePersonID: "f"},
+		{ID: "3", MainPersonID: "c", SecundePersonID: "m"},
+		{ID: "4", Kind: entity.RelationshipKindSpouse, MainPersonID: "f", SecundePersonID: "m"},
+	}
+}
+
+func codes(violations []Violation) []string {
+	var result []string
+	for _, v := range violations {
+		result = append(result, v.Code)
+	}
+	return result
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship() {
+	persons, existing := validationPersons(), validationRelationships()
+
+	suite.Run("should accept a consistent relationship", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "m", SecundePersonID: "y"}, persons, existing)
+		suite.Empty(violations)
+	})
+
+	suite.Run("should reject a person related to themselves", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "c", SecundePersonID: "c"}, persons, existing)
+		suite.Equal([]Violation{{Field: "parent", Code: ViolationSelfLink, Message: "a person cannot be related to themselves"}}, violations)
+	})
+
+	suite.Run("should reject unknown persons with the request field", func() {
+		violations := checkRelationship(&entity.Relationship{Kind: entity.RelationshipKindPartner, MainPersonID: "a", SecundePersonID: "c"}, persons, existing)
+		suite.Equal([]Violation{{Field: "firstPerson", Code: ViolationUnknownPerson, Message: "person a does not exist"}}, violations)
+	})
+
+	suite.Run("should reject a duplicated relationship", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "c", SecundePersonID: "f", Parentage: entity.ParentageAdoptive}, persons, existing)
+		suite.Equal([]string{ViolationDuplicate}, codes(violations))
+	})
+
+	suite.Run("should reject a duplicated union in any order", func() {
+		violations := checkRelationship(&entity.Relationship{Kind: entity.RelationshipKindSpouse, MainPersonID: "m", SecundePersonID: "f"}, persons, existing)
+		suite.Equal([]string{ViolationDuplicate}, codes(violations))
+	})
+
+	suite.Run("should reject a person becoming their own ancestor", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "f", SecundePersonID: "c", Parentage: entity.ParentageAdoptive}, persons, existing)
+		suite.Equal([]string{ViolationCycle, ViolationParentBornAfter}, codes(violations))
+	})
+
+	suite.Run("should reject a third biological parent", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "c", SecundePersonID: "y"}, persons, existing)
+		suite.Equal([]string{ViolationBiologicalParents}, codes(violations))
+	})
+
+	suite.Run("should accept a third parent that is not biological", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "c", SecundePersonID: "y", Parentage: entity.ParentageAdoptive}, persons, existing)
+		suite.Empty(violations)
+	})
+
+	suite.Run("should reject a parent born after the child", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "g", SecundePersonID: "x"}, persons, existing)
+		suite.Equal([]string{ViolationParentBornAfter}, codes(violations))
+	})
+
+	suite.Run("should ignore approximate birth dates", func() {
+		violations := checkRelationship(&entity.Relationship{MainPersonID: "g", SecundePersonID: "m", Parentage: entity.ParentageFoster}, persons, existing)
+		suite.Empty(violations)
+	})
+}
+
+func (suite *RelationshipServiceTestSuite) TestCreateInvalid() {
+	ctx := context.Background()
+
+	suite.Run("should return the violations without creating the relationship", func() {
+		suite.expectCreate(nil, nil)
+		service := NewService(suite.RelationshipRepoMock)
+		err := service.Create(ctx, &entity.Relationship{MainPersonID: "2", SecundePersonID: "9"})
+
+		var validationErr *ValidationError
+		suite.Require().True(errors.As(err, &validationErr))
+		suite.Equal([]Violation{{Field: "parent", Code: ViolationUnknownPerson, Message: "person 9 does not exist"}}, validationErr.Violations)
+		suite.Equal("create relationship error: invalid relationship: person 9 does not exist", err.Error())
+	})
+
+	suite.Run("should ignore the relationship being updated when looking for duplicates", func() {
+		suite.expectUpdate("1", []*entity.Relationship{{ID: "1", MainPersonID: "3", SecundePersonID: "2"}}, nil)
+		service := NewService(suite.RelationshipRepoMock)
+		suite.Nil(service.Update(ctx, "1", &entity.Relationship{MainPersonID: "3", SecundePersonID: "2", Parentage: entity.ParentageAdoptive}))
+	})
+
+	suite.Run("should reject a duplicate found by the repository check", func() {
+		suite.expectCreate([]*entity.Relationship{{ID: "1", MainPersonID: "3", SecundePersonID: "2"}}, nil)
+		service := NewService(suite.RelationshipRepoMock)
+		err := service.Create(ctx, &entity.Relationship{MainPersonID: "3", SecundePersonID: "2"})
+
+		var validationErr *ValidationError
+		suite.Require().True(errors.As(err, &validationErr))
+		suite.Equal([]string{ViolationDuplicate}, codes(validationErr.Violations))
+	})
+}